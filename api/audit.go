@@ -0,0 +1,161 @@
+package api
+
+import (
+	"betting/internal/domain"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Limits applied to an audit listing.
+const (
+	DefaultAuditLimit = 100
+	MaxAuditLimit     = 1000
+)
+
+// Query parameters accepted by an audit listing, along with QueryLimit.
+const (
+	QuerySubject = "subject"
+	QueryActor   = "actor"
+	QueryAfter   = "after"
+)
+
+// Errors returned when parsing an audit listing query.
+var (
+	ErrInvalidSubject = errors.New("subject must be a uuid")
+	ErrInvalidAfter   = errors.New("after must be a non-negative integer")
+)
+
+// AuditEntry is the presentation representation of a domain.AuditEntry.
+type AuditEntry struct {
+	Sequence     int64              `json:"sequence"`
+	Action       domain.AuditAction `json:"action"`
+	Subject      uuid.UUID          `json:"subject"`
+	Actor        string             `json:"actor"`
+	RequestID    string             `json:"requestId"`
+	Route        string             `json:"route"`
+	BodyHash     string             `json:"bodyHash"`
+	RecordedAt   time.Time          `json:"recordedAt"`
+	Changes      []Change           `json:"changes"`
+	PreviousHash string             `json:"previousHash"`
+	Hash         string             `json:"hash"`
+}
+
+// Change is the presentation representation of a domain.Change, Before and After hold JSON encoded values.
+type Change struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// ParseAuditQuery adapts the query parameters of an audit listing to a domain.AuditQuery.
+func ParseAuditQuery(values url.Values) (domain.AuditQuery, error) {
+	query := domain.AuditQuery{
+		Actor: values.Get(QueryActor),
+		Limit: DefaultAuditLimit,
+	}
+
+	if v := values.Get(QuerySubject); v != "" {
+		subject, err := uuid.Parse(v)
+		if err != nil {
+			return domain.AuditQuery{}, fmt.Errorf("%v: %w", v, ErrInvalidSubject)
+		}
+
+		query.Subject = subject
+	}
+
+	if v := values.Get(QueryAfter); v != "" {
+		after, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || after < 0 {
+			return domain.AuditQuery{}, fmt.Errorf("%v: %w", v, ErrInvalidAfter)
+		}
+
+		query.After = after
+	}
+
+	if v := values.Get(QueryLimit); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 1 {
+			return domain.AuditQuery{}, fmt.Errorf("%v: %w", v, ErrInvalidLimit)
+		}
+
+		if limit > MaxAuditLimit {
+			limit = MaxAuditLimit
+		}
+
+		query.Limit = limit
+	}
+
+	return query, nil
+}
+
+// AdaptAuditEntriesFromDomain adapts domain.AuditEntries to their presentation representation.
+func AdaptAuditEntriesFromDomain(entries []domain.AuditEntry) []AuditEntry {
+	response := make([]AuditEntry, len(entries))
+
+	for i, entry := range entries {
+		changes := make([]Change, len(entry.Changes))
+
+		for j := range entry.Changes {
+			changes[j] = Change{
+				Field:  entry.Changes[j].Field,
+				Before: entry.Changes[j].Before,
+				After:  entry.Changes[j].After,
+			}
+		}
+
+		response[i] = AuditEntry{
+			Sequence:     entry.Sequence,
+			Action:       entry.Action,
+			Subject:      entry.Subject,
+			Actor:        entry.Actor,
+			RequestID:    entry.RequestID,
+			Route:        entry.Route,
+			BodyHash:     entry.BodyHash,
+			RecordedAt:   entry.RecordedAt,
+			Changes:      changes,
+			PreviousHash: entry.PreviousHash,
+			Hash:         entry.Hash,
+		}
+	}
+
+	return response
+}
+
+// AdaptAuditEntriesToDomain adapts AuditEntries, as listed by GET /v1/audit, back to domain.AuditEntries so their chain
+// can be verified.
+func AdaptAuditEntriesToDomain(entries []AuditEntry) []domain.AuditEntry {
+	adapted := make([]domain.AuditEntry, len(entries))
+
+	for i, entry := range entries {
+		changes := make([]domain.Change, len(entry.Changes))
+
+		for j := range entry.Changes {
+			changes[j] = domain.Change{
+				Field:  entry.Changes[j].Field,
+				Before: entry.Changes[j].Before,
+				After:  entry.Changes[j].After,
+			}
+		}
+
+		adapted[i] = domain.AuditEntry{
+			Sequence:     entry.Sequence,
+			Action:       entry.Action,
+			Subject:      entry.Subject,
+			Actor:        entry.Actor,
+			RequestID:    entry.RequestID,
+			Route:        entry.Route,
+			BodyHash:     entry.BodyHash,
+			RecordedAt:   entry.RecordedAt,
+			Changes:      changes,
+			PreviousHash: entry.PreviousHash,
+			Hash:         entry.Hash,
+		}
+	}
+
+	return adapted
+}
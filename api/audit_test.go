@@ -0,0 +1,133 @@
+package api
+
+import (
+	"betting/internal/domain"
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+)
+
+func TestParseAuditQuery_Success(t *testing.T) {
+	tests := []struct {
+		name          string
+		givenValues   url.Values
+		expectedQuery domain.AuditQuery
+	}{
+		{
+			name:          "given no parameters, expect the defaults",
+			givenValues:   url.Values{},
+			expectedQuery: domain.AuditQuery{Limit: DefaultAuditLimit},
+		},
+		{
+			name: "given every parameter, expect them to be adapted",
+			givenValues: url.Values{
+				QuerySubject: {"0173b64f-e07e-4fa0-bcb3-231856390dce"},
+				QueryActor:   {"croupier-7"},
+				QueryAfter:   {"42"},
+				QueryLimit:   {"10"},
+			},
+			expectedQuery: domain.AuditQuery{
+				Subject: uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
+				Actor:   "croupier-7",
+				After:   42,
+				Limit:   10,
+			},
+		},
+		{
+			name:          "given a limit above the maximum, expect it to be capped",
+			givenValues:   url.Values{QueryLimit: {"100000"}},
+			expectedQuery: domain.AuditQuery{Limit: MaxAuditLimit},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := ParseAuditQuery(test.givenValues)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(actual, test.expectedQuery) {
+				t.Fatal(cmp.Diff(actual, test.expectedQuery))
+			}
+		})
+	}
+}
+
+func TestParseAuditQuery_Fail(t *testing.T) {
+	tests := []struct {
+		name          string
+		givenValues   url.Values
+		expectedError error
+	}{
+		{
+			name:          "given a subject that is not a uuid, expect ErrInvalidSubject",
+			givenValues:   url.Values{QuerySubject: {"table-1"}},
+			expectedError: ErrInvalidSubject,
+		},
+		{
+			name:          "given a negative after, expect ErrInvalidAfter",
+			givenValues:   url.Values{QueryAfter: {"-1"}},
+			expectedError: ErrInvalidAfter,
+		},
+		{
+			name:          "given a limit of zero, expect ErrInvalidLimit",
+			givenValues:   url.Values{QueryLimit: {"0"}},
+			expectedError: ErrInvalidLimit,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseAuditQuery(test.givenValues)
+			if !cmp.Equal(err, test.expectedError, cmpopts.EquateErrors()) {
+				t.Fatal(cmp.Diff(err, test.expectedError, cmpopts.EquateErrors()))
+			}
+		})
+	}
+}
+
+func TestAdaptAuditEntries_Verifiable(t *testing.T) {
+	first := domain.AuditEntry{
+		Sequence:   1,
+		Action:     domain.CreateTable,
+		Subject:    uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
+		Actor:      "croupier-7",
+		RequestID:  "a1b2c3",
+		Route:      "POST /v1/tables",
+		BodyHash:   "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		RecordedAt: time.Date(2021, 10, 11, 12, 0, 0, 5, time.UTC),
+		Changes:    []domain.Change{{Field: "IsClosed", After: "false"}},
+	}
+	first.Hash = first.Digest()
+
+	second := domain.AuditEntry{
+		Sequence:     2,
+		Action:       domain.SpinTable,
+		Subject:      first.Subject,
+		Actor:        "anonymous",
+		RecordedAt:   first.RecordedAt.Add(time.Minute),
+		PreviousHash: first.Hash,
+	}
+	second.Hash = second.Digest()
+
+	raw, err := json.Marshal(AdaptAuditEntriesFromDomain([]domain.AuditEntry{first, second}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var listed []AuditEntry
+
+	err = json.Unmarshal(raw, &listed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = domain.VerifyAudit(AdaptAuditEntriesToDomain(listed))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -8,45 +8,92 @@ import (
 	"github.com/google/uuid"
 )
 
-// BetRequest represents the required fields to create a bet.
+// BetRequest represents the required fields to create a bet. An announced bet gives Announced in place of
+// SelectedSpaces and its Stake is that of each chip, the bet placed reports the total. Jackpot places the progressive
+// jackpot side bet alongside the bet.
 type BetRequest struct {
+	SelectedSpaces []int         `json:"selectedSpaces"`
+	Stake          *money.Money  `json:"stake"`
+	Announced      *Announcement `json:"announced,omitempty"`
+	Jackpot        bool          `json:"jackpot,omitempty"`
+	Table          uuid.UUID     `json:"table"`
+}
+
+// Announcement represents the call of an announced bet, Number and Neighbours are only given for neighbour bets.
+type Announcement struct {
+	Call       domain.Call `json:"call"`
+	Number     *int        `json:"number,omitempty"`
+	Neighbours int         `json:"neighbours,omitempty"`
+}
+
+// Placement represents a single chip of an announced bet.
+type Placement struct {
 	SelectedSpaces []int        `json:"selectedSpaces"`
 	Stake          *money.Money `json:"stake"`
-	Table          uuid.UUID    `json:"table"`
+	Win            bool         `json:"win"`
 }
 
-// BetResponse represents a Bet in responses to clients.
+// BetResponse represents a Bet in responses to clients. Slip is only present for Bets placed with a Slip, Placements
+// for announced Bets, Refund for losing Bets given part of their stake back and ImprisonedOn for Bets imprisoned by zero.
+// Contribution is only present for Bets placed with the jackpot side bet and JackpotWin for those paid from its pool.
 type BetResponse struct {
-	ID        uuid.UUID        `json:"id"`
-	PlacedAt  time.Time        `json:"placedAt"`
-	Status    domain.BetStatus `json:"status"`
-	SettledAt *time.Time       `json:"settledAt"`
-	Win       bool             `json:"win"`
+	ID           uuid.UUID        `json:"id"`
+	PlacedAt     time.Time        `json:"placedAt"`
+	Status       domain.BetStatus `json:"status"`
+	SettledAt    *time.Time       `json:"settledAt"`
+	Win          bool             `json:"win"`
+	Refund       *money.Money     `json:"refund,omitempty"`
+	ImprisonedOn *uuid.UUID       `json:"imprisonedOn,omitempty"`
+	Slip         *uuid.UUID       `json:"slip,omitempty"`
+	Placements   []Placement      `json:"placements,omitempty"`
+	Contribution *money.Money     `json:"contribution,omitempty"`
+	JackpotWin   *money.Money     `json:"jackpotWin,omitempty"`
 	BetRequest
 }
 
 func AdaptBetFromDomain(bet domain.Bet) BetResponse {
+	var slip *uuid.UUID
+	if bet.Slip != uuid.Nil {
+		slip = &bet.Slip
+	}
+
+	var imprisonedOn *uuid.UUID
+	if bet.ImprisonedOn != uuid.Nil {
+		imprisonedOn = &bet.ImprisonedOn
+	}
+
 	return BetResponse{
-		ID:        bet.ID,
-		PlacedAt:  bet.PlacedAt,
-		Status:    bet.Status,
-		SettledAt: bet.SettledAt,
-		Win:       bet.Win,
+		ID:           bet.ID,
+		PlacedAt:     bet.PlacedAt,
+		Status:       bet.Status,
+		SettledAt:    bet.SettledAt,
+		Win:          bet.Win,
+		Refund:       bet.Refund,
+		ImprisonedOn: imprisonedOn,
+		Slip:         slip,
+		Placements:   adaptPlacementsFromDomain(bet.Placements),
+		Contribution: bet.Contribution,
+		JackpotWin:   bet.JackpotWin,
 		BetRequest: BetRequest{
 			Stake:          bet.Stake,
+			Announced:      adaptAnnouncementFromDomain(bet.Announcement),
+			Jackpot:        bet.Jackpot,
 			Table:          bet.Table,
 			SelectedSpaces: bet.SelectedSpaces,
 		},
 	}
 }
 
+// AdaptBetToDomain adapts a BetRequest to an unsettled Bet on the given Table, the time it is placed at is stamped as it
+// is placed rather than as it is adapted.
 func AdaptBetToDomain(bet BetRequest, tableID uuid.UUID) domain.Bet {
 	return domain.Bet{
 		ID:             uuid.New(),
 		Status:         domain.Unsettled,
 		Stake:          bet.Stake,
 		SelectedSpaces: bet.SelectedSpaces,
-		PlacedAt:       time.Now().UTC(),
+		Announcement:   adaptAnnouncementToDomain(bet.Announced),
+		Jackpot:        bet.Jackpot,
 		SettledAt:      nil,
 		Table:          tableID,
 	}
@@ -61,3 +108,107 @@ func AdaptBetsFromDomain(bets []domain.Bet) []BetResponse {
 
 	return responseBets
 }
+
+// AdaptBetsToDomain adapts previously returned BetResponses back to domain.Bets.
+func AdaptBetsToDomain(bets []BetResponse) []domain.Bet {
+	domainBets := make([]domain.Bet, len(bets))
+
+	for i := range bets {
+		domainBets[i] = domain.Bet{
+			ID:             bets[i].ID,
+			Status:         bets[i].Status,
+			SelectedSpaces: bets[i].SelectedSpaces,
+			Stake:          bets[i].Stake,
+			Announcement:   adaptAnnouncementToDomain(bets[i].Announced),
+			Placements:     adaptPlacementsToDomain(bets[i].Placements),
+			PlacedAt:       bets[i].PlacedAt,
+			SettledAt:      bets[i].SettledAt,
+			Win:            bets[i].Win,
+			Refund:         bets[i].Refund,
+			Table:          bets[i].Table,
+			Jackpot:        bets[i].Jackpot,
+			Contribution:   bets[i].Contribution,
+			JackpotWin:     bets[i].JackpotWin,
+		}
+
+		if bets[i].Slip != nil {
+			domainBets[i].Slip = *bets[i].Slip
+		}
+
+		if bets[i].ImprisonedOn != nil {
+			domainBets[i].ImprisonedOn = *bets[i].ImprisonedOn
+		}
+	}
+
+	return domainBets
+}
+
+func adaptAnnouncementToDomain(announcement *Announcement) *domain.Announcement {
+	if announcement == nil {
+		return nil
+	}
+
+	a := domain.Announcement{
+		Call:       announcement.Call,
+		Neighbours: announcement.Neighbours,
+	}
+
+	if announcement.Number != nil {
+		a.Number = *announcement.Number
+	}
+
+	return &a
+}
+
+func adaptAnnouncementFromDomain(announcement *domain.Announcement) *Announcement {
+	if announcement == nil {
+		return nil
+	}
+
+	a := Announcement{Call: announcement.Call}
+
+	if announcement.Call == domain.NeighboursOf {
+		number := announcement.Number
+
+		a.Number = &number
+		a.Neighbours = announcement.Neighbours
+	}
+
+	return &a
+}
+
+func adaptPlacementsFromDomain(placements []domain.Placement) []Placement {
+	if placements == nil {
+		return nil
+	}
+
+	p := make([]Placement, len(placements))
+
+	for i := range placements {
+		p[i] = Placement{
+			SelectedSpaces: placements[i].SelectedSpaces,
+			Stake:          placements[i].Stake,
+			Win:            placements[i].Win,
+		}
+	}
+
+	return p
+}
+
+func adaptPlacementsToDomain(placements []Placement) []domain.Placement {
+	if placements == nil {
+		return nil
+	}
+
+	p := make([]domain.Placement, len(placements))
+
+	for i := range placements {
+		p[i] = domain.Placement{
+			SelectedSpaces: placements[i].SelectedSpaces,
+			Stake:          placements[i].Stake,
+			Win:            placements[i].Win,
+		}
+	}
+
+	return p
+}
@@ -0,0 +1,39 @@
+package api
+
+import (
+	"betting/internal/domain"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrUnmatchedETag is returned when an If-Match header cannot match the ETag of any version of a Table.
+var ErrUnmatchedETag = errors.New("if-match header does not match a version of the table")
+
+// ETag returns the strong entity tag of the given version of a Table.
+func ETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// ParseIfMatch returns the version of a Table required by an If-Match header. An absent header or * require no
+// particular version and give domain.AnyVersion. Only a single entity tag is accepted, weak tags never match.
+func ParseIfMatch(header string) (int64, error) {
+	header = strings.TrimSpace(header)
+
+	if header == "" || header == "*" {
+		return domain.AnyVersion, nil
+	}
+
+	tag, err := strconv.Unquote(header)
+	if err != nil || !strings.HasPrefix(header, `"`) {
+		return 0, fmt.Errorf("%v: %w", header, ErrUnmatchedETag)
+	}
+
+	version, err := strconv.ParseInt(tag, 10, 64)
+	if err != nil || version <= domain.AnyVersion {
+		return 0, fmt.Errorf("%v: %w", header, ErrUnmatchedETag)
+	}
+
+	return version, nil
+}
@@ -0,0 +1,95 @@
+package api
+
+import (
+	"betting/internal/domain"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestETag(t *testing.T) {
+	expected := `"12"`
+
+	if !cmp.Equal(ETag(12), expected) {
+		t.Fatal(cmp.Diff(ETag(12), expected))
+	}
+}
+
+func TestParseIfMatch_Success(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenHeader     string
+		expectedVersion int64
+	}{
+		{
+			name:            "given no header, expect any version",
+			expectedVersion: domain.AnyVersion,
+		},
+		{
+			name:            "given a wildcard, expect any version",
+			givenHeader:     "*",
+			expectedVersion: domain.AnyVersion,
+		},
+		{
+			name:            "given a strong entity tag, expect its version",
+			givenHeader:     ` "3" `,
+			expectedVersion: 3,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			version, err := ParseIfMatch(test.givenHeader)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(version, test.expectedVersion) {
+				t.Fatal(cmp.Diff(version, test.expectedVersion))
+			}
+		})
+	}
+}
+
+func TestParseIfMatch_Fail(t *testing.T) {
+	tests := []struct {
+		name          string
+		givenHeader   string
+		expectedError error
+	}{
+		{
+			name:          "given a weak entity tag, expect it not to match",
+			givenHeader:   `W/"3"`,
+			expectedError: ErrUnmatchedETag,
+		},
+		{
+			name:          "given an unquoted version, expect it not to match",
+			givenHeader:   "3",
+			expectedError: ErrUnmatchedETag,
+		},
+		{
+			name:          "given several entity tags, expect them not to match",
+			givenHeader:   `"3", "4"`,
+			expectedError: ErrUnmatchedETag,
+		},
+		{
+			name:          "given an entity tag that is not a version, expect it not to match",
+			givenHeader:   `"abc"`,
+			expectedError: ErrUnmatchedETag,
+		},
+		{
+			name:          "given a version of zero, expect it not to match",
+			givenHeader:   `"0"`,
+			expectedError: ErrUnmatchedETag,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseIfMatch(test.givenHeader)
+
+			if !cmp.Equal(err, test.expectedError, cmpopts.EquateErrors()) {
+				t.Fatal(cmp.Diff(err, test.expectedError, cmpopts.EquateErrors()))
+			}
+		})
+	}
+}
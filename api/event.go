@@ -0,0 +1,31 @@
+package api
+
+import (
+	"betting/internal/domain"
+	"encoding/json"
+	"time"
+)
+
+// Event is the presentation representation of a domain.Event.
+type Event struct {
+	Sequence   int64            `json:"sequence"`
+	Type       domain.EventType `json:"type"`
+	RecordedAt time.Time        `json:"recordedAt"`
+	Data       json.RawMessage  `json:"data"`
+}
+
+// AdaptEventsFromDomain adapts domain.Events to Events.
+func AdaptEventsFromDomain(events []domain.Event) []Event {
+	response := make([]Event, len(events))
+
+	for i := range events {
+		response[i] = Event{
+			Sequence:   events[i].Sequence,
+			Type:       events[i].Type,
+			RecordedAt: events[i].RecordedAt,
+			Data:       events[i].Data,
+		}
+	}
+
+	return response
+}
@@ -0,0 +1,377 @@
+package api
+
+import (
+	"betting/internal/domain"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Rhymond/go-money"
+	"github.com/google/uuid"
+)
+
+// Query parameters accepted by an export, along with QueryCreatedFrom and QueryCreatedTo.
+const (
+	QueryRecords = "records"
+	QueryFormat  = "format"
+)
+
+// Errors returned when parsing an export query.
+var (
+	ErrInvalidRecords = errors.New("records must be one of tables, bets or outcomes")
+	ErrInvalidFormat  = errors.New("format must be one of csv or jsonl")
+	ErrMissingRange   = errors.New("createdFrom and createdTo are required")
+	ErrInvalidRange   = errors.New("createdTo must be after createdFrom")
+)
+
+// ExportFormat is the encoding an export is written in.
+type ExportFormat string
+
+// Available options for ExportFormat.
+const (
+	FormatCSV       ExportFormat = "csv"
+	FormatJSONLines ExportFormat = "jsonl"
+)
+
+// Defaults applied to an export.
+const (
+	DefaultFormat  = FormatCSV
+	DefaultRecords = domain.TableRecords
+)
+
+const (
+	contentTypeCSV   = "text/csv"
+	contentTypeJSONL = "application/x-ndjson"
+)
+
+// ContentType returns the media type an export in the format is served as.
+func (f ExportFormat) ContentType() string {
+	if f == FormatJSONLines {
+		return contentTypeJSONL
+	}
+
+	return contentTypeCSV
+}
+
+// ExportFilename returns the name of the file an export of the given records in the format is saved as.
+func ExportFilename(records domain.ExportRecords, format ExportFormat) string {
+	return records.String() + "." + string(format)
+}
+
+// ParseExportQuery adapts the query parameters of an export to a domain.ExportQuery and the ExportFormat it is written
+// in. Both ends of the range are required, records default to Tables and the format to CSV.
+func ParseExportQuery(values url.Values) (domain.ExportQuery, ExportFormat, error) {
+	query := domain.ExportQuery{Records: DefaultRecords}
+	format := DefaultFormat
+
+	switch records := domain.ExportRecords(values.Get(QueryRecords)); records {
+	case "":
+	case domain.TableRecords, domain.BetRecords, domain.OutcomeRecords:
+		query.Records = records
+	default:
+		return domain.ExportQuery{}, "", fmt.Errorf("%v: %w", records, ErrInvalidRecords)
+	}
+
+	switch f := ExportFormat(values.Get(QueryFormat)); f {
+	case "":
+	case FormatCSV, FormatJSONLines:
+		format = f
+	default:
+		return domain.ExportQuery{}, "", fmt.Errorf("%v: %w", f, ErrInvalidFormat)
+	}
+
+	createdFrom, err := parseTime(values, QueryCreatedFrom)
+	if err != nil {
+		return domain.ExportQuery{}, "", err
+	}
+
+	createdTo, err := parseTime(values, QueryCreatedTo)
+	if err != nil {
+		return domain.ExportQuery{}, "", err
+	}
+
+	if createdFrom == nil || createdTo == nil {
+		return domain.ExportQuery{}, "", ErrMissingRange
+	}
+
+	if !createdTo.After(*createdFrom) {
+		return domain.ExportQuery{}, "", ErrInvalidRange
+	}
+
+	query.CreatedFrom = *createdFrom
+	query.CreatedTo = *createdTo
+
+	return query, format, nil
+}
+
+// ExportTable is the export representation of a domain.Table, a single row of a Table export.
+type ExportTable struct {
+	ID        uuid.UUID  `json:"id"`
+	Series    uuid.UUID  `json:"series"`
+	Previous  *uuid.UUID `json:"previous"`
+	ZeroRule  string     `json:"zeroRule"`
+	Variant   string     `json:"variant"`
+	Wheels    int        `json:"wheels"`
+	CreatedAt time.Time  `json:"createdAt"`
+	CreatedBy string     `json:"createdBy"`
+	ClosedAt  *time.Time `json:"closedAt"`
+	ClosedBy  string     `json:"closedBy"`
+	SpunAt    *time.Time `json:"spunAt"`
+	SpunBy    string     `json:"spunBy"`
+	SettledAt *time.Time `json:"settledAt"`
+	SettledBy string     `json:"settledBy"`
+}
+
+var exportTableHeader = []string{"id", "series", "previous", "zeroRule", "variant", "wheels", "createdAt", "createdBy",
+	"closedAt", "closedBy", "spunAt", "spunBy", "settledAt", "settledBy"}
+
+func (e ExportTable) row() []string {
+	previous := ""
+	if e.Previous != nil {
+		previous = e.Previous.String()
+	}
+
+	return []string{e.ID.String(), e.Series.String(), previous, e.ZeroRule, e.Variant, strconv.Itoa(e.Wheels),
+		formatTime(&e.CreatedAt), e.CreatedBy, formatTime(e.ClosedAt), e.ClosedBy, formatTime(e.SpunAt), e.SpunBy,
+		formatTime(e.SettledAt), e.SettledBy}
+}
+
+// ExportOutcome is the export representation of a domain.Outcome, Ball counts the balls of the Table from one.
+type ExportOutcome struct {
+	Table    uuid.UUID  `json:"table"`
+	Ball     int        `json:"ball"`
+	Position int        `json:"position"`
+	Colour   string     `json:"colour"`
+	Seed     int64      `json:"seed"`
+	SpunAt   *time.Time `json:"spunAt"`
+}
+
+var exportOutcomeHeader = []string{"table", "ball", "position", "colour", "seed", "spunAt"}
+
+func (e ExportOutcome) row() []string {
+	return []string{e.Table.String(), strconv.Itoa(e.Ball), strconv.Itoa(e.Position), e.Colour,
+		strconv.FormatInt(e.Seed, 10), formatTime(e.SpunAt)}
+}
+
+// ExportBet is the export representation of a domain.Bet. Amounts are in the minor units of Currency, those the Bet
+// does not have are zero.
+type ExportBet struct {
+	ID             uuid.UUID  `json:"id"`
+	Table          uuid.UUID  `json:"table"`
+	Slip           *uuid.UUID `json:"slip"`
+	Status         string     `json:"status"`
+	SelectedSpaces []int      `json:"selectedSpaces"`
+	Currency       string     `json:"currency"`
+	Stake          int64      `json:"stake"`
+	Refund         int64      `json:"refund"`
+	Contribution   int64      `json:"contribution"`
+	JackpotWin     int64      `json:"jackpotWin"`
+	Win            bool       `json:"win"`
+	PlacedAt       time.Time  `json:"placedAt"`
+	SettledAt      *time.Time `json:"settledAt"`
+	ImprisonedOn   *uuid.UUID `json:"imprisonedOn"`
+}
+
+var exportBetHeader = []string{"id", "table", "slip", "status", "selectedSpaces", "currency", "stake", "refund",
+	"contribution", "jackpotWin", "win", "placedAt", "settledAt", "imprisonedOn"}
+
+func (e ExportBet) row() []string {
+	spaces := make([]string, len(e.SelectedSpaces))
+
+	for i := range e.SelectedSpaces {
+		spaces[i] = strconv.Itoa(e.SelectedSpaces[i])
+	}
+
+	return []string{e.ID.String(), e.Table.String(), formatID(e.Slip), e.Status, strings.Join(spaces, " "), e.Currency,
+		strconv.FormatInt(e.Stake, 10), strconv.FormatInt(e.Refund, 10), strconv.FormatInt(e.Contribution, 10),
+		strconv.FormatInt(e.JackpotWin, 10), strconv.FormatBool(e.Win), formatTime(&e.PlacedAt), formatTime(e.SettledAt),
+		formatID(e.ImprisonedOn)}
+}
+
+// exportRecord is a single row of an export.
+type exportRecord interface {
+	row() []string
+}
+
+// adaptTableToExport adapts a domain.Table to the rows of it written to an export of the given records.
+func adaptTableToExport(records domain.ExportRecords, table domain.Table) []exportRecord {
+	switch records {
+	case domain.BetRecords:
+		rows := make([]exportRecord, len(table.Bets))
+
+		for i := range table.Bets {
+			rows[i] = adaptBetToExport(table.Bets[i])
+		}
+
+		return rows
+	case domain.OutcomeRecords:
+		rows := make([]exportRecord, len(table.Outcomes))
+
+		for i := range table.Outcomes {
+			rows[i] = ExportOutcome{
+				Table:    table.ID,
+				Ball:     i + 1,
+				Position: table.Outcomes[i].Value,
+				Colour:   table.Outcomes[i].Colour.String(),
+				Seed:     table.Outcomes[i].Seed,
+				SpunAt:   table.SpunAt,
+			}
+		}
+
+		return rows
+	default:
+		return []exportRecord{ExportTable{
+			ID:        table.ID,
+			Series:    table.Series,
+			Previous:  optionalID(table.Previous),
+			ZeroRule:  table.Rules.Zero.String(),
+			Variant:   table.Rules.Variant.String(),
+			Wheels:    table.Rules.Wheels,
+			CreatedAt: table.CreatedAt,
+			CreatedBy: table.CreatedBy,
+			ClosedAt:  table.ClosedAt,
+			ClosedBy:  table.ClosedBy,
+			SpunAt:    table.SpunAt,
+			SpunBy:    table.SpunBy,
+			SettledAt: table.SettledAt,
+			SettledBy: table.SettledBy,
+		}}
+	}
+}
+
+func adaptBetToExport(bet domain.Bet) ExportBet {
+	e := ExportBet{
+		ID:             bet.ID,
+		Table:          bet.Table,
+		Slip:           optionalID(bet.Slip),
+		Status:         bet.Status.String(),
+		SelectedSpaces: bet.SelectedSpaces,
+		Stake:          minorUnits(bet.Stake),
+		Refund:         minorUnits(bet.Refund),
+		Contribution:   minorUnits(bet.Contribution),
+		JackpotWin:     minorUnits(bet.JackpotWin),
+		Win:            bet.Win,
+		PlacedAt:       bet.PlacedAt,
+		SettledAt:      bet.SettledAt,
+		ImprisonedOn:   optionalID(bet.ImprisonedOn),
+	}
+
+	if bet.Stake != nil {
+		e.Currency = bet.Stake.Currency().Code
+	}
+
+	return e
+}
+
+func minorUnits(m *money.Money) int64 {
+	if m == nil {
+		return 0
+	}
+
+	return m.Amount()
+}
+
+func optionalID(id uuid.UUID) *uuid.UUID {
+	if id == uuid.Nil {
+		return nil
+	}
+
+	return &id
+}
+
+func formatID(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+
+	return id.String()
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// ExportWriter writes the records of Tables to an export as they are given. CSV exports begin with a header naming the
+// columns, which match the fields of the JSON Lines records.
+type ExportWriter struct {
+	format  ExportFormat
+	records domain.ExportRecords
+	csv     *csv.Writer
+	json    *json.Encoder
+	started bool
+}
+
+// NewExportWriter instantiates ExportWriter, writing the given records of each Table to w in the format.
+func NewExportWriter(w io.Writer, format ExportFormat, records domain.ExportRecords) *ExportWriter {
+	return &ExportWriter{
+		format:  format,
+		records: records,
+		csv:     csv.NewWriter(w),
+		json:    json.NewEncoder(w),
+	}
+}
+
+// Write writes the records of the Table, CSV records are buffered until Flush.
+func (e *ExportWriter) Write(table domain.Table) error {
+	err := e.start()
+	if err != nil {
+		return err
+	}
+
+	for _, record := range adaptTableToExport(e.records, table) {
+		if e.format == FormatJSONLines {
+			err = e.json.Encode(record)
+		} else {
+			err = e.csv.Write(record.row())
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Flush writes any buffered records, and the CSV header should no Table have been written.
+func (e *ExportWriter) Flush() error {
+	err := e.start()
+	if err != nil {
+		return err
+	}
+
+	e.csv.Flush()
+
+	return e.csv.Error()
+}
+
+func (e *ExportWriter) start() error {
+	if e.started {
+		return nil
+	}
+
+	e.started = true
+
+	if e.format == FormatJSONLines {
+		return nil
+	}
+
+	switch e.records {
+	case domain.BetRecords:
+		return e.csv.Write(exportBetHeader)
+	case domain.OutcomeRecords:
+		return e.csv.Write(exportOutcomeHeader)
+	default:
+		return e.csv.Write(exportTableHeader)
+	}
+}
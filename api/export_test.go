@@ -0,0 +1,243 @@
+package api
+
+import (
+	"betting/internal/domain"
+	"bytes"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Rhymond/go-money"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+)
+
+var (
+	exportFrom    = time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+	exportTo      = time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	exportTable   = uuid.MustParse("8b0c2d4e-6f8a-4b0c-9d2e-4f6a8b0c2d4e")
+	exportBet     = uuid.MustParse("9c1d3e5f-7a9b-4c1d-8e3f-5a7b9c1d3e5f")
+	exportCreated = time.Date(2021, 10, 2, 9, 0, 0, 0, time.UTC)
+)
+
+func TestParseExportQuery_Success(t *testing.T) {
+	tests := []struct {
+		name           string
+		givenValues    url.Values
+		expectedQuery  domain.ExportQuery
+		expectedFormat ExportFormat
+	}{
+		{
+			name:           "given only the range, expect Tables as CSV",
+			givenValues:    url.Values{QueryCreatedFrom: {"2021-10-01T01:00:00+01:00"}, QueryCreatedTo: {"2021-11-01T00:00:00Z"}},
+			expectedQuery:  domain.ExportQuery{Records: domain.TableRecords, CreatedFrom: exportFrom, CreatedTo: exportTo},
+			expectedFormat: FormatCSV,
+		},
+		{
+			name: "given every parameter, expect them to be adapted",
+			givenValues: url.Values{
+				QueryRecords:     {"bets"},
+				QueryFormat:      {"jsonl"},
+				QueryCreatedFrom: {"2021-10-01T00:00:00Z"},
+				QueryCreatedTo:   {"2021-11-01T00:00:00Z"},
+			},
+			expectedQuery:  domain.ExportQuery{Records: domain.BetRecords, CreatedFrom: exportFrom, CreatedTo: exportTo},
+			expectedFormat: FormatJSONLines,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			query, format, err := ParseExportQuery(test.givenValues)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(query, test.expectedQuery) {
+				t.Fatal(cmp.Diff(query, test.expectedQuery))
+			}
+
+			if !cmp.Equal(format, test.expectedFormat) {
+				t.Fatal(cmp.Diff(format, test.expectedFormat))
+			}
+		})
+	}
+}
+
+func TestParseExportQuery_Fail(t *testing.T) {
+	tests := []struct {
+		name          string
+		givenValues   url.Values
+		expectedError error
+	}{
+		{
+			name:          "given unknown records, expect ErrInvalidRecords",
+			givenValues:   url.Values{QueryRecords: {"players"}},
+			expectedError: ErrInvalidRecords,
+		},
+		{
+			name:          "given an unknown format, expect ErrInvalidFormat",
+			givenValues:   url.Values{QueryFormat: {"xml"}},
+			expectedError: ErrInvalidFormat,
+		},
+		{
+			name:          "given a malformed time, expect ErrInvalidTime",
+			givenValues:   url.Values{QueryCreatedFrom: {"yesterday"}},
+			expectedError: ErrInvalidTime,
+		},
+		{
+			name:          "given no end to the range, expect ErrMissingRange",
+			givenValues:   url.Values{QueryCreatedFrom: {"2021-10-01T00:00:00Z"}},
+			expectedError: ErrMissingRange,
+		},
+		{
+			name:          "given a range ending before it starts, expect ErrInvalidRange",
+			givenValues:   url.Values{QueryCreatedFrom: {"2021-11-01T00:00:00Z"}, QueryCreatedTo: {"2021-10-01T00:00:00Z"}},
+			expectedError: ErrInvalidRange,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, _, err := ParseExportQuery(test.givenValues)
+			if !cmp.Equal(err, test.expectedError, cmpopts.EquateErrors()) {
+				t.Fatal(cmp.Diff(err, test.expectedError, cmpopts.EquateErrors()))
+			}
+		})
+	}
+}
+
+func TestExportWriter(t *testing.T) {
+	spunAt := exportCreated.Add(time.Hour)
+	table := domain.Table{
+		ID:        exportTable,
+		Series:    exportTable,
+		Rules:     domain.Rules{Zero: domain.EnPrison, Variant: domain.SingleBall, Wheels: 1},
+		CreatedAt: exportCreated,
+		CreatedBy: "croupier",
+		SpunAt:    &spunAt,
+		SpunBy:    "croupier",
+		Outcomes:  []domain.Outcome{{Value: 32, Colour: domain.Red, Seed: 7}},
+		Bets: []domain.Bet{{
+			ID:             exportBet,
+			Table:          exportTable,
+			Status:         domain.Settled,
+			SelectedSpaces: []int{1, 2},
+			Stake:          money.New(1050, "GBP"),
+			Refund:         money.New(525, "GBP"),
+			PlacedAt:       exportCreated,
+		}},
+	}
+
+	tests := []struct {
+		name           string
+		givenFormat    ExportFormat
+		givenRecords   domain.ExportRecords
+		givenTables    []domain.Table
+		expectedOutput string
+	}{
+		{
+			name:         "given Tables as CSV, expect a header and a row per Table",
+			givenFormat:  FormatCSV,
+			givenRecords: domain.TableRecords,
+			givenTables:  []domain.Table{table},
+			expectedOutput: "id,series,previous,zeroRule,variant,wheels,createdAt,createdBy,closedAt,closedBy,spunAt,spunBy," +
+				"settledAt,settledBy\n" +
+				exportTable.String() + "," + exportTable.String() + ",,en_prison,single,1,2021-10-02T09:00:00Z,croupier,,," +
+				"2021-10-02T10:00:00Z,croupier,,\n",
+		},
+		{
+			name:         "given Bets as CSV, expect amounts in minor units along with their currency",
+			givenFormat:  FormatCSV,
+			givenRecords: domain.BetRecords,
+			givenTables:  []domain.Table{table},
+			expectedOutput: "id,table,slip,status,selectedSpaces,currency,stake,refund,contribution,jackpotWin,win,placedAt," +
+				"settledAt,imprisonedOn\n" +
+				exportBet.String() + "," + exportTable.String() + ",,settled,1 2,GBP,1050,525,0,0,false,2021-10-02T09:00:00Z,,\n",
+		},
+		{
+			name:           "given Outcomes as CSV and no Tables, expect only the header",
+			givenFormat:    FormatCSV,
+			givenRecords:   domain.OutcomeRecords,
+			expectedOutput: "table,ball,position,colour,seed,spunAt\n",
+		},
+		{
+			name:         "given Outcomes as JSON Lines, expect a line per Outcome",
+			givenFormat:  FormatJSONLines,
+			givenRecords: domain.OutcomeRecords,
+			givenTables:  []domain.Table{table, table},
+			expectedOutput: strings.Repeat(`{"table":"`+exportTable.String()+`","ball":1,"position":32,"colour":"red","seed":7,`+
+				`"spunAt":"2021-10-02T10:00:00Z"}`+"\n", 2),
+		},
+		{
+			name:         "given Bets as JSON Lines, expect amounts in minor units along with their currency",
+			givenFormat:  FormatJSONLines,
+			givenRecords: domain.BetRecords,
+			givenTables:  []domain.Table{table},
+			expectedOutput: `{"id":"` + exportBet.String() + `","table":"` + exportTable.String() + `","slip":null,` +
+				`"status":"settled","selectedSpaces":[1,2],"currency":"GBP","stake":1050,"refund":525,"contribution":0,` +
+				`"jackpotWin":0,"win":false,"placedAt":"2021-10-02T09:00:00Z","settledAt":null,"imprisonedOn":null}` + "\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			w := NewExportWriter(&buf, test.givenFormat, test.givenRecords)
+
+			for i := range test.givenTables {
+				err := w.Write(test.givenTables[i])
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			err := w.Flush()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(buf.String(), test.expectedOutput) {
+				t.Fatal(cmp.Diff(buf.String(), test.expectedOutput))
+			}
+		})
+	}
+}
+
+func TestExportWriter_Header(t *testing.T) {
+	tests := []struct {
+		name        string
+		givenHeader []string
+		givenType   reflect.Type
+	}{
+		{
+			name:        "expect the table header to name the fields of api.ExportTable",
+			givenHeader: exportTableHeader,
+			givenType:   reflect.TypeOf(ExportTable{}),
+		},
+		{
+			name:        "expect the outcome header to name the fields of api.ExportOutcome",
+			givenHeader: exportOutcomeHeader,
+			givenType:   reflect.TypeOf(ExportOutcome{}),
+		},
+		{
+			name:        "expect the bet header to name the fields of api.ExportBet",
+			givenHeader: exportBetHeader,
+			givenType:   reflect.TypeOf(ExportBet{}),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			expected := make([]string, test.givenType.NumField())
+
+			for i := range expected {
+				expected[i] = strings.Split(test.givenType.Field(i).Tag.Get("json"), ",")[0]
+			}
+
+			if !cmp.Equal(test.givenHeader, expected) {
+				t.Fatal(cmp.Diff(test.givenHeader, expected))
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package api
+
+import (
+	"betting/internal/domain"
+
+	"github.com/Rhymond/go-money"
+	"github.com/google/uuid"
+)
+
+// JackpotResponse is the presentation representation of a domain.Jackpot.
+type JackpotResponse struct {
+	Pool   []*money.Money `json:"pool"`
+	Awards []JackpotAward `json:"awards"`
+}
+
+// JackpotAward is the presentation representation of a domain.JackpotAward.
+type JackpotAward struct {
+	Bet    uuid.UUID    `json:"bet"`
+	Table  uuid.UUID    `json:"table"`
+	Amount *money.Money `json:"amount"`
+}
+
+func AdaptJackpotFromDomain(jackpot domain.Jackpot) JackpotResponse {
+	pool := make([]*money.Money, len(jackpot.Pool))
+	copy(pool, jackpot.Pool)
+
+	awards := make([]JackpotAward, len(jackpot.Awards))
+
+	for i := range jackpot.Awards {
+		awards[i] = JackpotAward{
+			Bet:    jackpot.Awards[i].Bet,
+			Table:  jackpot.Awards[i].Table,
+			Amount: jackpot.Awards[i].Amount,
+		}
+	}
+
+	return JackpotResponse{
+		Pool:   pool,
+		Awards: awards,
+	}
+}
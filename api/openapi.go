@@ -0,0 +1,11 @@
+package api
+
+import (
+	// embed is required to include the specification in the binary.
+	_ "embed"
+)
+
+// Specification is the OpenAPI 3 description of every endpoint, request and response served.
+//
+//go:embed openapi.json
+var Specification []byte
@@ -0,0 +1,193 @@
+package api
+
+import (
+	"betting/internal/pkg/responses"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSpecification_Schemas(t *testing.T) {
+	doc, err := openapi3.NewLoader().LoadFromData(Specification)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		givenSchema string
+		givenType   reflect.Type
+	}{
+		{
+			name:        "expect the bet request schema to match api.BetRequest",
+			givenSchema: "BetRequest",
+			givenType:   reflect.TypeOf(BetRequest{}),
+		},
+		{
+			name:        "expect the announcement schema to match api.Announcement",
+			givenSchema: "Announcement",
+			givenType:   reflect.TypeOf(Announcement{}),
+		},
+		{
+			name:        "expect the placement schema to match api.Placement",
+			givenSchema: "Placement",
+			givenType:   reflect.TypeOf(Placement{}),
+		},
+		{
+			name:        "expect the bet response schema to match api.BetResponse",
+			givenSchema: "BetResponse",
+			givenType:   reflect.TypeOf(BetResponse{}),
+		},
+		{
+			name:        "expect the slip request schema to match api.SlipRequest",
+			givenSchema: "SlipRequest",
+			givenType:   reflect.TypeOf(SlipRequest{}),
+		},
+		{
+			name:        "expect the slip response schema to match api.SlipResponse",
+			givenSchema: "SlipResponse",
+			givenType:   reflect.TypeOf(SlipResponse{}),
+		},
+		{
+			name:        "expect the outcome schema to match api.Outcome",
+			givenSchema: "Outcome",
+			givenType:   reflect.TypeOf(Outcome{}),
+		},
+		{
+			name:        "expect the multiplier schema to match api.Multiplier",
+			givenSchema: "Multiplier",
+			givenType:   reflect.TypeOf(Multiplier{}),
+		},
+		{
+			name:        "expect the table request schema to match api.TableRequest",
+			givenSchema: "TableRequest",
+			givenType:   reflect.TypeOf(TableRequest{}),
+		},
+		{
+			name:        "expect the rules schema to match api.Rules",
+			givenSchema: "Rules",
+			givenType:   reflect.TypeOf(Rules{}),
+		},
+		{
+			name:        "expect the table response schema to match api.TableResponse",
+			givenSchema: "TableResponse",
+			givenType:   reflect.TypeOf(TableResponse{}),
+		},
+		{
+			name:        "expect the pocket exposure schema to match api.PocketExposure",
+			givenSchema: "PocketExposure",
+			givenType:   reflect.TypeOf(PocketExposure{}),
+		},
+		{
+			name:        "expect the table summary schema to match api.TableSummaryResponse",
+			givenSchema: "TableSummaryResponse",
+			givenType:   reflect.TypeOf(TableSummaryResponse{}),
+		},
+		{
+			name:        "expect the jackpot award schema to match api.JackpotAward",
+			givenSchema: "JackpotAward",
+			givenType:   reflect.TypeOf(JackpotAward{}),
+		},
+		{
+			name:        "expect the jackpot schema to match api.JackpotResponse",
+			givenSchema: "JackpotResponse",
+			givenType:   reflect.TypeOf(JackpotResponse{}),
+		},
+		{
+			name:        "expect the result schema to match api.Result",
+			givenSchema: "Result",
+			givenType:   reflect.TypeOf(Result{}),
+		},
+		{
+			name:        "expect the number statistics schema to match api.NumberStatistics",
+			givenSchema: "NumberStatistics",
+			givenType:   reflect.TypeOf(NumberStatistics{}),
+		},
+		{
+			name:        "expect the streak schema to match api.Streak",
+			givenSchema: "Streak",
+			givenType:   reflect.TypeOf(Streak{}),
+		},
+		{
+			name:        "expect the statistics schema to match api.StatisticsResponse",
+			givenSchema: "StatisticsResponse",
+			givenType:   reflect.TypeOf(StatisticsResponse{}),
+		},
+		{
+			name:        "expect the audit entry schema to match api.AuditEntry",
+			givenSchema: "AuditEntry",
+			givenType:   reflect.TypeOf(AuditEntry{}),
+		},
+		{
+			name:        "expect the change schema to match api.Change",
+			givenSchema: "Change",
+			givenType:   reflect.TypeOf(Change{}),
+		},
+		{
+			name:        "expect the event schema to match api.Event",
+			givenSchema: "Event",
+			givenType:   reflect.TypeOf(Event{}),
+		},
+		{
+			name:        "expect the error schema to match responses.Error",
+			givenSchema: "Error",
+			givenType:   reflect.TypeOf(responses.Error{}),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			schema, ok := doc.Components.Schemas[test.givenSchema]
+			if !ok {
+				t.Fatalf("schema %v is not specified", test.givenSchema)
+			}
+
+			actual := make([]string, 0, len(schema.Value.Properties))
+
+			for property := range schema.Value.Properties {
+				actual = append(actual, property)
+			}
+
+			sort.Strings(actual)
+
+			expected := jsonFields(test.givenType)
+
+			if !cmp.Equal(actual, expected) {
+				t.Fatal(cmp.Diff(actual, expected))
+			}
+		})
+	}
+}
+
+// jsonFields returns the sorted names of the fields the given struct is encoded with.
+func jsonFields(t reflect.Type) []string {
+	var fields []string
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+
+		if field.Anonymous && tag == "" {
+			fields = append(fields, jsonFields(field.Type)...)
+			continue
+		}
+
+		name := strings.Split(tag, ",")[0]
+		if name == "-" || field.PkgPath != "" {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		fields = append(fields, name)
+	}
+
+	sort.Strings(fields)
+
+	return fields
+}
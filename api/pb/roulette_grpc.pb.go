@@ -0,0 +1,485 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// TableServiceClient is the client API for TableService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TableServiceClient interface {
+	// CreateTable creates a table on which bets can be placed.
+	CreateTable(ctx context.Context, in *CreateTableRequest, opts ...grpc.CallOption) (*Table, error)
+	// GetTable fetches a specific table.
+	GetTable(ctx context.Context, in *GetTableRequest, opts ...grpc.CallOption) (*Table, error)
+	// ListTables retrieves created tables a page at a time.
+	ListTables(ctx context.Context, in *ListTablesRequest, opts ...grpc.CallOption) (*ListTablesResponse, error)
+	// GetTableSummary aggregates the bets of a table.
+	GetTableSummary(ctx context.Context, in *GetTableSummaryRequest, opts ...grpc.CallOption) (*TableSummary, error)
+	// SpinTable accepts no more bets and generates the outcome.
+	SpinTable(ctx context.Context, in *SpinTableRequest, opts ...grpc.CallOption) (*Table, error)
+	// SettleTable moves all bets to settled and finds any winners.
+	SettleTable(ctx context.Context, in *SettleTableRequest, opts ...grpc.CallOption) (*Table, error)
+	// WatchTable sends the current state of a table followed by every update to it once spun or settled.
+	WatchTable(ctx context.Context, in *WatchTableRequest, opts ...grpc.CallOption) (TableService_WatchTableClient, error)
+}
+
+type tableServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTableServiceClient(cc grpc.ClientConnInterface) TableServiceClient {
+	return &tableServiceClient{cc}
+}
+
+func (c *tableServiceClient) CreateTable(ctx context.Context, in *CreateTableRequest, opts ...grpc.CallOption) (*Table, error) {
+	out := new(Table)
+	err := c.cc.Invoke(ctx, "/roulette.v1.TableService/CreateTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tableServiceClient) GetTable(ctx context.Context, in *GetTableRequest, opts ...grpc.CallOption) (*Table, error) {
+	out := new(Table)
+	err := c.cc.Invoke(ctx, "/roulette.v1.TableService/GetTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tableServiceClient) ListTables(ctx context.Context, in *ListTablesRequest, opts ...grpc.CallOption) (*ListTablesResponse, error) {
+	out := new(ListTablesResponse)
+	err := c.cc.Invoke(ctx, "/roulette.v1.TableService/ListTables", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tableServiceClient) GetTableSummary(ctx context.Context, in *GetTableSummaryRequest, opts ...grpc.CallOption) (*TableSummary, error) {
+	out := new(TableSummary)
+	err := c.cc.Invoke(ctx, "/roulette.v1.TableService/GetTableSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tableServiceClient) SpinTable(ctx context.Context, in *SpinTableRequest, opts ...grpc.CallOption) (*Table, error) {
+	out := new(Table)
+	err := c.cc.Invoke(ctx, "/roulette.v1.TableService/SpinTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tableServiceClient) SettleTable(ctx context.Context, in *SettleTableRequest, opts ...grpc.CallOption) (*Table, error) {
+	out := new(Table)
+	err := c.cc.Invoke(ctx, "/roulette.v1.TableService/SettleTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tableServiceClient) WatchTable(ctx context.Context, in *WatchTableRequest, opts ...grpc.CallOption) (TableService_WatchTableClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TableService_ServiceDesc.Streams[0], "/roulette.v1.TableService/WatchTable", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tableServiceWatchTableClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TableService_WatchTableClient interface {
+	Recv() (*Table, error)
+	grpc.ClientStream
+}
+
+type tableServiceWatchTableClient struct {
+	grpc.ClientStream
+}
+
+func (x *tableServiceWatchTableClient) Recv() (*Table, error) {
+	m := new(Table)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// TableServiceServer is the server API for TableService service.
+// All implementations must embed UnimplementedTableServiceServer
+// for forward compatibility
+type TableServiceServer interface {
+	// CreateTable creates a table on which bets can be placed.
+	CreateTable(context.Context, *CreateTableRequest) (*Table, error)
+	// GetTable fetches a specific table.
+	GetTable(context.Context, *GetTableRequest) (*Table, error)
+	// ListTables retrieves created tables a page at a time.
+	ListTables(context.Context, *ListTablesRequest) (*ListTablesResponse, error)
+	// GetTableSummary aggregates the bets of a table.
+	GetTableSummary(context.Context, *GetTableSummaryRequest) (*TableSummary, error)
+	// SpinTable accepts no more bets and generates the outcome.
+	SpinTable(context.Context, *SpinTableRequest) (*Table, error)
+	// SettleTable moves all bets to settled and finds any winners.
+	SettleTable(context.Context, *SettleTableRequest) (*Table, error)
+	// WatchTable sends the current state of a table followed by every update to it once spun or settled.
+	WatchTable(*WatchTableRequest, TableService_WatchTableServer) error
+	mustEmbedUnimplementedTableServiceServer()
+}
+
+// UnimplementedTableServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTableServiceServer struct {
+}
+
+func (UnimplementedTableServiceServer) CreateTable(context.Context, *CreateTableRequest) (*Table, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTable not implemented")
+}
+func (UnimplementedTableServiceServer) GetTable(context.Context, *GetTableRequest) (*Table, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTable not implemented")
+}
+func (UnimplementedTableServiceServer) ListTables(context.Context, *ListTablesRequest) (*ListTablesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTables not implemented")
+}
+func (UnimplementedTableServiceServer) GetTableSummary(context.Context, *GetTableSummaryRequest) (*TableSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTableSummary not implemented")
+}
+func (UnimplementedTableServiceServer) SpinTable(context.Context, *SpinTableRequest) (*Table, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpinTable not implemented")
+}
+func (UnimplementedTableServiceServer) SettleTable(context.Context, *SettleTableRequest) (*Table, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleTable not implemented")
+}
+func (UnimplementedTableServiceServer) WatchTable(*WatchTableRequest, TableService_WatchTableServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchTable not implemented")
+}
+func (UnimplementedTableServiceServer) mustEmbedUnimplementedTableServiceServer() {}
+
+// UnsafeTableServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TableServiceServer will
+// result in compilation errors.
+type UnsafeTableServiceServer interface {
+	mustEmbedUnimplementedTableServiceServer()
+}
+
+func RegisterTableServiceServer(s grpc.ServiceRegistrar, srv TableServiceServer) {
+	s.RegisterService(&TableService_ServiceDesc, srv)
+}
+
+func _TableService_CreateTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TableServiceServer).CreateTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roulette.v1.TableService/CreateTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TableServiceServer).CreateTable(ctx, req.(*CreateTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TableService_GetTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TableServiceServer).GetTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roulette.v1.TableService/GetTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TableServiceServer).GetTable(ctx, req.(*GetTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TableService_ListTables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TableServiceServer).ListTables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roulette.v1.TableService/ListTables",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TableServiceServer).ListTables(ctx, req.(*ListTablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TableService_GetTableSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTableSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TableServiceServer).GetTableSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roulette.v1.TableService/GetTableSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TableServiceServer).GetTableSummary(ctx, req.(*GetTableSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TableService_SpinTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpinTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TableServiceServer).SpinTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roulette.v1.TableService/SpinTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TableServiceServer).SpinTable(ctx, req.(*SpinTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TableService_SettleTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettleTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TableServiceServer).SettleTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roulette.v1.TableService/SettleTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TableServiceServer).SettleTable(ctx, req.(*SettleTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TableService_WatchTable_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTableRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TableServiceServer).WatchTable(m, &tableServiceWatchTableServer{stream})
+}
+
+type TableService_WatchTableServer interface {
+	Send(*Table) error
+	grpc.ServerStream
+}
+
+type tableServiceWatchTableServer struct {
+	grpc.ServerStream
+}
+
+func (x *tableServiceWatchTableServer) Send(m *Table) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// TableService_ServiceDesc is the grpc.ServiceDesc for TableService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TableService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "roulette.v1.TableService",
+	HandlerType: (*TableServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTable",
+			Handler:    _TableService_CreateTable_Handler,
+		},
+		{
+			MethodName: "GetTable",
+			Handler:    _TableService_GetTable_Handler,
+		},
+		{
+			MethodName: "ListTables",
+			Handler:    _TableService_ListTables_Handler,
+		},
+		{
+			MethodName: "GetTableSummary",
+			Handler:    _TableService_GetTableSummary_Handler,
+		},
+		{
+			MethodName: "SpinTable",
+			Handler:    _TableService_SpinTable_Handler,
+		},
+		{
+			MethodName: "SettleTable",
+			Handler:    _TableService_SettleTable_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchTable",
+			Handler:       _TableService_WatchTable_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "roulette.proto",
+}
+
+// BetServiceClient is the client API for BetService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BetServiceClient interface {
+	// PlaceBet creates a bet for the given table.
+	PlaceBet(ctx context.Context, in *PlaceBetRequest, opts ...grpc.CallOption) (*Bet, error)
+	// GetBet fetches a specific bet.
+	GetBet(ctx context.Context, in *GetBetRequest, opts ...grpc.CallOption) (*Bet, error)
+}
+
+type betServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBetServiceClient(cc grpc.ClientConnInterface) BetServiceClient {
+	return &betServiceClient{cc}
+}
+
+func (c *betServiceClient) PlaceBet(ctx context.Context, in *PlaceBetRequest, opts ...grpc.CallOption) (*Bet, error) {
+	out := new(Bet)
+	err := c.cc.Invoke(ctx, "/roulette.v1.BetService/PlaceBet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *betServiceClient) GetBet(ctx context.Context, in *GetBetRequest, opts ...grpc.CallOption) (*Bet, error) {
+	out := new(Bet)
+	err := c.cc.Invoke(ctx, "/roulette.v1.BetService/GetBet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BetServiceServer is the server API for BetService service.
+// All implementations must embed UnimplementedBetServiceServer
+// for forward compatibility
+type BetServiceServer interface {
+	// PlaceBet creates a bet for the given table.
+	PlaceBet(context.Context, *PlaceBetRequest) (*Bet, error)
+	// GetBet fetches a specific bet.
+	GetBet(context.Context, *GetBetRequest) (*Bet, error)
+	mustEmbedUnimplementedBetServiceServer()
+}
+
+// UnimplementedBetServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedBetServiceServer struct {
+}
+
+func (UnimplementedBetServiceServer) PlaceBet(context.Context, *PlaceBetRequest) (*Bet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceBet not implemented")
+}
+func (UnimplementedBetServiceServer) GetBet(context.Context, *GetBetRequest) (*Bet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBet not implemented")
+}
+func (UnimplementedBetServiceServer) mustEmbedUnimplementedBetServiceServer() {}
+
+// UnsafeBetServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BetServiceServer will
+// result in compilation errors.
+type UnsafeBetServiceServer interface {
+	mustEmbedUnimplementedBetServiceServer()
+}
+
+func RegisterBetServiceServer(s grpc.ServiceRegistrar, srv BetServiceServer) {
+	s.RegisterService(&BetService_ServiceDesc, srv)
+}
+
+func _BetService_PlaceBet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceBetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetServiceServer).PlaceBet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roulette.v1.BetService/PlaceBet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetServiceServer).PlaceBet(ctx, req.(*PlaceBetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BetService_GetBet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetServiceServer).GetBet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/roulette.v1.BetService/GetBet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetServiceServer).GetBet(ctx, req.(*GetBetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BetService_ServiceDesc is the grpc.ServiceDesc for BetService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BetService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "roulette.v1.BetService",
+	HandlerType: (*BetServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PlaceBet",
+			Handler:    _BetService_PlaceBet_Handler,
+		},
+		{
+			MethodName: "GetBet",
+			Handler:    _BetService_GetBet_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "roulette.proto",
+}
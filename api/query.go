@@ -0,0 +1,179 @@
+package api
+
+import (
+	"betting/internal/domain"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Limits applied to a Table listing.
+const (
+	DefaultTableLimit = 50
+	MaxTableLimit     = 500
+)
+
+// Query parameters accepted by a Table listing.
+const (
+	QueryState       = "state"
+	QueryCreatedFrom = "createdFrom"
+	QueryCreatedTo   = "createdTo"
+	QueryColour      = "colour"
+	QueryOrder       = "order"
+	QueryCursor      = "cursor"
+	QueryLimit       = "limit"
+	QueryBets        = "bets"
+)
+
+// Errors returned when parsing a Table listing query.
+var (
+	ErrInvalidState  = errors.New("state must be one of open or closed")
+	ErrInvalidTime   = errors.New("time must be RFC 3339 formatted")
+	ErrInvalidColour = errors.New("colour must be one of red, black or green")
+	ErrInvalidOrder  = errors.New("order must be one of asc or desc")
+	ErrInvalidCursor = errors.New("cursor is not valid")
+	ErrInvalidLimit  = errors.New("limit must be a positive integer")
+	ErrInvalidBets   = errors.New("bets must be a boolean")
+)
+
+// ParseTableQuery adapts the query parameters of a Table listing to a domain.TableQuery.
+func ParseTableQuery(values url.Values) (domain.TableQuery, error) {
+	query := domain.TableQuery{
+		Order: domain.Ascending,
+		Limit: DefaultTableLimit,
+	}
+
+	switch state := domain.TableState(values.Get(QueryState)); state {
+	case "", domain.Open, domain.Closed:
+		query.State = state
+	default:
+		return domain.TableQuery{}, fmt.Errorf("%v: %w", state, ErrInvalidState)
+	}
+
+	createdFrom, err := parseTime(values, QueryCreatedFrom)
+	if err != nil {
+		return domain.TableQuery{}, err
+	}
+
+	query.CreatedFrom = createdFrom
+
+	createdTo, err := parseTime(values, QueryCreatedTo)
+	if err != nil {
+		return domain.TableQuery{}, err
+	}
+
+	query.CreatedTo = createdTo
+
+	if v := values.Get(QueryColour); v != "" {
+		colour := domain.Colour(v)
+		if colour != domain.Red && colour != domain.Black && colour != domain.Green {
+			return domain.TableQuery{}, fmt.Errorf("%v: %w", v, ErrInvalidColour)
+		}
+
+		query.Colour = colour
+	}
+
+	switch order := domain.SortOrder(values.Get(QueryOrder)); order {
+	case "":
+	case domain.Ascending, domain.Descending:
+		query.Order = order
+	default:
+		return domain.TableQuery{}, fmt.Errorf("%v: %w", order, ErrInvalidOrder)
+	}
+
+	if v := values.Get(QueryCursor); v != "" {
+		var cursor domain.TableCursor
+
+		cursor, err = DecodeTableCursor(v)
+		if err != nil {
+			return domain.TableQuery{}, err
+		}
+
+		query.After = &cursor
+	}
+
+	if v := values.Get(QueryLimit); v != "" {
+		var limit int
+
+		limit, err = strconv.Atoi(v)
+		if err != nil || limit < 1 {
+			return domain.TableQuery{}, fmt.Errorf("%v: %w", v, ErrInvalidLimit)
+		}
+
+		if limit > MaxTableLimit {
+			limit = MaxTableLimit
+		}
+
+		query.Limit = limit
+	}
+
+	if v := values.Get(QueryBets); v != "" {
+		var includeBets bool
+
+		includeBets, err = strconv.ParseBool(v)
+		if err != nil {
+			return domain.TableQuery{}, fmt.Errorf("%v: %w", v, ErrInvalidBets)
+		}
+
+		query.OmitBets = !includeBets
+	}
+
+	return query, nil
+}
+
+func parseTime(values url.Values, param string) (*time.Time, error) {
+	v := values.Get(param)
+	if v == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", param, ErrInvalidTime)
+	}
+
+	t = t.UTC()
+
+	return &t, nil
+}
+
+// EncodeTableCursor returns the opaque representation of a domain.TableCursor given to clients.
+func EncodeTableCursor(cursor domain.TableCursor) string {
+	raw := cursor.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + cursor.ID.String()
+
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeTableCursor adapts an opaque cursor given to clients back to a domain.TableCursor.
+func DecodeTableCursor(cursor string) (domain.TableCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return domain.TableCursor{}, ErrInvalidCursor
+	}
+
+	parts := strings.SplitN(string(raw), "|", 2)
+	if len(parts) != 2 {
+		return domain.TableCursor{}, ErrInvalidCursor
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return domain.TableCursor{}, ErrInvalidCursor
+	}
+
+	id, err := uuid.Parse(parts[1])
+	if err != nil {
+		return domain.TableCursor{}, ErrInvalidCursor
+	}
+
+	return domain.TableCursor{
+		CreatedAt: createdAt,
+		ID:        id,
+	}, nil
+}
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"betting/internal/domain"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+)
+
+func TestParseTableQuery_Success(t *testing.T) {
+	createdFrom := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	cursor := domain.TableCursor{
+		CreatedAt: time.Date(2021, 6, 2, 10, 30, 0, 5, time.UTC),
+		ID:        uuid.MustParse("00812e8f-7fca-49a9-b141-9a52a0d0a82e"),
+	}
+
+	tests := []struct {
+		name          string
+		givenValues   url.Values
+		expectedQuery domain.TableQuery
+	}{
+		{
+			name:        "given no parameters, expect the defaults",
+			givenValues: url.Values{},
+			expectedQuery: domain.TableQuery{
+				Order: domain.Ascending,
+				Limit: DefaultTableLimit,
+			},
+		},
+		{
+			name: "given every parameter, expect them to be adapted",
+			givenValues: url.Values{
+				QueryState:       {"closed"},
+				QueryCreatedFrom: {"2021-06-01T01:00:00+01:00"},
+				QueryColour:      {"red"},
+				QueryOrder:       {"desc"},
+				QueryCursor:      {EncodeTableCursor(cursor)},
+				QueryLimit:       {"10"},
+				QueryBets:        {"false"},
+			},
+			expectedQuery: domain.TableQuery{
+				State:       domain.Closed,
+				CreatedFrom: &createdFrom,
+				Colour:      domain.Red,
+				Order:       domain.Descending,
+				After:       &cursor,
+				Limit:       10,
+				OmitBets:    true,
+			},
+		},
+		{
+			name:        "given a limit above the maximum, expect it to be capped",
+			givenValues: url.Values{QueryLimit: {"100000"}},
+			expectedQuery: domain.TableQuery{
+				Order: domain.Ascending,
+				Limit: MaxTableLimit,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := ParseTableQuery(test.givenValues)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(actual, test.expectedQuery) {
+				t.Fatal(cmp.Diff(actual, test.expectedQuery))
+			}
+		})
+	}
+}
+
+func TestParseTableQuery_Fail(t *testing.T) {
+	tests := []struct {
+		name          string
+		givenValues   url.Values
+		expectedError error
+	}{
+		{
+			name:          "given an unknown state, expect ErrInvalidState",
+			givenValues:   url.Values{QueryState: {"spinning"}},
+			expectedError: ErrInvalidState,
+		},
+		{
+			name:          "given an unparsable time, expect ErrInvalidTime",
+			givenValues:   url.Values{QueryCreatedTo: {"yesterday"}},
+			expectedError: ErrInvalidTime,
+		},
+		{
+			name:          "given an unknown colour, expect ErrInvalidColour",
+			givenValues:   url.Values{QueryColour: {"blue"}},
+			expectedError: ErrInvalidColour,
+		},
+		{
+			name:          "given a tampered cursor, expect ErrInvalidCursor",
+			givenValues:   url.Values{QueryCursor: {"bm90LWEtY3Vyc29y"}},
+			expectedError: ErrInvalidCursor,
+		},
+		{
+			name:          "given a negative limit, expect ErrInvalidLimit",
+			givenValues:   url.Values{QueryLimit: {"-1"}},
+			expectedError: ErrInvalidLimit,
+		},
+		{
+			name:          "given a non boolean bets, expect ErrInvalidBets",
+			givenValues:   url.Values{QueryBets: {"some"}},
+			expectedError: ErrInvalidBets,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseTableQuery(test.givenValues)
+			if err == nil {
+				t.Fatalf("expected %v, got nil", test.expectedError)
+			}
+
+			if !cmp.Equal(err, test.expectedError, cmpopts.EquateErrors()) {
+				t.Fatal(cmp.Diff(err, test.expectedError, cmpopts.EquateErrors()))
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"betting/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// SlipRequest represents the required fields to place several bets on a table at once.
+type SlipRequest struct {
+	Bets []BetRequest `json:"bets"`
+}
+
+// SlipResponse represents a Slip in responses to clients, Status is shared by each of its bets.
+type SlipResponse struct {
+	ID     uuid.UUID        `json:"id"`
+	Table  uuid.UUID        `json:"table"`
+	Status domain.BetStatus `json:"status"`
+	Bets   []BetResponse    `json:"bets"`
+}
+
+// AdaptSlipToDomain adapts a SlipRequest to a new domain.Slip, each of its bets is placed with the Slip on the given
+// table.
+func AdaptSlipToDomain(slip SlipRequest, tableID uuid.UUID) domain.Slip {
+	domainSlip := domain.Slip{
+		ID:    uuid.New(),
+		Table: tableID,
+		Bets:  make([]domain.Bet, len(slip.Bets)),
+	}
+
+	for i := range slip.Bets {
+		domainSlip.Bets[i] = AdaptBetToDomain(slip.Bets[i], tableID)
+		domainSlip.Bets[i].Slip = domainSlip.ID
+	}
+
+	return domainSlip
+}
+
+// AdaptSlipFromDomain adapts a domain.Slip to a SlipResponse.
+func AdaptSlipFromDomain(slip domain.Slip) SlipResponse {
+	return SlipResponse{
+		ID:     slip.ID,
+		Table:  slip.Table,
+		Status: slip.Status(),
+		Bets:   AdaptBetsFromDomain(slip.Bets),
+	}
+}
@@ -0,0 +1,110 @@
+package api
+
+import (
+	"betting/internal/domain"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// QuerySeries narrows the statistics to a single series of tables.
+const QuerySeries = "series"
+
+// ErrInvalidSeries is returned when the series given for the statistics is not a uuid.
+var ErrInvalidSeries = errors.New("series must be a uuid")
+
+// StatisticsResponse is the presentation representation of a domain.Statistics. Recent holds the latest results first,
+// Numbers the hits of each position and the spins since it last came up, Hot and Cold the positions which have come up
+// the most and the least.
+type StatisticsResponse struct {
+	Spins   int                `json:"spins"`
+	Recent  []Result           `json:"recent"`
+	Numbers []NumberStatistics `json:"numbers"`
+	Hot     []int              `json:"hot"`
+	Cold    []int              `json:"cold"`
+	Streaks []Streak           `json:"streaks"`
+}
+
+// Result is the presentation representation of a domain.Result.
+type Result struct {
+	Table    uuid.UUID     `json:"table"`
+	Position int           `json:"position"`
+	Colour   domain.Colour `json:"colour"`
+	SpunAt   time.Time     `json:"spunAt"`
+}
+
+// NumberStatistics is how often a position has come up and the number of spins since it last did.
+type NumberStatistics struct {
+	Position int           `json:"position"`
+	Colour   domain.Colour `json:"colour"`
+	Hits     int           `json:"hits"`
+	Gap      int           `json:"gap"`
+}
+
+// Streak is the presentation representation of a domain.Streak.
+type Streak struct {
+	Kind   domain.StreakKind `json:"kind"`
+	Value  string            `json:"value"`
+	Length int               `json:"length"`
+}
+
+// ParseStatisticsQuery returns the series given by the query parameters of the statistics, uuid.Nil when none is.
+func ParseStatisticsQuery(values url.Values) (uuid.UUID, error) {
+	v := values.Get(QuerySeries)
+	if v == "" {
+		return uuid.Nil, nil
+	}
+
+	series, err := uuid.Parse(v)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%v: %w", v, ErrInvalidSeries)
+	}
+
+	return series, nil
+}
+
+func AdaptStatisticsFromDomain(statistics domain.Statistics) StatisticsResponse {
+	recent := make([]Result, len(statistics.Recent))
+
+	for i := range statistics.Recent {
+		recent[i] = Result{
+			Table:    statistics.Recent[i].Table,
+			Position: statistics.Recent[i].Value,
+			Colour:   statistics.Recent[i].Colour,
+			SpunAt:   statistics.Recent[i].SpunAt,
+		}
+	}
+
+	numbers := make([]NumberStatistics, domain.Pockets)
+
+	for i := range numbers {
+		numbers[i] = NumberStatistics{
+			Position: i,
+			Colour:   domain.NumbersToColours[i],
+			Hits:     statistics.HitsOf(i),
+			Gap:      statistics.Gap(i),
+		}
+	}
+
+	streaks := make([]Streak, len(statistics.Streaks))
+
+	for i := range statistics.Streaks {
+		streaks[i] = Streak{
+			Kind:   statistics.Streaks[i].Kind,
+			Value:  statistics.Streaks[i].Value,
+			Length: statistics.Streaks[i].Length,
+		}
+	}
+
+	return StatisticsResponse{
+		Spins:   statistics.Spins,
+		Recent:  recent,
+		Numbers: numbers,
+		Hot:     statistics.Hot(),
+		Cold:    statistics.Cold(),
+		Streaks: streaks,
+	}
+}
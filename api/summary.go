@@ -0,0 +1,44 @@
+package api
+
+import (
+	"betting/internal/domain"
+
+	"github.com/Rhymond/go-money"
+	"github.com/google/uuid"
+)
+
+// TableSummaryResponse is the presentation representation of a domain.TableSummary.
+type TableSummaryResponse struct {
+	Table       uuid.UUID        `json:"table"`
+	BetCount    int              `json:"betCount"`
+	TotalStaked []*money.Money   `json:"totalStaked"`
+	Exposure    []PocketExposure `json:"exposure"`
+	HouseResult []*money.Money   `json:"houseResult"`
+}
+
+// PocketExposure is the presentation representation of a domain.PocketExposure.
+type PocketExposure struct {
+	Position  int            `json:"position"`
+	Colour    domain.Colour  `json:"colour"`
+	Liability []*money.Money `json:"liability"`
+}
+
+func AdaptTableSummaryFromDomain(summary domain.TableSummary) TableSummaryResponse {
+	exposure := make([]PocketExposure, len(summary.Exposure))
+
+	for i := range summary.Exposure {
+		exposure[i] = PocketExposure{
+			Position:  summary.Exposure[i].Position,
+			Colour:    summary.Exposure[i].Colour,
+			Liability: summary.Exposure[i].Liability,
+		}
+	}
+
+	return TableSummaryResponse{
+		Table:       summary.TableID,
+		BetCount:    summary.BetCount,
+		TotalStaked: summary.TotalStaked,
+		Exposure:    exposure,
+		HouseResult: summary.HouseResult,
+	}
+}
@@ -2,22 +2,88 @@ package api
 
 import (
 	"betting/internal/domain"
+	"time"
 
 	"github.com/google/uuid"
 )
 
-// TableResponse is the presentation representation of a domain.Table.
+// TableRequest represents the optional fields to create a table. Previous is the table of the round before, whose
+// imprisoned bets are carried onto the new table.
+type TableRequest struct {
+	Rules    *Rules     `json:"rules,omitempty"`
+	Previous *uuid.UUID `json:"previous,omitempty"`
+}
+
+// Rules configure how the bets of a table are settled. Wheels is only given for the multi_wheel variant.
+type Rules struct {
+	Zero    domain.ZeroRule `json:"zero"`
+	Variant domain.Variant  `json:"variant,omitempty"`
+	Wheels  int             `json:"wheels,omitempty"`
+}
+
+// TableResponse is the presentation representation of a domain.Table. Outcomes holds the result of every ball spun and
+// Outcome the first of them, Multipliers the numbers struck before the spin of a lightning table. Series is shared by
+// every round of a game. Each step of the table's lifecycle is given with the user who took it, the time of a step not
+// yet taken is null.
 type TableResponse struct {
-	ID       uuid.UUID     `json:"id"`
-	Bets     []BetResponse `json:"bets"`
-	IsClosed bool          `json:"isClosed"`
-	Outcome  *Outcome      `json:"outcome"`
+	ID          uuid.UUID     `json:"id"`
+	Bets        []BetResponse `json:"bets"`
+	IsClosed    bool          `json:"isClosed"`
+	Outcome     *Outcome      `json:"outcome"`
+	Outcomes    []Outcome     `json:"outcomes,omitempty"`
+	Multipliers []Multiplier  `json:"multipliers,omitempty"`
+	Rules       Rules         `json:"rules"`
+	Previous    *uuid.UUID    `json:"previous,omitempty"`
+	Series      uuid.UUID     `json:"series"`
+	CreatedAt   time.Time     `json:"createdAt"`
+	CreatedBy   string        `json:"createdBy,omitempty"`
+	ClosedAt    *time.Time    `json:"closedAt"`
+	ClosedBy    string        `json:"closedBy,omitempty"`
+	SpunAt      *time.Time    `json:"spunAt"`
+	SpunBy      string        `json:"spunBy,omitempty"`
+	SettledAt   *time.Time    `json:"settledAt"`
+	SettledBy   string        `json:"settledBy,omitempty"`
+}
+
+// AdaptTableRequestToDomain returns the domain.Rules and previous Table ID given by a TableRequest, uuid.Nil when there
+// is no previous Table.
+func AdaptTableRequestToDomain(table TableRequest) (domain.Rules, uuid.UUID) {
+	var rules domain.Rules
+	if table.Rules != nil {
+		rules = AdaptRulesToDomain(*table.Rules)
+	}
+
+	previous := uuid.Nil
+	if table.Previous != nil {
+		previous = *table.Previous
+	}
+
+	return rules, previous
+}
+
+// AdaptRulesFromDomain adapts domain.Rules to Rules.
+func AdaptRulesFromDomain(rules domain.Rules) Rules {
+	return Rules{
+		Zero:    rules.Zero,
+		Variant: rules.Variant,
+		Wheels:  rules.Wheels,
+	}
+}
+
+// AdaptRulesToDomain adapts Rules to domain.Rules.
+func AdaptRulesToDomain(rules Rules) domain.Rules {
+	return domain.Rules{
+		Zero:    rules.Zero,
+		Variant: rules.Variant,
+		Wheels:  rules.Wheels,
+	}
 }
 
 // Outcome is the result of the Table.
 type Outcome struct {
 	Position int           `json:"position"`
 	Colour   domain.Colour `json:"colour"`
+	Seed     int64         `json:"seed,omitempty"`
 }
 
 func AdaptOutcomeFromDomain(outcome *domain.Outcome) *Outcome {
@@ -28,18 +94,153 @@ func AdaptOutcomeFromDomain(outcome *domain.Outcome) *Outcome {
 	return &Outcome{
 		Position: outcome.Value,
 		Colour:   outcome.Colour,
+		Seed:     outcome.Seed,
+	}
+}
+
+// AdaptOutcomesFromDomain adapts the domain.Outcomes of every ball, nil when the Table has not been spun.
+func AdaptOutcomesFromDomain(outcomes []domain.Outcome) []Outcome {
+	if len(outcomes) == 0 {
+		return nil
+	}
+
+	os := make([]Outcome, len(outcomes))
+
+	for i := range outcomes {
+		os[i] = *AdaptOutcomeFromDomain(&outcomes[i])
+	}
+
+	return os
+}
+
+// AdaptOutcomesToDomain adapts the Outcomes of every ball to domain.Outcomes.
+func AdaptOutcomesToDomain(outcomes []Outcome) []domain.Outcome {
+	if len(outcomes) == 0 {
+		return nil
+	}
+
+	os := make([]domain.Outcome, len(outcomes))
+
+	for i := range outcomes {
+		os[i] = *AdaptOutcomeToDomain(&outcomes[i])
+	}
+
+	return os
+}
+
+// Multiplier is a number struck on a lightning table, a straight up on it is paid factor to 1.
+type Multiplier struct {
+	Position int `json:"position"`
+	Factor   int `json:"factor"`
+}
+
+// AdaptMultipliersFromDomain adapts domain.Multipliers to Multipliers, nil when no numbers were struck.
+func AdaptMultipliersFromDomain(multipliers []domain.Multiplier) []Multiplier {
+	if len(multipliers) == 0 {
+		return nil
+	}
+
+	ms := make([]Multiplier, len(multipliers))
+
+	for i := range multipliers {
+		ms[i] = Multiplier{Position: multipliers[i].Position, Factor: multipliers[i].Factor}
+	}
+
+	return ms
+}
+
+// AdaptMultipliersToDomain adapts Multipliers to domain.Multipliers.
+func AdaptMultipliersToDomain(multipliers []Multiplier) []domain.Multiplier {
+	if len(multipliers) == 0 {
+		return nil
+	}
+
+	ms := make([]domain.Multiplier, len(multipliers))
+
+	for i := range multipliers {
+		ms[i] = domain.Multiplier{Position: multipliers[i].Position, Factor: multipliers[i].Factor}
+	}
+
+	return ms
+}
+
+// AdaptOutcomeToDomain adapts an Outcome to a domain.Outcome.
+func AdaptOutcomeToDomain(outcome *Outcome) *domain.Outcome {
+	if outcome == nil {
+		return nil
+	}
+
+	return &domain.Outcome{
+		Value:  outcome.Position,
+		Colour: outcome.Colour,
+		Seed:   outcome.Seed,
 	}
 }
 
 func AdaptTableFromDomain(table domain.Table) TableResponse {
+	var previous *uuid.UUID
+	if table.Previous != uuid.Nil {
+		previous = &table.Previous
+	}
+
+	var outcome *domain.Outcome
+	if table.IsSpun() {
+		outcome = &table.Outcomes[0]
+	}
+
 	return TableResponse{
-		ID:       table.ID,
-		Bets:     AdaptBetsFromDomain(table.Bets),
-		IsClosed: table.IsClosed,
-		Outcome:  AdaptOutcomeFromDomain(table.Outcome),
+		ID:          table.ID,
+		Bets:        AdaptBetsFromDomain(table.Bets),
+		IsClosed:    table.IsClosed,
+		Outcome:     AdaptOutcomeFromDomain(outcome),
+		Outcomes:    AdaptOutcomesFromDomain(table.Outcomes),
+		Multipliers: AdaptMultipliersFromDomain(table.Multipliers),
+		Rules:       AdaptRulesFromDomain(table.Rules),
+		Previous:    previous,
+		Series:      table.Series,
+		CreatedAt:   table.CreatedAt,
+		CreatedBy:   table.CreatedBy,
+		ClosedAt:    table.ClosedAt,
+		ClosedBy:    table.ClosedBy,
+		SpunAt:      table.SpunAt,
+		SpunBy:      table.SpunBy,
+		SettledAt:   table.SettledAt,
+		SettledBy:   table.SettledBy,
 	}
 }
 
+// AdaptTableToDomain adapts a previously returned TableResponse back to a domain.Table. A response recorded before
+// tables had several balls only has its Outcome.
+func AdaptTableToDomain(table TableResponse) domain.Table {
+	t := domain.Table{
+		ID:          table.ID,
+		Bets:        AdaptBetsToDomain(table.Bets),
+		IsClosed:    table.IsClosed,
+		Outcomes:    AdaptOutcomesToDomain(table.Outcomes),
+		Multipliers: AdaptMultipliersToDomain(table.Multipliers),
+		Rules:       AdaptRulesToDomain(table.Rules),
+		Series:      table.Series,
+		CreatedAt:   table.CreatedAt,
+		CreatedBy:   table.CreatedBy,
+		ClosedAt:    table.ClosedAt,
+		ClosedBy:    table.ClosedBy,
+		SpunAt:      table.SpunAt,
+		SpunBy:      table.SpunBy,
+		SettledAt:   table.SettledAt,
+		SettledBy:   table.SettledBy,
+	}
+
+	if len(t.Outcomes) == 0 && table.Outcome != nil {
+		t.Outcomes = []domain.Outcome{*AdaptOutcomeToDomain(table.Outcome)}
+	}
+
+	if table.Previous != nil {
+		t.Previous = *table.Previous
+	}
+
+	return t
+}
+
 func AdaptTablesFromDomain(tables []domain.Table) []TableResponse {
 	ts := make([]TableResponse, len(tables))
 
@@ -0,0 +1,61 @@
+package audit
+
+import (
+	"betting/api"
+	"betting/internal/domain"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// NewCmd associates the audit command with verifying an exported audit log.
+func NewCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "audit [audit.json...]",
+		Short: "audit verifies the hash chain of an audit log",
+		Long: "audit reads the pages of an audit log as returned by GET /v1/audit without a subject or actor, in the " +
+			"order they were fetched, and checks that no entry has been altered, removed or reordered.",
+		Args: cobra.MinimumNArgs(1),
+		RunE: Run,
+	}
+}
+
+// Run verifies the audit entries held in the given files, taken as one log in order, and reports how many were checked.
+func Run(cmd *cobra.Command, args []string) error {
+	var entries []api.AuditEntry
+
+	for _, name := range args {
+		page, err := read(name)
+		if err != nil {
+			return err
+		}
+
+		entries = append(entries, page...)
+	}
+
+	err := domain.VerifyAudit(api.AdaptAuditEntriesToDomain(entries))
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "verified %d audit entries\n", len(entries))
+
+	return nil
+}
+
+// read decodes a page of audit entries from the named file.
+func read(name string) ([]api.AuditEntry, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var entries []api.AuditEntry
+
+	err = json.NewDecoder(f).Decode(&entries)
+
+	return entries, err
+}
@@ -0,0 +1,112 @@
+package export
+
+import (
+	"betting/api"
+	"betting/internal/pkg/responses"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// ErrExportFailed is returned when the server does not serve the export.
+var ErrExportFailed = errors.New("export failed")
+
+// Flags accepted by the export command.
+const (
+	FlagURL     = "url"
+	FlagRecords = "records"
+	FlagFormat  = "format"
+	FlagFrom    = "from"
+	FlagTo      = "to"
+	FlagOutput  = "output"
+)
+
+// NewCmd associates the export command with streaming an export from a running server.
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "export streams the tables, bets or outcomes of a range of time as CSV or JSON Lines",
+		Long: "export reads GET /v1/export of a running server, which writes the records of the tables created within " +
+			"the range as they are read, and copies it to stdout or the named file.",
+		Args: cobra.NoArgs,
+		RunE: Run,
+	}
+
+	cmd.Flags().String(FlagURL, "http://localhost:8080", "address of the server")
+	cmd.Flags().String(FlagRecords, api.DefaultRecords.String(), "records exported, one of tables, bets or outcomes")
+	cmd.Flags().String(FlagFormat, string(api.DefaultFormat), "format of the export, one of csv or jsonl")
+	cmd.Flags().String(FlagFrom, "", "export the tables created at or after this RFC 3339 time")
+	cmd.Flags().String(FlagTo, "", "export the tables created before this RFC 3339 time")
+	cmd.Flags().StringP(FlagOutput, "o", "", "file the export is written to, stdout when empty")
+
+	return cmd
+}
+
+// Run requests the export described by the flags and copies it to its output as it arrives.
+func Run(cmd *cobra.Command, _ []string) error {
+	flags := cmd.Flags()
+
+	address, _ := flags.GetString(FlagURL)
+	records, _ := flags.GetString(FlagRecords)
+	format, _ := flags.GetString(FlagFormat)
+	from, _ := flags.GetString(FlagFrom)
+	to, _ := flags.GetString(FlagTo)
+	output, _ := flags.GetString(FlagOutput)
+
+	values := url.Values{
+		api.QueryRecords:     {records},
+		api.QueryFormat:      {format},
+		api.QueryCreatedFrom: {from},
+		api.QueryCreatedTo:   {to},
+	}
+
+	_, _, err := api.ParseExportQuery(values)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(cmd.Context(), http.MethodGet, address+"/v1/export?"+values.Encode(), nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var problem responses.Error
+
+		if json.NewDecoder(resp.Body).Decode(&problem) != nil || problem.Detail == "" {
+			problem.Detail = resp.Status
+		}
+
+		return fmt.Errorf("%v: %w", problem.Detail, ErrExportFailed)
+	}
+
+	var w io.Writer = cmd.OutOrStdout()
+
+	if output != "" {
+		var f *os.File
+
+		f, err = os.Create(output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		w = f
+	}
+
+	_, err = io.Copy(w, resp.Body)
+
+	return err
+}
@@ -1,6 +1,9 @@
 package main
 
 import (
+	"betting/cmd/audit"
+	"betting/cmd/export"
+	"betting/cmd/replay"
 	"betting/cmd/serve"
 	"fmt"
 	"os"
@@ -11,9 +14,12 @@ import (
 
 var rootCmd = &cobra.Command{}
 
-// init adds the serve command to the chain of available commands.
+// init adds the serve, replay, audit and export commands to the chain of available commands.
 func init() {
 	rootCmd.AddCommand(serve.NewCmd())
+	rootCmd.AddCommand(replay.NewCmd())
+	rootCmd.AddCommand(audit.NewCmd())
+	rootCmd.AddCommand(export.NewCmd())
 }
 
 // main sets the path to the config file and executes the command chain found in the root command.
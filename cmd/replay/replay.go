@@ -0,0 +1,66 @@
+package replay
+
+import (
+	"betting/api"
+	"betting/internal/replay"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// ErrNotReproduced is returned when the replayed settlement differs from the recording.
+var ErrNotReproduced = errors.New("recorded settlement could not be reproduced")
+
+// NewCmd associates the replay command with re-running a recorded table.
+func NewCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "replay [table.json]",
+		Short: "replay re-runs a recorded table's bets against its seed to reproduce settlement",
+		Long: "replay reads a table as returned by GET /v1/tables/{id}, spins it again with the seed recorded on its " +
+			"outcome, settles its bets and reports any differences from the recording.",
+		Args: cobra.ExactArgs(1),
+		RunE: Run,
+	}
+}
+
+// Run replays the table recorded in the given file and writes the replayed table to stdout.
+func Run(cmd *cobra.Command, args []string) error {
+	f, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var recorded api.TableResponse
+
+	err = json.NewDecoder(f).Decode(&recorded)
+	if err != nil {
+		return err
+	}
+
+	result, err := replay.New().Replay(cmd.Context(), api.AdaptTableToDomain(recorded))
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(cmd.OutOrStdout())
+	encoder.SetIndent("", "  ")
+
+	err = encoder.Encode(api.AdaptTableFromDomain(result.Replayed))
+	if err != nil {
+		return err
+	}
+
+	for i := range result.Mismatches {
+		fmt.Fprintln(cmd.ErrOrStderr(), result.Mismatches[i])
+	}
+
+	if !result.Reproduced() {
+		return ErrNotReproduced
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package audit
+
+import (
+	"betting/api"
+	"betting/cmd/serve/problem"
+	"betting/internal/domain"
+	"betting/internal/pkg/logging"
+	"betting/internal/pkg/responses"
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// Controller provides business logic capable of reading the audit log.
+type Controller interface {
+	List(ctx context.Context, query domain.AuditQuery) (domain.AuditPage, error)
+}
+
+// Handler handles requests relating to the audit log.
+type Handler struct {
+	Controller Controller
+}
+
+// New instantiates a Handler.
+func New(controller Controller) Handler {
+	return Handler{
+		Controller: controller,
+	}
+}
+
+// List shows a page of the audit log, oldest first. The log is read only, it is written as each change is made.
+func (h Handler) List(w http.ResponseWriter, r *http.Request) {
+	query, err := api.ParseAuditQuery(r.URL.Query())
+	if err != nil {
+		logging.FromContext(r.Context()).WithError(err).Error("invalid audit query")
+
+		problem.WriteInvalid(w, r, err)
+		return
+	}
+
+	page, err := h.Controller.List(r.Context(), query)
+	if err != nil {
+		logging.FromContext(r.Context()).WithError(err).Error("failed to locate audit entries")
+
+		problem.Write(w, r, err)
+		return
+	}
+
+	if page.Next != 0 {
+		next := *r.URL
+		values := next.Query()
+		values.Set(api.QueryAfter, strconv.FormatInt(page.Next, 10))
+		next.RawQuery = values.Encode()
+
+		w.Header().Set("Link", fmt.Sprintf("<%v>; rel=\"next\"", next.RequestURI()))
+	}
+
+	resBody := api.AdaptAuditEntriesFromDomain(page.Entries)
+
+	responses.NewJSON(w).Success(http.StatusOK, resBody)
+}
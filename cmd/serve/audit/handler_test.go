@@ -0,0 +1,187 @@
+package audit
+
+import (
+	"betting/api"
+	"betting/cmd/serve/problem"
+	"betting/internal/domain"
+	"betting/internal/pkg/responses"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+func TestHandler_List_Success(t *testing.T) {
+	recordedAt := time.Date(2021, 10, 11, 12, 0, 0, 0, time.UTC)
+	subject := uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d")
+
+	tests := []struct {
+		name            string
+		givenController *mockController
+		givenURL        string
+		expectedQuery   domain.AuditQuery
+		expectedLink    string
+		expectedBody    []api.AuditEntry
+	}{
+		{
+			name:            "given nothing recorded, expect 200 with no entries",
+			givenController: &mockController{},
+			givenURL:        "/v1/audit",
+			expectedQuery:   domain.AuditQuery{Limit: api.DefaultAuditLimit},
+			expectedBody:    []api.AuditEntry{},
+		},
+		{
+			name: "given more entries than the limit, expect 200 with a link to the next page",
+			givenController: &mockController{
+				GivenPage: domain.AuditPage{
+					Entries: []domain.AuditEntry{{
+						Sequence:   3,
+						Action:     domain.SpinTable,
+						Subject:    subject,
+						Actor:      "croupier-7",
+						RecordedAt: recordedAt,
+						Changes:    []domain.Change{{Field: "IsClosed", Before: "false", After: "true"}},
+						Hash:       "c",
+					}},
+					Next: 3,
+				},
+			},
+			givenURL:      "/v1/audit?subject=160998da-2d89-4f06-a690-fd189213958d&limit=1",
+			expectedQuery: domain.AuditQuery{Subject: subject, Limit: 1},
+			expectedLink:  `</v1/audit?after=3&limit=1&subject=160998da-2d89-4f06-a690-fd189213958d>; rel="next"`,
+			expectedBody: []api.AuditEntry{{
+				Sequence:   3,
+				Action:     domain.SpinTable,
+				Subject:    subject,
+				Actor:      "croupier-7",
+				RecordedAt: recordedAt,
+				Changes:    []api.Change{{Field: "IsClosed", Before: "false", After: "true"}},
+				Hash:       "c",
+			}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler := New(test.givenController)
+
+			rr := httptest.NewRecorder()
+
+			req := httptest.NewRequest(http.MethodGet, test.givenURL, nil)
+
+			router := new(mux.Router)
+			router.HandleFunc("/v1/audit", handler.List)
+			router.ServeHTTP(rr, req)
+
+			resp := rr.Result()
+
+			if !cmp.Equal(resp.StatusCode, http.StatusOK) {
+				t.Fatal(cmp.Diff(resp.StatusCode, http.StatusOK))
+			}
+
+			if !cmp.Equal(test.givenController.query, test.expectedQuery) {
+				t.Fatal(cmp.Diff(test.givenController.query, test.expectedQuery))
+			}
+
+			if !cmp.Equal(resp.Header.Get("Link"), test.expectedLink) {
+				t.Fatal(cmp.Diff(resp.Header.Get("Link"), test.expectedLink))
+			}
+
+			var res []api.AuditEntry
+			err := json.NewDecoder(resp.Body).Decode(&res)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(res, test.expectedBody) {
+				t.Fatal(cmp.Diff(res, test.expectedBody))
+			}
+		})
+	}
+}
+
+func TestHandler_List_Fail(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenController Controller
+		givenURL        string
+		expectedStatus  int
+		expectedBody    responses.Error
+	}{
+		{
+			name:            "given an after which is not a number, expect 400",
+			givenController: &mockController{},
+			givenURL:        "/v1/audit?after=abc",
+			expectedStatus:  http.StatusBadRequest,
+			expectedBody: responses.Error{
+				Type:     problem.TypeInvalid,
+				Title:    http.StatusText(http.StatusBadRequest),
+				Status:   http.StatusBadRequest,
+				Detail:   "abc: after must be a non-negative integer",
+				Instance: "/v1/audit",
+			},
+		},
+		{
+			name: "given controller error, expect 500 without its detail",
+			givenController: &mockController{
+				GivenError: errors.New("storage unavailable"),
+			},
+			givenURL:       "/v1/audit",
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: responses.Error{
+				Type:     responses.BlankType,
+				Title:    http.StatusText(http.StatusInternalServerError),
+				Status:   http.StatusInternalServerError,
+				Detail:   http.StatusText(http.StatusInternalServerError),
+				Instance: "/v1/audit",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler := New(test.givenController)
+
+			rr := httptest.NewRecorder()
+
+			req := httptest.NewRequest(http.MethodGet, test.givenURL, nil)
+
+			router := new(mux.Router)
+			router.HandleFunc("/v1/audit", handler.List)
+			router.ServeHTTP(rr, req)
+
+			resp := rr.Result()
+
+			if !cmp.Equal(resp.StatusCode, test.expectedStatus) {
+				t.Fatal(cmp.Diff(resp.StatusCode, test.expectedStatus))
+			}
+
+			var res responses.Error
+			err := json.NewDecoder(resp.Body).Decode(&res)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(res, test.expectedBody) {
+				t.Fatal(cmp.Diff(res, test.expectedBody))
+			}
+		})
+	}
+}
+
+type mockController struct {
+	GivenPage  domain.AuditPage
+	GivenError error
+	query      domain.AuditQuery
+}
+
+func (m *mockController) List(_ context.Context, query domain.AuditQuery) (domain.AuditPage, error) {
+	m.query = query
+
+	return m.GivenPage, m.GivenError
+}
@@ -0,0 +1,22 @@
+package audit
+
+import (
+	"betting/internal/audit"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// NewController builds the audit log, held in the given storage and stamped by the given clock.
+func NewController(auditStorage audit.StorageProvider, clock audit.Clock) audit.Controller {
+	return audit.NewController(audit.NewRepository(auditStorage), clock)
+}
+
+// Load registers the audit routes, served by the given Controller.
+func Load(r *mux.Router, controller Controller) *mux.Router {
+	handler := New(controller)
+
+	r.HandleFunc("/v1/audit", handler.List).Methods(http.MethodGet)
+
+	return r
+}
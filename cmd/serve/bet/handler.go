@@ -2,15 +2,15 @@ package bet
 
 import (
 	"betting/api"
+	"betting/cmd/serve/problem"
 	"betting/internal/domain"
+	"betting/internal/pkg/logging"
 	"betting/internal/pkg/responses"
 	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
 
-	log "github.com/sirupsen/logrus"
-
 	"github.com/google/uuid"
 
 	"github.com/gorilla/mux"
@@ -31,11 +31,13 @@ type Controller interface {
 // ControllerWriter provides business logic capable of writes.
 type ControllerWriter interface {
 	Create(ctx context.Context, bet domain.Bet) (domain.Bet, error)
+	CreateSlip(ctx context.Context, slip domain.Slip) (domain.Slip, error)
 }
 
 // ControllerReader provides business logic capable of reads.
 type ControllerReader interface {
 	Get(ctx context.Context, id uuid.UUID) (domain.Bet, error)
+	GetSlip(ctx context.Context, id uuid.UUID) (domain.Slip, error)
 }
 
 // Handler handles requests relating to bets.
@@ -56,15 +58,15 @@ func (h Handler) Create(w http.ResponseWriter, r *http.Request) {
 
 	id, ok := path["id"]
 	if !ok {
-		log.Errorf("invalid id: %v", ErrNoIDPresent)
-		responses.NewJSON(w).Fail(http.StatusBadRequest, ErrNoIDPresent)
+		logging.FromContext(r.Context()).WithError(ErrNoIDPresent).Error("invalid id")
+		problem.WriteInvalid(w, r, ErrNoIDPresent)
 		return
 	}
 
 	tableID, err := uuid.Parse(id)
 	if err != nil {
-		log.Errorf("invalid id: %v, %v", id, ErrInvalidID)
-		responses.NewJSON(w).Fail(http.StatusBadRequest, ErrInvalidID)
+		logging.FromContext(r.Context()).WithField(logging.FieldID, id).WithError(ErrInvalidID).Error("invalid id")
+		problem.WriteInvalid(w, r, ErrInvalidID)
 		return
 	}
 
@@ -73,8 +75,8 @@ func (h Handler) Create(w http.ResponseWriter, r *http.Request) {
 
 	err = decoder.Decode(&betRequest)
 	if err != nil {
-		log.Errorf("could not decode request body: %v", err)
-		responses.NewJSON(w).Fail(http.StatusBadRequest, err)
+		logging.FromContext(r.Context()).WithError(err).Error("could not decode request body")
+		problem.WriteInvalid(w, r, err)
 		return
 	}
 
@@ -82,14 +84,14 @@ func (h Handler) Create(w http.ResponseWriter, r *http.Request) {
 
 	bet, err := h.Controller.Create(r.Context(), domainBet)
 	if err != nil {
-		log.Errorf("failed to create bet: %v, %v", tableID, err)
-		responses.NewJSON(w).Fail(http.StatusBadRequest, err)
+		logging.FromContext(r.Context()).WithField(logging.FieldTableID, tableID).WithError(err).Error("failed to create bet")
+		problem.Write(w, r, err)
 		return
 	}
 
 	resBody := api.AdaptBetFromDomain(bet)
 
-	log.Infof("created bet: %v", bet.ID)
+	logging.FromContext(r.Context()).WithField(logging.FieldBetID, bet.ID).Info("created bet")
 
 	responses.NewJSON(w).Success(http.StatusCreated, resBody)
 }
@@ -100,29 +102,105 @@ func (h Handler) Get(w http.ResponseWriter, r *http.Request) {
 
 	id, ok := path["id"]
 	if !ok {
-		log.Errorf("invalid id: %v", ErrNoIDPresent)
-		responses.NewJSON(w).Fail(http.StatusBadRequest, ErrNoIDPresent)
+		logging.FromContext(r.Context()).WithError(ErrNoIDPresent).Error("invalid id")
+		problem.WriteInvalid(w, r, ErrNoIDPresent)
 		return
 	}
 
 	betID, err := uuid.Parse(id)
 	if err != nil {
-		log.Errorf("invalid id: %v, %v", id, ErrInvalidID)
+		logging.FromContext(r.Context()).WithField(logging.FieldID, id).WithError(ErrInvalidID).Error("invalid id")
 
-		responses.NewJSON(w).Fail(http.StatusBadRequest, ErrInvalidID)
+		problem.WriteInvalid(w, r, ErrInvalidID)
 		return
 	}
 
 	bet, err := h.Controller.Get(r.Context(), betID)
 	if err != nil {
-		log.Errorf("failed to locate bet: %v, %v", betID, err)
-		responses.NewJSON(w).Fail(http.StatusBadRequest, err)
+		logging.FromContext(r.Context()).WithField(logging.FieldBetID, betID).WithError(err).Error("failed to locate bet")
+		problem.Write(w, r, err)
 		return
 	}
 
 	resBody := api.AdaptBetFromDomain(bet)
 
-	log.Infof("located bet: %v", bet.ID)
+	logging.FromContext(r.Context()).WithField(logging.FieldBetID, bet.ID).Info("located bet")
+
+	responses.NewJSON(w).Success(http.StatusOK, resBody)
+}
+
+// CreateSlip places every bet of the given slip on the table, or none of them.
+func (h Handler) CreateSlip(w http.ResponseWriter, r *http.Request) {
+	path := mux.Vars(r)
+
+	id, ok := path["id"]
+	if !ok {
+		logging.FromContext(r.Context()).WithError(ErrNoIDPresent).Error("invalid id")
+		problem.WriteInvalid(w, r, ErrNoIDPresent)
+		return
+	}
+
+	tableID, err := uuid.Parse(id)
+	if err != nil {
+		logging.FromContext(r.Context()).WithField(logging.FieldID, id).WithError(ErrInvalidID).Error("invalid id")
+		problem.WriteInvalid(w, r, ErrInvalidID)
+		return
+	}
+
+	var slipRequest api.SlipRequest
+	decoder := json.NewDecoder(r.Body)
+
+	err = decoder.Decode(&slipRequest)
+	if err != nil {
+		logging.FromContext(r.Context()).WithError(err).Error("could not decode request body")
+		problem.WriteInvalid(w, r, err)
+		return
+	}
+
+	domainSlip := api.AdaptSlipToDomain(slipRequest, tableID)
+
+	slip, err := h.Controller.CreateSlip(r.Context(), domainSlip)
+	if err != nil {
+		logging.FromContext(r.Context()).WithField(logging.FieldTableID, tableID).WithError(err).Error("failed to create slip")
+		problem.Write(w, r, err)
+		return
+	}
+
+	resBody := api.AdaptSlipFromDomain(slip)
+
+	logging.FromContext(r.Context()).WithField(logging.FieldSlipID, slip.ID).Info("created slip")
+
+	responses.NewJSON(w).Success(http.StatusCreated, resBody)
+}
+
+// GetSlip retrieves the slip for the given ID with its bets.
+func (h Handler) GetSlip(w http.ResponseWriter, r *http.Request) {
+	path := mux.Vars(r)
+
+	id, ok := path["id"]
+	if !ok {
+		logging.FromContext(r.Context()).WithError(ErrNoIDPresent).Error("invalid id")
+		problem.WriteInvalid(w, r, ErrNoIDPresent)
+		return
+	}
+
+	slipID, err := uuid.Parse(id)
+	if err != nil {
+		logging.FromContext(r.Context()).WithField(logging.FieldID, id).WithError(ErrInvalidID).Error("invalid id")
+		problem.WriteInvalid(w, r, ErrInvalidID)
+		return
+	}
+
+	slip, err := h.Controller.GetSlip(r.Context(), slipID)
+	if err != nil {
+		logging.FromContext(r.Context()).WithField(logging.FieldSlipID, slipID).WithError(err).Error("failed to locate slip")
+		problem.Write(w, r, err)
+		return
+	}
+
+	resBody := api.AdaptSlipFromDomain(slip)
+
+	logging.FromContext(r.Context()).WithField(logging.FieldSlipID, slip.ID).Info("located slip")
 
 	responses.NewJSON(w).Success(http.StatusOK, resBody)
 }
@@ -2,7 +2,10 @@ package bet
 
 import (
 	"betting/api"
+	"betting/cmd/serve/problem"
+	"betting/internal/bet"
 	"betting/internal/domain"
+	"betting/internal/pkg/exposure"
 	"betting/internal/pkg/responses"
 	"betting/storage/memory"
 	"betting/testing/opts"
@@ -108,20 +111,26 @@ func TestHandler_Create_Fail(t *testing.T) {
 			givenURL:       "/v1/tables/test/bet",
 			expectedStatus: http.StatusBadRequest,
 			expectedBody: responses.Error{
-				Status: http.StatusBadRequest,
-				Detail: ErrInvalidID.Error(),
+				Type:     problem.TypeInvalid,
+				Title:    http.StatusText(http.StatusBadRequest),
+				Status:   http.StatusBadRequest,
+				Detail:   ErrInvalidID.Error(),
+				Instance: "/v1/tables/test/bet",
 			},
 		},
 		{
-			name: "given controller error, expect 400",
+			name: "given controller error, expect 409",
 			givenController: mockController{
 				GivenCreateError: memory.ErrDuplicateKey,
 			},
 			givenURL:       "/v1/tables/bd88dfac-a3b9-43ee-ac7a-f958de23b26d/bet",
-			expectedStatus: http.StatusBadRequest,
+			expectedStatus: http.StatusConflict,
 			expectedBody: responses.Error{
-				Status: http.StatusBadRequest,
-				Detail: memory.ErrDuplicateKey.Error(),
+				Type:     problem.TypeConflict,
+				Title:    http.StatusText(http.StatusConflict),
+				Status:   http.StatusConflict,
+				Detail:   memory.ErrDuplicateKey.Error(),
+				Instance: "/v1/tables/bd88dfac-a3b9-43ee-ac7a-f958de23b26d/bet",
 			},
 		},
 	}
@@ -237,20 +246,26 @@ func TestHandler_Get_Fail(t *testing.T) {
 			givenURL:       "/v1/bets/test",
 			expectedStatus: http.StatusBadRequest,
 			expectedBody: responses.Error{
-				Status: http.StatusBadRequest,
-				Detail: ErrInvalidID.Error(),
+				Type:     problem.TypeInvalid,
+				Title:    http.StatusText(http.StatusBadRequest),
+				Status:   http.StatusBadRequest,
+				Detail:   ErrInvalidID.Error(),
+				Instance: "/v1/bets/test",
 			},
 		},
 		{
-			name: "given controller error, expect 400",
+			name: "given controller error, expect 409",
 			givenController: mockController{
 				GivenGetError: memory.ErrDuplicateKey,
 			},
 			givenURL:       "/v1/bets/00812e8f-7fca-49a9-b141-9a52a0d0a82e",
-			expectedStatus: http.StatusBadRequest,
+			expectedStatus: http.StatusConflict,
 			expectedBody: responses.Error{
-				Status: http.StatusBadRequest,
-				Detail: memory.ErrDuplicateKey.Error(),
+				Type:     problem.TypeConflict,
+				Title:    http.StatusText(http.StatusConflict),
+				Status:   http.StatusConflict,
+				Detail:   memory.ErrDuplicateKey.Error(),
+				Instance: "/v1/bets/00812e8f-7fca-49a9-b141-9a52a0d0a82e",
 			},
 		},
 	}
@@ -285,11 +300,264 @@ func TestHandler_Get_Fail(t *testing.T) {
 	}
 }
 
+func TestHandler_CreateSlip_Success(t *testing.T) {
+	slipID := uuid.MustParse("8f1f5f5c-7a43-4d0e-a4a8-1b8a2b8f4c11")
+
+	tests := []struct {
+		name            string
+		givenController Controller
+		givenURL        string
+		givenBody       api.SlipRequest
+		expectedStatus  int
+		expectedBody    api.SlipResponse
+	}{
+		{
+			name: "given controller success, expect 201",
+			givenController: mockController{
+				GivenCreateSlip: domain.Slip{
+					ID:    slipID,
+					Table: uuid.MustParse("bd88dfac-a3b9-43ee-ac7a-f958de23b26d"),
+					Bets: []domain.Bet{
+						{
+							ID:     uuid.MustParse("00812e8f-7fca-49a9-b141-9a52a0d0a82e"),
+							Status: domain.Unsettled,
+							Table:  uuid.MustParse("bd88dfac-a3b9-43ee-ac7a-f958de23b26d"),
+							Stake:  money.New(100, "GBP"),
+							Slip:   slipID,
+						},
+					},
+				},
+			},
+			givenURL: "/v1/tables/bd88dfac-a3b9-43ee-ac7a-f958de23b26d/slip",
+			givenBody: api.SlipRequest{
+				Bets: []api.BetRequest{{SelectedSpaces: []int{14}, Stake: money.New(100, "GBP")}},
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody: api.SlipResponse{
+				ID:     slipID,
+				Table:  uuid.MustParse("bd88dfac-a3b9-43ee-ac7a-f958de23b26d"),
+				Status: domain.Unsettled,
+				Bets: []api.BetResponse{
+					{
+						ID:     uuid.MustParse("00812e8f-7fca-49a9-b141-9a52a0d0a82e"),
+						Status: domain.Unsettled,
+						Slip:   &slipID,
+						BetRequest: api.BetRequest{
+							Stake: money.New(100, "GBP"),
+							Table: uuid.MustParse("bd88dfac-a3b9-43ee-ac7a-f958de23b26d"),
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler := New(test.givenController)
+
+			rr := httptest.NewRecorder()
+
+			b, err := json.Marshal(test.givenBody)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			req := httptest.NewRequest(http.MethodPost, test.givenURL, bytes.NewReader(b))
+
+			router := new(mux.Router)
+			router.HandleFunc("/v1/tables/{id}/slip", handler.CreateSlip)
+			router.ServeHTTP(rr, req)
+
+			resp := rr.Result()
+
+			if !cmp.Equal(resp.StatusCode, test.expectedStatus) {
+				t.Fatal(cmp.Diff(resp.StatusCode, test.expectedStatus))
+			}
+
+			var res api.SlipResponse
+			err = json.NewDecoder(resp.Body).Decode(&res)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(res, test.expectedBody, opts.MoneyComparer) {
+				t.Fatal(cmp.Diff(res, test.expectedBody, opts.MoneyComparer))
+			}
+		})
+	}
+}
+
+func TestHandler_CreateSlip_Fail(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenController Controller
+		givenURL        string
+		givenBody       api.SlipRequest
+		expectedStatus  int
+		expectedBody    responses.Error
+	}{
+		{
+			name:            "given an invalid id, expect 400",
+			givenController: mockController{},
+			givenURL:        "/v1/tables/test/slip",
+			expectedStatus:  http.StatusBadRequest,
+			expectedBody: responses.Error{
+				Type:     problem.TypeInvalid,
+				Title:    http.StatusText(http.StatusBadRequest),
+				Status:   http.StatusBadRequest,
+				Detail:   ErrInvalidID.Error(),
+				Instance: "/v1/tables/test/slip",
+			},
+		},
+		{
+			name: "given an empty slip, expect 400",
+			givenController: mockController{
+				GivenCreateSlipError: bet.ErrEmptySlip,
+			},
+			givenURL:       "/v1/tables/bd88dfac-a3b9-43ee-ac7a-f958de23b26d/slip",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: responses.Error{
+				Type:     problem.TypeInvalid,
+				Title:    http.StatusText(http.StatusBadRequest),
+				Status:   http.StatusBadRequest,
+				Detail:   bet.ErrEmptySlip.Error(),
+				Instance: "/v1/tables/bd88dfac-a3b9-43ee-ac7a-f958de23b26d/slip",
+			},
+		},
+		{
+			name: "given a slip exceeding the limit, expect 422",
+			givenController: mockController{
+				GivenCreateSlipError: exposure.ErrLimitExceeded,
+			},
+			givenURL: "/v1/tables/bd88dfac-a3b9-43ee-ac7a-f958de23b26d/slip",
+			givenBody: api.SlipRequest{
+				Bets: []api.BetRequest{{SelectedSpaces: []int{14}, Stake: money.New(100, "GBP")}},
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedBody: responses.Error{
+				Type:     problem.TypeLimitExceeded,
+				Title:    http.StatusText(http.StatusUnprocessableEntity),
+				Status:   http.StatusUnprocessableEntity,
+				Detail:   exposure.ErrLimitExceeded.Error(),
+				Instance: "/v1/tables/bd88dfac-a3b9-43ee-ac7a-f958de23b26d/slip",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler := New(test.givenController)
+
+			rr := httptest.NewRecorder()
+
+			b, err := json.Marshal(test.givenBody)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			req := httptest.NewRequest(http.MethodPost, test.givenURL, bytes.NewReader(b))
+
+			router := new(mux.Router)
+			router.HandleFunc("/v1/tables/{id}/slip", handler.CreateSlip)
+			router.ServeHTTP(rr, req)
+
+			resp := rr.Result()
+
+			if !cmp.Equal(resp.StatusCode, test.expectedStatus) {
+				t.Fatal(cmp.Diff(resp.StatusCode, test.expectedStatus))
+			}
+
+			var res responses.Error
+			err = json.NewDecoder(resp.Body).Decode(&res)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(res, test.expectedBody) {
+				t.Fatal(cmp.Diff(res, test.expectedBody))
+			}
+		})
+	}
+}
+
+func TestHandler_GetSlip(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenController Controller
+		givenURL        string
+		expectedStatus  int
+		expectedBody    api.SlipResponse
+	}{
+		{
+			name: "given controller success, expect 200 with the status shared by its bets",
+			givenController: mockController{
+				GivenGetSlip: domain.Slip{
+					ID:    uuid.MustParse("8f1f5f5c-7a43-4d0e-a4a8-1b8a2b8f4c11"),
+					Table: uuid.MustParse("bd88dfac-a3b9-43ee-ac7a-f958de23b26d"),
+					Bets:  []domain.Bet{{Status: domain.Settled}},
+				},
+			},
+			givenURL:       "/v1/slips/8f1f5f5c-7a43-4d0e-a4a8-1b8a2b8f4c11",
+			expectedStatus: http.StatusOK,
+			expectedBody: api.SlipResponse{
+				ID:     uuid.MustParse("8f1f5f5c-7a43-4d0e-a4a8-1b8a2b8f4c11"),
+				Table:  uuid.MustParse("bd88dfac-a3b9-43ee-ac7a-f958de23b26d"),
+				Status: domain.Settled,
+				Bets:   []api.BetResponse{{Status: domain.Settled}},
+			},
+		},
+		{
+			name: "given a slip that does not exist, expect 404",
+			givenController: mockController{
+				GivenGetSlipError: memory.ErrInvalidKey,
+			},
+			givenURL:       "/v1/slips/8f1f5f5c-7a43-4d0e-a4a8-1b8a2b8f4c11",
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler := New(test.givenController)
+
+			rr := httptest.NewRecorder()
+
+			req := httptest.NewRequest(http.MethodGet, test.givenURL, nil)
+
+			router := new(mux.Router)
+			router.HandleFunc("/v1/slips/{id}", handler.GetSlip)
+			router.ServeHTTP(rr, req)
+
+			resp := rr.Result()
+
+			if !cmp.Equal(resp.StatusCode, test.expectedStatus) {
+				t.Fatal(cmp.Diff(resp.StatusCode, test.expectedStatus))
+			}
+
+			if resp.StatusCode != http.StatusOK {
+				return
+			}
+
+			var res api.SlipResponse
+			err := json.NewDecoder(resp.Body).Decode(&res)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(res, test.expectedBody, opts.MoneyComparer) {
+				t.Fatal(cmp.Diff(res, test.expectedBody, opts.MoneyComparer))
+			}
+		})
+	}
+}
+
 type mockController struct {
-	GivenCreateBet   domain.Bet
-	GivenCreateError error
-	GivenGetBet      domain.Bet
-	GivenGetError    error
+	GivenCreateBet       domain.Bet
+	GivenCreateError     error
+	GivenCreateSlip      domain.Slip
+	GivenCreateSlipError error
+	GivenGetBet          domain.Bet
+	GivenGetError        error
+	GivenGetSlip         domain.Slip
+	GivenGetSlipError    error
 }
 
 func (m mockController) Create(_ context.Context, _ domain.Bet) (domain.Bet, error) {
@@ -299,3 +567,11 @@ func (m mockController) Create(_ context.Context, _ domain.Bet) (domain.Bet, err
 func (m mockController) Get(_ context.Context, _ uuid.UUID) (domain.Bet, error) {
 	return m.GivenGetBet, m.GivenGetError
 }
+
+func (m mockController) CreateSlip(_ context.Context, _ domain.Slip) (domain.Slip, error) {
+	return m.GivenCreateSlip, m.GivenCreateSlipError
+}
+
+func (m mockController) GetSlip(_ context.Context, _ uuid.UUID) (domain.Slip, error) {
+	return m.GivenGetSlip, m.GivenGetSlipError
+}
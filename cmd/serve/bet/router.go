@@ -8,16 +8,34 @@ import (
 	"github.com/gorilla/mux"
 )
 
-func Load(r *mux.Router, tableStorage table.StorageProvider, betStorage bet.StorageProvider) *mux.Router {
-	controller := bet.NewController(
-		bet.NewRepository(betStorage),
-		table.NewRepository(tableStorage),
-	)
+func Load(
+	r *mux.Router,
+	tableStorage table.StorageProvider,
+	betStorage bet.StorageProvider,
+	limiter bet.ExposureLimiter,
+	jackpot bet.Jackpot,
+	wallet bet.Wallet,
+	unitOfWork bet.UnitOfWork,
+	clock bet.Clock,
+	auditor bet.Auditor,
+) *mux.Router {
+	controller := bet.NewController(bet.ControllerParams{
+		RepositoryProvider: bet.NewRepository(betStorage),
+		TableRepoProvider:  table.NewRepository(tableStorage),
+		ExposureLimiter:    limiter,
+		Jackpot:            jackpot,
+		Wallet:             wallet,
+		UnitOfWork:         unitOfWork,
+		Clock:              clock,
+		Auditor:            auditor,
+	})
 
 	handler := New(controller)
 
 	r.HandleFunc("/v1/tables/{id}/bet", handler.Create).Methods(http.MethodPost)
+	r.HandleFunc("/v1/tables/{id}/slip", handler.CreateSlip).Methods(http.MethodPost)
 	r.HandleFunc("/v1/bets/{id}", handler.Get).Methods(http.MethodGet)
+	r.HandleFunc("/v1/slips/{id}", handler.GetSlip).Methods(http.MethodGet)
 
 	return r
 }
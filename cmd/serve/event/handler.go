@@ -0,0 +1,71 @@
+package event
+
+import (
+	"betting/api"
+	"betting/cmd/serve/problem"
+	"betting/internal/domain"
+	"betting/internal/pkg/logging"
+	"betting/internal/pkg/responses"
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+// Errors returned by the Handler.
+var (
+	ErrNoIDPresent = errors.New("failed locate table")
+	ErrInvalidID   = errors.New("id given is not a uuid")
+)
+
+// Controller provides business logic capable of reading the Events of a Table.
+type Controller interface {
+	List(ctx context.Context, table uuid.UUID) ([]domain.Event, error)
+}
+
+// Handler handles requests relating to the events of a table.
+type Handler struct {
+	Controller Controller
+}
+
+// New instantiates a Handler.
+func New(controller Controller) Handler {
+	return Handler{
+		Controller: controller,
+	}
+}
+
+// List shows every event recorded against the table with the given ID, oldest first.
+func (h Handler) List(w http.ResponseWriter, r *http.Request) {
+	path := mux.Vars(r)
+
+	id, ok := path["id"]
+	if !ok {
+		logging.FromContext(r.Context()).WithError(ErrNoIDPresent).Error("invalid id")
+
+		problem.WriteInvalid(w, r, ErrNoIDPresent)
+		return
+	}
+
+	tableID, err := uuid.Parse(id)
+	if err != nil {
+		logging.FromContext(r.Context()).WithField(logging.FieldID, id).WithError(ErrInvalidID).Error("invalid id")
+
+		problem.WriteInvalid(w, r, ErrInvalidID)
+		return
+	}
+
+	events, err := h.Controller.List(r.Context(), tableID)
+	if err != nil {
+		logging.FromContext(r.Context()).WithField(logging.FieldTableID, tableID).WithError(err).Error("failed to locate events")
+
+		problem.Write(w, r, err)
+		return
+	}
+
+	resBody := api.AdaptEventsFromDomain(events)
+
+	responses.NewJSON(w).Success(http.StatusOK, resBody)
+}
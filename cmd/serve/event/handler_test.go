@@ -0,0 +1,158 @@
+package event
+
+import (
+	"betting/api"
+	"betting/cmd/serve/problem"
+	"betting/internal/domain"
+	"betting/internal/pkg/responses"
+	"betting/storage/memory"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+func TestHandler_List_Success(t *testing.T) {
+	recordedAt := time.Date(2021, 10, 11, 12, 0, 0, 0, time.UTC)
+	tableID := uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d")
+
+	controller := &mockController{
+		GivenEvents: []domain.Event{
+			{Stream: tableID, Sequence: 1, Type: domain.TableOpened, RecordedAt: recordedAt, Data: []byte(`{"ID":"160998da"}`)},
+			{Stream: tableID, Sequence: 2, Type: domain.TableClosed, RecordedAt: recordedAt, Data: []byte(`{"ClosedBy":"croupier"}`)},
+		},
+	}
+
+	handler := New(controller)
+
+	rr := httptest.NewRecorder()
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/tables/160998da-2d89-4f06-a690-fd189213958d/events", nil)
+
+	router := new(mux.Router)
+	router.HandleFunc("/v1/tables/{id}/events", handler.List)
+	router.ServeHTTP(rr, req)
+
+	resp := rr.Result()
+
+	if !cmp.Equal(resp.StatusCode, http.StatusOK) {
+		t.Fatal(cmp.Diff(resp.StatusCode, http.StatusOK))
+	}
+
+	if !cmp.Equal(controller.table, tableID) {
+		t.Fatal(cmp.Diff(controller.table, tableID))
+	}
+
+	var res []api.Event
+	err := json.NewDecoder(resp.Body).Decode(&res)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []api.Event{
+		{Sequence: 1, Type: domain.TableOpened, RecordedAt: recordedAt, Data: json.RawMessage(`{"ID":"160998da"}`)},
+		{Sequence: 2, Type: domain.TableClosed, RecordedAt: recordedAt, Data: json.RawMessage(`{"ClosedBy":"croupier"}`)},
+	}
+
+	if !cmp.Equal(res, expected) {
+		t.Fatal(cmp.Diff(res, expected))
+	}
+}
+
+func TestHandler_List_Fail(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenController Controller
+		givenURL        string
+		expectedStatus  int
+		expectedBody    responses.Error
+	}{
+		{
+			name:            "given an id which is not a uuid, expect 400",
+			givenController: &mockController{},
+			givenURL:        "/v1/tables/abc/events",
+			expectedStatus:  http.StatusBadRequest,
+			expectedBody: responses.Error{
+				Type:     problem.TypeInvalid,
+				Title:    http.StatusText(http.StatusBadRequest),
+				Status:   http.StatusBadRequest,
+				Detail:   ErrInvalidID.Error(),
+				Instance: "/v1/tables/abc/events",
+			},
+		},
+		{
+			name:            "given a table without events, expect 404",
+			givenController: &mockController{GivenError: memory.ErrNoEvents},
+			givenURL:        "/v1/tables/160998da-2d89-4f06-a690-fd189213958d/events",
+			expectedStatus:  http.StatusNotFound,
+			expectedBody: responses.Error{
+				Type:     problem.TypeNotFound,
+				Title:    http.StatusText(http.StatusNotFound),
+				Status:   http.StatusNotFound,
+				Detail:   memory.ErrNoEvents.Error(),
+				Instance: "/v1/tables/160998da-2d89-4f06-a690-fd189213958d/events",
+			},
+		},
+		{
+			name:            "given controller error, expect 500 without its detail",
+			givenController: &mockController{GivenError: errors.New("storage unavailable")},
+			givenURL:        "/v1/tables/160998da-2d89-4f06-a690-fd189213958d/events",
+			expectedStatus:  http.StatusInternalServerError,
+			expectedBody: responses.Error{
+				Type:     responses.BlankType,
+				Title:    http.StatusText(http.StatusInternalServerError),
+				Status:   http.StatusInternalServerError,
+				Detail:   http.StatusText(http.StatusInternalServerError),
+				Instance: "/v1/tables/160998da-2d89-4f06-a690-fd189213958d/events",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler := New(test.givenController)
+
+			rr := httptest.NewRecorder()
+
+			req := httptest.NewRequest(http.MethodGet, test.givenURL, nil)
+
+			router := new(mux.Router)
+			router.HandleFunc("/v1/tables/{id}/events", handler.List)
+			router.ServeHTTP(rr, req)
+
+			resp := rr.Result()
+
+			if !cmp.Equal(resp.StatusCode, test.expectedStatus) {
+				t.Fatal(cmp.Diff(resp.StatusCode, test.expectedStatus))
+			}
+
+			var res responses.Error
+			err := json.NewDecoder(resp.Body).Decode(&res)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(res, test.expectedBody) {
+				t.Fatal(cmp.Diff(res, test.expectedBody))
+			}
+		})
+	}
+}
+
+type mockController struct {
+	GivenEvents []domain.Event
+	GivenError  error
+	table       uuid.UUID
+}
+
+func (m *mockController) List(_ context.Context, table uuid.UUID) ([]domain.Event, error) {
+	m.table = table
+
+	return m.GivenEvents, m.GivenError
+}
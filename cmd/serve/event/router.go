@@ -0,0 +1,22 @@
+package event
+
+import (
+	"betting/internal/event"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// NewController builds the reader of the events each table is projected from, held in the given store.
+func NewController(eventStore event.StorageProvider) event.Controller {
+	return event.NewController(event.NewRepository(eventStore))
+}
+
+// Load registers the event routes, served by the given Controller.
+func Load(r *mux.Router, controller Controller) *mux.Router {
+	handler := New(controller)
+
+	r.HandleFunc("/v1/tables/{id}/events", handler.List).Methods(http.MethodGet)
+
+	return r
+}
@@ -0,0 +1,90 @@
+package export
+
+import (
+	"betting/api"
+	"betting/cmd/serve/problem"
+	"betting/internal/domain"
+	"betting/internal/pkg/logging"
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Controller provides business logic capable of exporting Tables.
+type Controller interface {
+	Export(ctx context.Context, query domain.ExportQuery, write func(table domain.Table) error) error
+}
+
+// Handler handles requests for exports.
+type Handler struct {
+	Controller Controller
+}
+
+// New instantiates a Handler.
+func New(controller Controller) Handler {
+	return Handler{
+		Controller: controller,
+	}
+}
+
+// Export streams the records of the Tables created within the range of the query as they are read. A failure before
+// any of the export is written is reported as a problem, once the response has begun it is cut short instead.
+func (h Handler) Export(w http.ResponseWriter, r *http.Request) {
+	query, format, err := api.ParseExportQuery(r.URL.Query())
+	if err != nil {
+		logging.FromContext(r.Context()).WithError(err).Error("invalid export query")
+
+		problem.WriteInvalid(w, r, err)
+		return
+	}
+
+	s := &stream{
+		w:        w,
+		format:   format,
+		filename: api.ExportFilename(query.Records, format),
+	}
+	writer := api.NewExportWriter(s, format, query.Records)
+
+	err = h.Controller.Export(r.Context(), query, writer.Write)
+	if err == nil {
+		err = writer.Flush()
+	}
+
+	if err != nil {
+		logging.FromContext(r.Context()).WithError(err).Error("failed to export")
+
+		if !s.started {
+			problem.Write(w, r, err)
+		}
+
+		return
+	}
+
+	s.start()
+}
+
+// stream writes the headers of an export before its first bytes, so they are only sent once there is an export.
+type stream struct {
+	w        http.ResponseWriter
+	format   api.ExportFormat
+	filename string
+	started  bool
+}
+
+func (s *stream) Write(b []byte) (int, error) {
+	s.start()
+
+	return s.w.Write(b)
+}
+
+func (s *stream) start() {
+	if s.started {
+		return
+	}
+
+	s.started = true
+
+	s.w.Header().Set("Content-Type", s.format.ContentType())
+	s.w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", s.filename))
+	s.w.WriteHeader(http.StatusOK)
+}
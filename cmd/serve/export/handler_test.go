@@ -0,0 +1,165 @@
+package export
+
+import (
+	"betting/api"
+	"betting/cmd/serve/problem"
+	"betting/internal/domain"
+	"betting/internal/pkg/responses"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+const exportURL = "/v1/export?records=outcomes&format=csv&createdFrom=2021-10-01T00:00:00Z&createdTo=2021-11-01T00:00:00Z"
+
+var tableID = uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d")
+
+func TestHandler_Export_Success(t *testing.T) {
+	spunAt := time.Date(2021, 10, 11, 12, 0, 0, 0, time.UTC)
+
+	controller := &mockController{
+		GivenTables: []domain.Table{
+			{ID: tableID, SpunAt: &spunAt, Outcomes: []domain.Outcome{{Value: 0, Colour: domain.Green}}},
+		},
+	}
+
+	handler := New(controller)
+
+	rr := httptest.NewRecorder()
+
+	req := httptest.NewRequest(http.MethodGet, exportURL, nil)
+
+	router := new(mux.Router)
+	router.HandleFunc("/v1/export", handler.Export)
+	router.ServeHTTP(rr, req)
+
+	resp := rr.Result()
+
+	if !cmp.Equal(resp.StatusCode, http.StatusOK) {
+		t.Fatal(cmp.Diff(resp.StatusCode, http.StatusOK))
+	}
+
+	expectedQuery := domain.ExportQuery{
+		Records:     domain.OutcomeRecords,
+		CreatedFrom: time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC),
+		CreatedTo:   time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	if !cmp.Equal(controller.query, expectedQuery) {
+		t.Fatal(cmp.Diff(controller.query, expectedQuery))
+	}
+
+	if !cmp.Equal(resp.Header.Get("Content-Type"), "text/csv") {
+		t.Fatal(cmp.Diff(resp.Header.Get("Content-Type"), "text/csv"))
+	}
+
+	if !cmp.Equal(resp.Header.Get("Content-Disposition"), `attachment; filename="outcomes.csv"`) {
+		t.Fatal(cmp.Diff(resp.Header.Get("Content-Disposition"), `attachment; filename="outcomes.csv"`))
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "table,ball,position,colour,seed,spunAt\n" + tableID.String() + ",1,0,green,0,2021-10-11T12:00:00Z\n"
+
+	if !cmp.Equal(string(body), expected) {
+		t.Fatal(cmp.Diff(string(body), expected))
+	}
+}
+
+func TestHandler_Export_Fail(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenController Controller
+		givenURL        string
+		expectedStatus  int
+		expectedBody    responses.Error
+	}{
+		{
+			name:            "given no range, expect 400",
+			givenController: &mockController{},
+			givenURL:        "/v1/export?records=bets",
+			expectedStatus:  http.StatusBadRequest,
+			expectedBody: responses.Error{
+				Type:     problem.TypeInvalid,
+				Title:    http.StatusText(http.StatusBadRequest),
+				Status:   http.StatusBadRequest,
+				Detail:   api.ErrMissingRange.Error(),
+				Instance: "/v1/export",
+			},
+		},
+		{
+			name:            "given controller error before anything is written, expect 500 without its detail",
+			givenController: &mockController{GivenError: errors.New("storage unavailable")},
+			givenURL:        exportURL,
+			expectedStatus:  http.StatusInternalServerError,
+			expectedBody: responses.Error{
+				Type:     responses.BlankType,
+				Title:    http.StatusText(http.StatusInternalServerError),
+				Status:   http.StatusInternalServerError,
+				Detail:   http.StatusText(http.StatusInternalServerError),
+				Instance: "/v1/export",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler := New(test.givenController)
+
+			rr := httptest.NewRecorder()
+
+			req := httptest.NewRequest(http.MethodGet, test.givenURL, nil)
+
+			router := new(mux.Router)
+			router.HandleFunc("/v1/export", handler.Export)
+			router.ServeHTTP(rr, req)
+
+			resp := rr.Result()
+
+			if !cmp.Equal(resp.StatusCode, test.expectedStatus) {
+				t.Fatal(cmp.Diff(resp.StatusCode, test.expectedStatus))
+			}
+
+			var res responses.Error
+			err := json.NewDecoder(resp.Body).Decode(&res)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(res, test.expectedBody) {
+				t.Fatal(cmp.Diff(res, test.expectedBody))
+			}
+		})
+	}
+}
+
+// mockController passes each of GivenTables to write before returning GivenError.
+type mockController struct {
+	GivenTables []domain.Table
+	GivenError  error
+	query       domain.ExportQuery
+}
+
+func (m *mockController) Export(_ context.Context, query domain.ExportQuery, write func(table domain.Table) error) error {
+	m.query = query
+
+	for i := range m.GivenTables {
+		err := write(m.GivenTables[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	return m.GivenError
+}
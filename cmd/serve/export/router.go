@@ -0,0 +1,24 @@
+package export
+
+import (
+	"betting/internal/bet"
+	"betting/internal/export"
+	"betting/internal/table"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// NewController builds the exporter of the tables and bets held in the given storage.
+func NewController(tableStorage table.StorageProvider, betStorage bet.StorageProvider) export.Controller {
+	return export.NewController(table.NewRepository(tableStorage), bet.NewRepository(betStorage))
+}
+
+// Load registers the export routes, served by the given Controller.
+func Load(r *mux.Router, controller Controller) *mux.Router {
+	handler := New(controller)
+
+	r.HandleFunc("/v1/export", handler.Export).Methods(http.MethodGet)
+
+	return r
+}
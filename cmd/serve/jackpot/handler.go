@@ -0,0 +1,43 @@
+package jackpot
+
+import (
+	"betting/api"
+	"betting/cmd/serve/problem"
+	"betting/internal/domain"
+	"betting/internal/pkg/logging"
+	"betting/internal/pkg/responses"
+	"context"
+	"net/http"
+)
+
+// Controller provides business logic capable of reading the Jackpot.
+type Controller interface {
+	Get(ctx context.Context) (domain.Jackpot, error)
+}
+
+// Handler handles requests relating to the jackpot.
+type Handler struct {
+	Controller Controller
+}
+
+// New instantiates a Handler.
+func New(controller Controller) Handler {
+	return Handler{
+		Controller: controller,
+	}
+}
+
+// Get shows the pool of the progressive jackpot shared by every table and the awards paid from it.
+func (h Handler) Get(w http.ResponseWriter, r *http.Request) {
+	jackpot, err := h.Controller.Get(r.Context())
+	if err != nil {
+		logging.FromContext(r.Context()).WithError(err).Error("failed to get jackpot")
+
+		problem.Write(w, r, err)
+		return
+	}
+
+	resBody := api.AdaptJackpotFromDomain(jackpot)
+
+	responses.NewJSON(w).Success(http.StatusOK, resBody)
+}
@@ -0,0 +1,157 @@
+package jackpot
+
+import (
+	"betting/api"
+	"betting/internal/domain"
+	"betting/internal/pkg/responses"
+	"betting/testing/opts"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Rhymond/go-money"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+func TestHandler_Get_Success(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenController Controller
+		expectedStatus  int
+		expectedBody    api.JackpotResponse
+	}{
+		{
+			name: "given an empty jackpot, expect 200 with an empty pool",
+			givenController: mockController{
+				GivenJackpot: domain.Jackpot{},
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: api.JackpotResponse{
+				Pool:   []*money.Money{},
+				Awards: []api.JackpotAward{},
+			},
+		},
+		{
+			name: "given a jackpot which has paid out, expect 200 with its pool and awards",
+			givenController: mockController{
+				GivenJackpot: domain.Jackpot{
+					Pool: []*money.Money{money.New(50, "EUR"), money.New(1, "GBP")},
+					Awards: []domain.JackpotAward{
+						{
+							Bet:    uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+							Table:  uuid.MustParse("00812e8f-7fca-49a9-b141-9a52a0d0a82e"),
+							Amount: money.New(1200, "GBP"),
+						},
+					},
+				},
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: api.JackpotResponse{
+				Pool: []*money.Money{money.New(50, "EUR"), money.New(1, "GBP")},
+				Awards: []api.JackpotAward{
+					{
+						Bet:    uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+						Table:  uuid.MustParse("00812e8f-7fca-49a9-b141-9a52a0d0a82e"),
+						Amount: money.New(1200, "GBP"),
+					},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler := New(test.givenController)
+
+			rr := httptest.NewRecorder()
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/jackpot", nil)
+
+			router := new(mux.Router)
+			router.HandleFunc("/v1/jackpot", handler.Get)
+			router.ServeHTTP(rr, req)
+
+			resp := rr.Result()
+
+			if !cmp.Equal(resp.StatusCode, test.expectedStatus) {
+				t.Fatal(cmp.Diff(resp.StatusCode, test.expectedStatus))
+			}
+
+			var res api.JackpotResponse
+			err := json.NewDecoder(resp.Body).Decode(&res)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(res, test.expectedBody, opts.MoneyComparer) {
+				t.Fatal(cmp.Diff(res, test.expectedBody, opts.MoneyComparer))
+			}
+		})
+	}
+}
+
+func TestHandler_Get_Fail(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenController Controller
+		expectedStatus  int
+		expectedBody    responses.Error
+	}{
+		{
+			name: "given controller error, expect 500 without its detail",
+			givenController: mockController{
+				GivenError: errors.New("storage unavailable"),
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: responses.Error{
+				Type:     responses.BlankType,
+				Title:    http.StatusText(http.StatusInternalServerError),
+				Status:   http.StatusInternalServerError,
+				Detail:   http.StatusText(http.StatusInternalServerError),
+				Instance: "/v1/jackpot",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler := New(test.givenController)
+
+			rr := httptest.NewRecorder()
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/jackpot", nil)
+
+			router := new(mux.Router)
+			router.HandleFunc("/v1/jackpot", handler.Get)
+			router.ServeHTTP(rr, req)
+
+			resp := rr.Result()
+
+			if !cmp.Equal(resp.StatusCode, test.expectedStatus) {
+				t.Fatal(cmp.Diff(resp.StatusCode, test.expectedStatus))
+			}
+
+			var res responses.Error
+			err := json.NewDecoder(resp.Body).Decode(&res)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(res, test.expectedBody) {
+				t.Fatal(cmp.Diff(res, test.expectedBody))
+			}
+		})
+	}
+}
+
+type mockController struct {
+	GivenJackpot domain.Jackpot
+	GivenError   error
+}
+
+func (m mockController) Get(_ context.Context) (domain.Jackpot, error) {
+	return m.GivenJackpot, m.GivenError
+}
@@ -0,0 +1,28 @@
+package jackpot
+
+import (
+	"betting/internal/domain"
+	"betting/internal/jackpot"
+	"betting/internal/table"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// NewController builds the jackpot shared by every table, held in the given storage and played by the given rules.
+func NewController(
+	jackpotStorage jackpot.StorageProvider,
+	tableStorage table.StorageProvider,
+	rules domain.JackpotRules,
+) jackpot.Controller {
+	return jackpot.NewController(jackpot.NewRepository(jackpotStorage), table.NewRepository(tableStorage), rules)
+}
+
+// Load registers the jackpot routes, served by the given Controller.
+func Load(r *mux.Router, controller Controller) *mux.Router {
+	handler := New(controller)
+
+	r.HandleFunc("/v1/jackpot", handler.Get).Methods(http.MethodGet)
+
+	return r
+}
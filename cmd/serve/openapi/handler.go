@@ -0,0 +1,29 @@
+package openapi
+
+import (
+	"betting/internal/pkg/logging"
+	"net/http"
+)
+
+// Handler serves an OpenAPI specification.
+type Handler struct {
+	Specification []byte
+}
+
+// New instantiates a Handler.
+func New(specification []byte) Handler {
+	return Handler{
+		Specification: specification,
+	}
+}
+
+// Get returns the specification.
+func (h Handler) Get(w http.ResponseWriter, r *http.Request) {
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	_, err := w.Write(h.Specification)
+	if err != nil {
+		logging.FromContext(r.Context()).WithError(err).Error("failed to write specification")
+	}
+}
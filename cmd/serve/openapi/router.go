@@ -0,0 +1,23 @@
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// Load serves the given specification and validates every request and response against it, responses that do not
+// match are refused when strict.
+func Load(r *mux.Router, specification []byte, strict bool) (*mux.Router, error) {
+	validator, err := NewValidator(specification, strict)
+	if err != nil {
+		return nil, err
+	}
+
+	handler := New(specification)
+
+	r.HandleFunc("/openapi.json", handler.Get).Methods(http.MethodGet)
+	r.Use(validator.Middleware)
+
+	return r, nil
+}
@@ -0,0 +1,968 @@
+package openapi
+
+import (
+	"betting/api"
+	"betting/cmd/serve/audit"
+	"betting/cmd/serve/bet"
+	"betting/cmd/serve/event"
+	"betting/cmd/serve/export"
+	"betting/cmd/serve/jackpot"
+	"betting/cmd/serve/statistics"
+	"betting/cmd/serve/table"
+	"betting/internal/domain"
+	"betting/internal/pkg/actor"
+	"betting/internal/pkg/ballplacer"
+	"betting/internal/pkg/clock"
+	"betting/internal/pkg/correlation"
+	"betting/internal/pkg/exposure"
+	"betting/internal/pkg/origin"
+	"betting/storage/memory"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+// newRouter registers every route served, as serve.StartServer does, refusing responses that drift from the
+// specification. The ball lands on the given positions in turn, or is placed at random as in production without any.
+func newRouter(t *testing.T, positions ...int) *mux.Router {
+	t.Helper()
+
+	systemClock := clock.New()
+	eventStore := memory.NewEventStore(systemClock)
+	tableStorage := memory.NewTableStorage(eventStore)
+	betStorage := memory.NewBetStorage(tableStorage, eventStore, systemClock)
+	unitOfWork := memory.NewUnitOfWork()
+	pot := jackpot.NewController(memory.NewJackpotStorage(), tableStorage, domain.JackpotRules{Percent: 10, Repeats: 3})
+	history := statistics.NewController(memory.NewStatisticsStorage(), systemClock)
+	auditor := audit.NewController(memory.NewAuditStorage(), systemClock)
+
+	r := mux.NewRouter()
+	r.Use(correlation.Middleware, actor.Middleware, origin.Middleware)
+
+	o, err := Load(r, api.Specification, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var placer ballplacer.Provider = ballplacer.NewScripted(positions...)
+	if len(positions) == 0 {
+		placer = ballplacer.New()
+	}
+
+	limiter := exposure.New(exposure.Limits{"GBP": 1000000}, nil)
+
+	tr := table.Load(o, tableStorage, betStorage, placer, nil, unitOfWork, pot, history, systemClock, auditor)
+	br := bet.Load(tr, tableStorage, betStorage, limiter, pot, nil, unitOfWork, systemClock, auditor)
+
+	jr := jackpot.Load(br, pot)
+	sr := statistics.Load(jr, history)
+
+	ar := audit.Load(sr, auditor)
+
+	er := event.Load(ar, event.NewController(eventStore))
+
+	return export.Load(er, export.NewController(tableStorage, betStorage))
+}
+
+func TestLoad_Routes(t *testing.T) {
+	doc, err := Parse(api.Specification)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var specified []string
+
+	for path, item := range doc.Paths {
+		for method := range item.Operations() {
+			specified = append(specified, method+" "+path)
+		}
+	}
+
+	var registered []string
+
+	err = newRouter(t, 14).Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		path, err := route.GetPathTemplate()
+		if err != nil {
+			return err
+		}
+
+		methods, err := route.GetMethods()
+		if err != nil {
+			return err
+		}
+
+		for _, method := range methods {
+			registered = append(registered, method+" "+path)
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sort.Strings(specified)
+	sort.Strings(registered)
+
+	if !cmp.Equal(registered, specified) {
+		t.Fatal(cmp.Diff(registered, specified))
+	}
+}
+
+func TestLoad_Responses(t *testing.T) {
+	r := newRouter(t, 14)
+
+	do := func(t *testing.T, method, url, body, ifMatch string, expectedStatus int) []byte {
+		t.Helper()
+
+		return serve(t, r, method, url, body, ifMatch, expectedStatus)
+	}
+
+	var created api.TableResponse
+
+	err := json.Unmarshal(do(t, http.MethodPost, "/v1/tables", "", "", http.StatusCreated), &created)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var placed api.BetResponse
+
+	err = json.Unmarshal(do(
+		t,
+		http.MethodPost,
+		fmt.Sprintf("/v1/tables/%v/bet", created.ID),
+		placeBet,
+		"",
+		http.StatusCreated,
+	), &placed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	do(
+		t,
+		http.MethodPost,
+		fmt.Sprintf("/v1/tables/%v/bet", created.ID),
+		`{"selectedSpaces": [14], "stake": {"amount": 100000, "currency": "GBP"}}`,
+		"",
+		http.StatusUnprocessableEntity,
+	)
+
+	do(
+		t,
+		http.MethodPost,
+		fmt.Sprintf("/v1/tables/%v/bet", created.ID),
+		`{"announced": {"call": "neighbours", "number": 14, "neighbours": 2}, "stake": {"amount": 100, "currency": "GBP"}}`,
+		"",
+		http.StatusCreated,
+	)
+
+	do(
+		t,
+		http.MethodPost,
+		fmt.Sprintf("/v1/tables/%v/bet", created.ID),
+		`{"announced": {"call": "jeu zero"}, "stake": {"amount": 100, "currency": "GBP"}}`,
+		"",
+		http.StatusBadRequest,
+	)
+
+	var slip api.SlipResponse
+
+	err = json.Unmarshal(do(
+		t,
+		http.MethodPost,
+		fmt.Sprintf("/v1/tables/%v/slip", created.ID),
+		placeSlip,
+		"",
+		http.StatusCreated,
+	), &slip)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	do(
+		t,
+		http.MethodPost,
+		fmt.Sprintf("/v1/tables/%v/slip", created.ID),
+		`{"bets": [{"selectedSpaces": [14], "stake": {"amount": 20000, "currency": "GBP"}},
+			{"selectedSpaces": [14], "stake": {"amount": 20000, "currency": "GBP"}}]}`,
+		"",
+		http.StatusUnprocessableEntity,
+	)
+
+	do(t, http.MethodPost, fmt.Sprintf("/v1/tables/%v/slip", created.ID), `{"bets": []}`, "", http.StatusBadRequest)
+
+	tests := []struct {
+		name           string
+		givenMethod    string
+		givenURL       string
+		givenIfMatch   string
+		expectedStatus int
+	}{
+		{
+			name:           "expect the specification to be served",
+			givenMethod:    http.MethodGet,
+			givenURL:       "/openapi.json",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "expect the tables to be listed",
+			givenMethod:    http.MethodGet,
+			givenURL:       "/v1/tables?state=open&limit=1",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "expect the table to be returned",
+			givenMethod:    http.MethodGet,
+			givenURL:       fmt.Sprintf("/v1/tables/%v", created.ID),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "expect the bet to be returned",
+			givenMethod:    http.MethodGet,
+			givenURL:       fmt.Sprintf("/v1/bets/%v", placed.ID),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "expect the slip to be returned",
+			givenMethod:    http.MethodGet,
+			givenURL:       fmt.Sprintf("/v1/slips/%v", slip.ID),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "expect the open table to be summarised",
+			givenMethod:    http.MethodGet,
+			givenURL:       fmt.Sprintf("/v1/tables/%v/summary", created.ID),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "given the version the table was created at, expect the table to be spun",
+			givenMethod:    http.MethodPut,
+			givenURL:       fmt.Sprintf("/v1/tables/%v/spin", created.ID),
+			givenIfMatch:   `"1"`,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "given the version from before the spin, expect the precondition to fail",
+			givenMethod:    http.MethodPut,
+			givenURL:       fmt.Sprintf("/v1/tables/%v/settle", created.ID),
+			givenIfMatch:   `"1"`,
+			expectedStatus: http.StatusPreconditionFailed,
+		},
+		{
+			name:           "expect the table to be settled",
+			givenMethod:    http.MethodPut,
+			givenURL:       fmt.Sprintf("/v1/tables/%v/settle", created.ID),
+			givenIfMatch:   "*",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "expect the settled table to be summarised",
+			givenMethod:    http.MethodGet,
+			givenURL:       fmt.Sprintf("/v1/tables/%v/summary", created.ID),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "expect the settled slip to be returned",
+			givenMethod:    http.MethodGet,
+			givenURL:       fmt.Sprintf("/v1/slips/%v", slip.ID),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "expect the closed tables to be listed",
+			givenMethod:    http.MethodGet,
+			givenURL:       "/v1/tables?state=closed&colour=red&order=desc",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "given a bet on the closed table, expect a conflict",
+			givenMethod:    http.MethodPost,
+			givenURL:       fmt.Sprintf("/v1/tables/%v/bet", created.ID),
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "given a table that does not exist, expect it not to be found",
+			givenMethod:    http.MethodGet,
+			givenURL:       "/v1/tables/49cffe67-9798-4327-9760-c4b81562f928",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "given a table that does not exist is spun, expect it not to be found",
+			givenMethod:    http.MethodPut,
+			givenURL:       "/v1/tables/49cffe67-9798-4327-9760-c4b81562f928/spin",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "given a bet on a table that does not exist, expect it not to be found",
+			givenMethod:    http.MethodPost,
+			givenURL:       "/v1/tables/49cffe67-9798-4327-9760-c4b81562f928/bet",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "given a bet that does not exist, expect it not to be found",
+			givenMethod:    http.MethodGet,
+			givenURL:       "/v1/bets/49cffe67-9798-4327-9760-c4b81562f928",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "given a slip that does not exist, expect it not to be found",
+			givenMethod:    http.MethodGet,
+			givenURL:       "/v1/slips/49cffe67-9798-4327-9760-c4b81562f928",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "given an id that is not a uuid, expect a bad request",
+			givenMethod:    http.MethodGet,
+			givenURL:       "/v1/bets/1",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			do(t, test.givenMethod, test.givenURL, placeBet, test.givenIfMatch, test.expectedStatus)
+		})
+	}
+}
+
+func TestLoad_ZeroRules(t *testing.T) {
+	r := newRouter(t, 0, 0, 1)
+
+	do := func(t *testing.T, method, url, body string, expectedStatus int, v interface{}) {
+		t.Helper()
+
+		err := json.Unmarshal(serve(t, r, method, url, body, "", expectedStatus), v)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var partage, prison, next api.TableResponse
+
+	do(t, http.MethodPost, "/v1/tables", `{"rules": {"zero": "la_partage"}}`, http.StatusCreated, &partage)
+	do(t, http.MethodPost, "/v1/tables", `{"rules": {"zero": "en_prison"}}`, http.StatusCreated, &prison)
+
+	for _, table := range []api.TableResponse{partage, prison} {
+		var placed api.BetResponse
+
+		do(t, http.MethodPost, fmt.Sprintf("/v1/tables/%v/bet", table.ID), placeRed, http.StatusCreated, &placed)
+		do(t, http.MethodPut, fmt.Sprintf("/v1/tables/%v/spin", table.ID), "", http.StatusOK, &api.TableResponse{})
+	}
+
+	do(t, http.MethodPut, fmt.Sprintf("/v1/tables/%v/settle", partage.ID), "", http.StatusOK, &partage)
+
+	if bet := partage.Bets[0]; bet.Status != domain.Settled || bet.Win || bet.Refund.Amount() != 50 {
+		t.Fatalf("expected half the stake refunded by la partage, got %+v", bet)
+	}
+
+	var summary api.TableSummaryResponse
+
+	do(t, http.MethodGet, fmt.Sprintf("/v1/tables/%v/summary", partage.ID), "", http.StatusOK, &summary)
+
+	if len(summary.HouseResult) != 1 || summary.HouseResult[0].Amount() != 50 {
+		t.Fatalf("expected the house to keep half the stake, got %+v", summary.HouseResult)
+	}
+
+	do(t, http.MethodPut, fmt.Sprintf("/v1/tables/%v/settle", prison.ID), "", http.StatusOK, &prison)
+
+	if bet := prison.Bets[0]; bet.Status != domain.Imprisoned || bet.ImprisonedOn == nil || *bet.ImprisonedOn != prison.ID {
+		t.Fatalf("expected the bet to be imprisoned, got %+v", bet)
+	}
+
+	do(t, http.MethodPost, "/v1/tables", fmt.Sprintf(`{"previous": %q}`, prison.ID), http.StatusCreated, &next)
+
+	if len(next.Bets) != 1 || next.Bets[0].ID != prison.Bets[0].ID {
+		t.Fatalf("expected the imprisoned bet to be carried, got %+v", next.Bets)
+	}
+
+	do(t, http.MethodPut, fmt.Sprintf("/v1/tables/%v/spin", next.ID), "", http.StatusOK, &next)
+	do(t, http.MethodPut, fmt.Sprintf("/v1/tables/%v/settle", next.ID), "", http.StatusOK, &next)
+
+	if bet := next.Bets[0]; bet.Status != domain.Settled || bet.Win || bet.Refund.Amount() != 100 {
+		t.Fatalf("expected the stake to be released, got %+v", bet)
+	}
+
+	do(t, http.MethodGet, fmt.Sprintf("/v1/tables/%v", prison.ID), "", http.StatusOK, &prison)
+
+	if len(prison.Bets) != 0 {
+		t.Fatalf("expected no bets left on the previous table, got %+v", prison.Bets)
+	}
+
+	serve(t, r, http.MethodPost, "/v1/tables", `{"rules": {"zero": "surrender"}}`, "", http.StatusBadRequest)
+	serve(t, r, http.MethodPost, "/v1/tables", `{"previous": "49cffe67-9798-4327-9760-c4b81562f928"}`, "", http.StatusNotFound)
+}
+
+func TestLoad_Variants(t *testing.T) {
+	r := newRouter(t, 14, 3, 14, 0, 1)
+
+	do := func(t *testing.T, method, url, body string, expectedStatus int, v interface{}) {
+		t.Helper()
+
+		err := json.Unmarshal(serve(t, r, method, url, body, "", expectedStatus), v)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var double, multi, lightning api.TableResponse
+
+	do(t, http.MethodPost, "/v1/tables", `{"rules": {"zero": "none", "variant": "double_ball"}}`, http.StatusCreated, &double)
+
+	for _, body := range []string{placeBet, placeRed} {
+		do(t, http.MethodPost, fmt.Sprintf("/v1/tables/%v/bet", double.ID), body, http.StatusCreated, &api.BetResponse{})
+	}
+
+	do(t, http.MethodPut, fmt.Sprintf("/v1/tables/%v/spin", double.ID), "", http.StatusOK, &double)
+
+	if len(double.Outcomes) != 2 || double.Outcome == nil || *double.Outcome != double.Outcomes[0] {
+		t.Fatalf("expected an outcome for each of two balls, got %+v and %+v", double.Outcome, double.Outcomes)
+	}
+
+	do(t, http.MethodPut, fmt.Sprintf("/v1/tables/%v/settle", double.ID), "", http.StatusOK, &double)
+
+	for _, bet := range double.Bets {
+		if !bet.Win {
+			t.Fatalf("expected the bet to win, got %+v", bet)
+		}
+	}
+
+	var summary api.TableSummaryResponse
+
+	do(t, http.MethodGet, fmt.Sprintf("/v1/tables/%v/summary", double.ID), "", http.StatusOK, &summary)
+
+	// the straight up is caught by one ball and paid 17 to 1, red by both and paid 3 to 1
+	if len(summary.HouseResult) != 1 || summary.HouseResult[0].Amount() != -1700-300 {
+		t.Fatalf("expected the house to pay the double ball paytable, got %+v", summary.HouseResult)
+	}
+
+	do(t, http.MethodPost, "/v1/tables", `{"rules": {"variant": "multi_wheel", "wheels": 3}}`, http.StatusCreated, &multi)
+	do(t, http.MethodPut, fmt.Sprintf("/v1/tables/%v/spin", multi.ID), "", http.StatusOK, &multi)
+
+	if len(multi.Outcomes) != 3 || multi.Rules.Wheels != 3 {
+		t.Fatalf("expected an outcome for each of three wheels, got %+v", multi)
+	}
+
+	do(t, http.MethodPost, "/v1/tables", `{"rules": {"variant": "lightning"}}`, http.StatusCreated, &lightning)
+	do(t, http.MethodPost, fmt.Sprintf("/v1/tables/%v/bet", lightning.ID), placeBet, http.StatusCreated, &api.BetResponse{})
+	do(t, http.MethodGet, fmt.Sprintf("/v1/tables/%v/summary", lightning.ID), "", http.StatusOK, &summary)
+
+	if liability := summary.Exposure[14].Liability; len(liability) != 1 || liability[0].Amount() != 100*domain.MaxMultiplier {
+		t.Fatalf("expected the straight up exposed at the greatest multiplier, got %+v", liability)
+	}
+
+	// the scripted placer strikes no numbers, so the straight up is paid the reduced 29 to 1
+	do(t, http.MethodPut, fmt.Sprintf("/v1/tables/%v/spin", lightning.ID), "", http.StatusOK, &lightning)
+	do(t, http.MethodPut, fmt.Sprintf("/v1/tables/%v/settle", lightning.ID), "", http.StatusOK, &lightning)
+	do(t, http.MethodGet, fmt.Sprintf("/v1/tables/%v/summary", lightning.ID), "", http.StatusOK, &summary)
+
+	if len(summary.HouseResult) != 1 || summary.HouseResult[0].Amount() != -2900 {
+		t.Fatalf("expected the house to pay the reduced straight up, got %+v", summary.HouseResult)
+	}
+
+	serve(t, r, http.MethodPost, "/v1/tables", `{"rules": {"variant": "multi_wheel", "wheels": 9}}`, "", http.StatusBadRequest)
+	serve(t, r, http.MethodPost, "/v1/tables", `{"rules": {"zero": "la_partage", "variant": "double_ball"}}`, "", http.StatusBadRequest)
+}
+
+func TestLoad_Jackpot(t *testing.T) {
+	r := newRouter(t, 14, 14, 14)
+
+	do := func(t *testing.T, method, url, body string, expectedStatus int, v interface{}) {
+		t.Helper()
+
+		err := json.Unmarshal(serve(t, r, method, url, body, "", expectedStatus), v)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var (
+		round  api.TableResponse
+		placed api.BetResponse
+		pool   api.JackpotResponse
+	)
+
+	do(t, http.MethodPost, "/v1/tables", "", http.StatusCreated, &round)
+
+	for i := 0; i < 3; i++ {
+		if i > 0 {
+			do(t, http.MethodPost, "/v1/tables", fmt.Sprintf(`{"previous": %q}`, round.ID), http.StatusCreated, &round)
+		}
+
+		do(t, http.MethodPost, fmt.Sprintf("/v1/tables/%v/bet", round.ID), placeJackpot, http.StatusCreated, &placed)
+
+		if !placed.Jackpot || placed.Contribution == nil || placed.Contribution.Amount() != 100 {
+			t.Fatalf("expected a tenth of the stake contributed, got %+v", placed)
+		}
+
+		do(t, http.MethodPost, fmt.Sprintf("/v1/tables/%v/bet", round.ID), placeBet, http.StatusCreated, &api.BetResponse{})
+		do(t, http.MethodPut, fmt.Sprintf("/v1/tables/%v/spin", round.ID), "", http.StatusOK, &api.TableResponse{})
+		do(t, http.MethodPut, fmt.Sprintf("/v1/tables/%v/settle", round.ID), "", http.StatusOK, &round)
+	}
+
+	// the third round in a row on 14 pays the pool to the only side bet on it
+	for _, bet := range round.Bets {
+		if bet.Jackpot != (bet.JackpotWin != nil) || bet.Jackpot && bet.JackpotWin.Amount() != 300 {
+			t.Fatalf("expected the side bet to win the pool, got %+v", bet)
+		}
+	}
+
+	do(t, http.MethodGet, "/v1/jackpot", "", http.StatusOK, &pool)
+
+	if len(pool.Pool) != 1 || pool.Pool[0].Amount() != 0 || len(pool.Awards) != 1 || pool.Awards[0].Table != round.ID {
+		t.Fatalf("expected the pool to be paid out to the last round, got %+v", pool)
+	}
+}
+
+func TestLoad_JackpotRandom(t *testing.T) {
+	const rounds = 12
+
+	r := newRouter(t)
+
+	do := func(t *testing.T, method, url, body string, expectedStatus int, v interface{}) {
+		t.Helper()
+
+		err := json.Unmarshal(serve(t, r, method, url, body, "", expectedStatus), v)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var (
+		round  api.TableResponse
+		pool   api.JackpotResponse
+		landed = make(map[int]bool)
+	)
+
+	do(t, http.MethodPost, "/v1/tables", "", http.StatusCreated, &round)
+
+	for i := 0; i < rounds; i++ {
+		if i > 0 {
+			do(t, http.MethodPost, "/v1/tables", fmt.Sprintf(`{"previous": %q}`, round.ID), http.StatusCreated, &round)
+		}
+
+		do(t, http.MethodPost, fmt.Sprintf("/v1/tables/%v/bet", round.ID), placeJackpot, http.StatusCreated, &api.BetResponse{})
+		do(t, http.MethodPut, fmt.Sprintf("/v1/tables/%v/spin", round.ID), "", http.StatusOK, &api.TableResponse{})
+		do(t, http.MethodPut, fmt.Sprintf("/v1/tables/%v/settle", round.ID), "", http.StatusOK, &round)
+
+		landed[round.Outcomes[0].Position] = true
+	}
+
+	do(t, http.MethodGet, "/v1/jackpot", "", http.StatusOK, &pool)
+
+	// three rounds in a row land on the same number about once in 1369, so a pool paid out round after round means the
+	// wheel is not being spun at random
+	if len(landed) == 1 || len(pool.Awards) > 1 {
+		t.Fatalf("expected the jackpot to be won rarely, landed on %v and paid %v awards", landed, len(pool.Awards))
+	}
+}
+
+func TestLoad_Stakes(t *testing.T) {
+	r := newRouter(t, 17)
+
+	var round api.TableResponse
+
+	err := json.Unmarshal(serve(t, r, http.MethodPost, "/v1/tables", "", "", http.StatusCreated), &round)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bet := fmt.Sprintf("/v1/tables/%v/bet", round.ID)
+	slip := fmt.Sprintf("/v1/tables/%v/slip", round.ID)
+
+	serve(t, r, http.MethodPost, bet, `{"selectedSpaces": [17], "stake": {"amount": -100000, "currency": "GBP"}}`, "",
+		http.StatusBadRequest)
+	serve(t, r, http.MethodPost, bet, `{"selectedSpaces": [17], "stake": {"amount": 0, "currency": "GBP"}}`, "",
+		http.StatusBadRequest)
+	serve(t, r, http.MethodPost, bet, `{"announced": {"call": "orphelins"}, "stake": {"amount": -100, "currency": "GBP"}}`, "",
+		http.StatusBadRequest)
+	serve(t, r, http.MethodPost, slip, `{"bets": [`+placeBet+`, {"selectedSpaces": [17], "stake": {"amount": 0, "currency": "GBP"}}]}`,
+		"", http.StatusBadRequest)
+
+	var placed api.TableResponse
+
+	err = json.Unmarshal(serve(t, r, http.MethodGet, "/v1/tables/"+round.ID.String(), "", "", http.StatusOK), &placed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(placed.Bets) != 0 {
+		t.Fatalf("expected no bets to be placed, got %+v", placed.Bets)
+	}
+}
+
+func TestLoad_Statistics(t *testing.T) {
+	r := newRouter(t, 14, 17, 32, 0)
+
+	do := func(t *testing.T, method, url, body string, expectedStatus int, v interface{}) {
+		t.Helper()
+
+		err := json.Unmarshal(serve(t, r, method, url, body, "", expectedStatus), v)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var round, other api.TableResponse
+
+	do(t, http.MethodPost, "/v1/tables", "", http.StatusCreated, &round)
+	do(t, http.MethodPut, fmt.Sprintf("/v1/tables/%v/spin", round.ID), "", http.StatusOK, &api.TableResponse{})
+	do(t, http.MethodPut, fmt.Sprintf("/v1/tables/%v/settle", round.ID), "", http.StatusOK, &api.TableResponse{})
+
+	series := round.Series
+
+	do(t, http.MethodPost, "/v1/tables", fmt.Sprintf(`{"previous": %q}`, round.ID), http.StatusCreated, &round)
+	do(t, http.MethodPut, fmt.Sprintf("/v1/tables/%v/spin", round.ID), "", http.StatusOK, &api.TableResponse{})
+
+	if round.Series != series {
+		t.Fatalf("expected the next round to be in series %v, got %v", series, round.Series)
+	}
+
+	do(t, http.MethodPost, "/v1/tables", `{"rules": {"zero": "none", "variant": "double_ball"}}`, http.StatusCreated, &other)
+	do(t, http.MethodPut, fmt.Sprintf("/v1/tables/%v/spin", other.ID), "", http.StatusOK, &api.TableResponse{})
+
+	var all, game api.StatisticsResponse
+
+	do(t, http.MethodGet, "/v1/statistics", "", http.StatusOK, &all)
+
+	positions := make([]int, len(all.Recent))
+
+	for i := range all.Recent {
+		positions[i] = all.Recent[i].Position
+	}
+
+	if !cmp.Equal(positions, []int{0, 32, 17, 14}) || all.Spins != 4 || all.Numbers[14].Hits != 1 || all.Numbers[14].Gap != 3 {
+		t.Fatalf("expected every ball spun to be recorded, got %+v", all)
+	}
+
+	do(t, http.MethodGet, "/v1/statistics?series="+series.String(), "", http.StatusOK, &game)
+
+	expected := []api.Streak{
+		{Kind: domain.ColourStreak, Value: "black", Length: 1},
+		{Kind: domain.DozenStreak, Value: "second", Length: 2},
+		{Kind: domain.ColumnStreak, Value: "second", Length: 2},
+	}
+
+	if game.Spins != 2 || !cmp.Equal(game.Hot, []int{14, 17}) || !cmp.Equal(game.Streaks, expected) {
+		t.Fatalf("expected the two rounds of the series, got %+v", game)
+	}
+}
+
+func TestLoad_Previous(t *testing.T) {
+	r := newRouter(t, 14)
+
+	var previous api.TableResponse
+
+	err := json.Unmarshal(serve(t, r, http.MethodPost, "/v1/tables", "", "", http.StatusCreated), &previous)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	next := fmt.Sprintf(`{"previous": %q}`, previous.ID)
+
+	serve(t, r, http.MethodPost, "/v1/tables", next, "", http.StatusConflict)
+	serve(t, r, http.MethodPut, fmt.Sprintf("/v1/tables/%v/spin", previous.ID), "", "", http.StatusOK)
+	serve(t, r, http.MethodPut, fmt.Sprintf("/v1/tables/%v/settle", previous.ID), "", "", http.StatusOK)
+	serve(t, r, http.MethodPost, "/v1/tables", next, "", http.StatusCreated)
+	serve(t, r, http.MethodPost, "/v1/tables", next, "", http.StatusConflict)
+}
+
+func TestLoad_Lifecycle(t *testing.T) {
+	r := newRouter(t, 14)
+
+	do := func(t *testing.T, method, url, user string, v interface{}) {
+		t.Helper()
+
+		req := httptest.NewRequest(method, url, nil)
+		if user != "" {
+			req.Header.Set(actor.Header, user)
+		}
+
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		if w.Code != http.StatusOK && w.Code != http.StatusCreated {
+			t.Fatalf("%v %v: expected success, got %v: %v", method, url, w.Code, w.Body.String())
+		}
+
+		err := json.Unmarshal(w.Body.Bytes(), v)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var created, spun, settled api.TableResponse
+
+	do(t, http.MethodPost, "/v1/tables", "host", &created)
+
+	if created.CreatedBy != "host" || created.ClosedAt != nil || created.SpunAt != nil || created.SettledAt != nil {
+		t.Fatalf("expected only the creation to be stamped, got %+v", created)
+	}
+
+	serve(t, r, http.MethodPut, fmt.Sprintf("/v1/tables/%v/settle", created.ID), "", "", http.StatusConflict)
+
+	do(t, http.MethodPut, fmt.Sprintf("/v1/tables/%v/spin", created.ID), "croupier", &spun)
+
+	if spun.ClosedBy != "croupier" || spun.SpunBy != "croupier" || spun.SpunAt == nil || spun.SpunAt.Before(created.CreatedAt) {
+		t.Fatalf("expected the spin to be stamped, got %+v", spun)
+	}
+
+	do(t, http.MethodPut, fmt.Sprintf("/v1/tables/%v/settle", created.ID), "", &settled)
+
+	if settled.SettledBy != actor.Anonymous || settled.SettledAt == nil || settled.SettledAt.Before(*spun.SpunAt) {
+		t.Fatalf("expected the settlement to be stamped, got %+v", settled)
+	}
+
+	if settled.CreatedBy != "host" || settled.SpunBy != "croupier" {
+		t.Fatalf("expected the earlier steps to be kept, got %+v", settled)
+	}
+
+	serve(t, r, http.MethodPut, fmt.Sprintf("/v1/tables/%v/spin", created.ID), "", "", http.StatusConflict)
+	serve(t, r, http.MethodPut, fmt.Sprintf("/v1/tables/%v/settle", created.ID), "", "", http.StatusConflict)
+
+	var again api.TableResponse
+
+	do(t, http.MethodGet, fmt.Sprintf("/v1/tables/%v", created.ID), "", &again)
+
+	if !cmp.Equal(again.Outcomes, settled.Outcomes) || !again.SettledAt.Equal(*settled.SettledAt) {
+		t.Fatalf("expected a settled table to keep its outcome and settlement, got %+v", again)
+	}
+}
+
+func TestLoad_Audit(t *testing.T) {
+	r := newRouter(t, 14)
+
+	do := func(t *testing.T, method, url, body, user string, v interface{}) {
+		t.Helper()
+
+		req := httptest.NewRequest(method, url, strings.NewReader(body))
+		if user != "" {
+			req.Header.Set(actor.Header, user)
+		}
+
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		if w.Code != http.StatusOK && w.Code != http.StatusCreated {
+			t.Fatalf("%v %v: expected success, got %v: %v", method, url, w.Code, w.Body.String())
+		}
+
+		err := json.Unmarshal(w.Body.Bytes(), v)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var created api.TableResponse
+	var placed api.BetResponse
+
+	bet := `{"stake": {"amount": 100, "currency": "GBP"}, "selectedSpaces": [14]}`
+
+	do(t, http.MethodPost, "/v1/tables", "", "host", &created)
+	do(t, http.MethodPost, fmt.Sprintf("/v1/tables/%v/bet", created.ID), bet, "player", &placed)
+	do(t, http.MethodPut, fmt.Sprintf("/v1/tables/%v/spin", created.ID), "", "croupier", &api.TableResponse{})
+	do(t, http.MethodPut, fmt.Sprintf("/v1/tables/%v/settle", created.ID), "", "croupier", &api.TableResponse{})
+
+	var entries []api.AuditEntry
+
+	do(t, http.MethodGet, "/v1/audit", "", "", &entries)
+
+	err := domain.VerifyAudit(api.AdaptAuditEntriesToDomain(entries))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type step struct {
+		Action  domain.AuditAction
+		Subject string
+		Actor   string
+		Route   string
+	}
+
+	actual := make([]step, len(entries))
+
+	for i := range entries {
+		actual[i] = step{entries[i].Action, entries[i].Subject.String(), entries[i].Actor, entries[i].Route}
+	}
+
+	table := created.ID.String()
+	expected := []step{
+		{domain.CreateTable, table, "host", "POST /v1/tables"},
+		{domain.PlaceBet, placed.ID.String(), "player", "POST /v1/tables/{id}/bet"},
+		{domain.SpinTable, table, "croupier", "PUT /v1/tables/{id}/spin"},
+		{domain.SettleTable, table, "croupier", "PUT /v1/tables/{id}/settle"},
+	}
+
+	if !cmp.Equal(actual, expected) {
+		t.Fatal(cmp.Diff(actual, expected))
+	}
+
+	if entries[1].BodyHash != origin.Hash([]byte(bet)) {
+		t.Fatalf("expected the digest of the bet placed, got %v", entries[1].BodyHash)
+	}
+
+	var changed []string
+
+	for _, change := range entries[3].Changes {
+		changed = append(changed, change.Field)
+	}
+
+	if !cmp.Equal(changed, []string{"Bets", "SettledAt", "SettledBy", "Version"}) {
+		t.Fatalf("expected settling to change the bets, stamps and version, got %v", changed)
+	}
+
+	var page []api.AuditEntry
+
+	do(t, http.MethodGet, "/v1/audit?subject="+table+"&actor=croupier", "", "", &page)
+
+	if len(page) != 2 || page[0].Action != domain.SpinTable || page[1].Action != domain.SettleTable {
+		t.Fatalf("expected the spin and settlement of the table, got %+v", page)
+	}
+}
+
+func TestLoad_Events(t *testing.T) {
+	r := newRouter(t, 14)
+
+	var created api.TableResponse
+
+	err := json.Unmarshal(serve(t, r, http.MethodPost, "/v1/tables", "", "", http.StatusCreated), &created)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	table := fmt.Sprintf("/v1/tables/%v", created.ID)
+
+	serve(t, r, http.MethodPost, table+"/bet", `{"stake": {"amount": 100, "currency": "GBP"}, "selectedSpaces": [14]}`, "",
+		http.StatusCreated)
+	serve(t, r, http.MethodPut, table+"/spin", "", "", http.StatusOK)
+	serve(t, r, http.MethodPut, table+"/settle", "", "", http.StatusOK)
+
+	var events []api.Event
+
+	err = json.Unmarshal(serve(t, r, http.MethodGet, table+"/events", "", "", http.StatusOK), &events)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	kinds := make([]domain.EventType, len(events))
+
+	for i := range events {
+		if events[i].Sequence != int64(i+1) {
+			t.Fatalf("expected the events in sequence, got %v at %v", events[i].Sequence, i)
+		}
+
+		kinds[i] = events[i].Type
+	}
+
+	expected := []domain.EventType{
+		domain.TableOpened,
+		domain.BetPlaced,
+		domain.TableClosed,
+		domain.BetsMarked,
+		domain.OutcomeDrawn,
+		domain.TableSettled,
+		domain.BetsMarked,
+		domain.BetsSettled,
+	}
+
+	if !cmp.Equal(kinds, expected) {
+		t.Fatal(cmp.Diff(kinds, expected))
+	}
+
+	serve(t, r, http.MethodGet, fmt.Sprintf("/v1/tables/%v/events", uuid.New()), "", "", http.StatusNotFound)
+}
+
+// serve sends the request to r, failing the test unless it is answered with the expected status.
+func serve(t *testing.T, r *mux.Router, method, url, body, ifMatch string, expectedStatus int) []byte {
+	t.Helper()
+
+	req := httptest.NewRequest(method, url, strings.NewReader(body))
+	if ifMatch != "" {
+		req.Header.Set("If-Match", ifMatch)
+	}
+
+	w := httptest.NewRecorder()
+
+	r.ServeHTTP(w, req)
+
+	if w.Code != expectedStatus {
+		t.Fatalf("%v %v: expected %v, got %v: %v", method, url, expectedStatus, w.Code, w.Body.String())
+	}
+
+	return w.Body.Bytes()
+}
+
+const (
+	placeRed = `{"selectedSpaces": [1, 3, 5, 7, 9, 12, 14, 16, 18, 19, 21, 23, 25, 27, 30, 32, 34, 36],
+		"stake": {"amount": 100, "currency": "GBP"}}`
+	placeBet     = `{"selectedSpaces": [14], "stake": {"amount": 100, "currency": "GBP"}}`
+	placeJackpot = `{"selectedSpaces": [14], "stake": {"amount": 1000, "currency": "GBP"}, "jackpot": true}`
+	placeSlip    = `{"bets": [` + placeBet + `, {"selectedSpaces": [1, 2], "stake": {"amount": 50, "currency": "GBP"}}]}`
+)
+
+func TestLoad_Export(t *testing.T) {
+	r := newRouter(t, 14)
+
+	var created api.TableResponse
+
+	err := json.Unmarshal(serve(t, r, http.MethodPost, "/v1/tables", "", "", http.StatusCreated), &created)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	serve(t, r, http.MethodPost, fmt.Sprintf("/v1/tables/%v/bet", created.ID),
+		`{"stake": {"amount": 150, "currency": "GBP"}, "selectedSpaces": [14]}`, "", http.StatusCreated)
+
+	from := created.CreatedAt.Add(-time.Minute).Format(time.RFC3339)
+	to := created.CreatedAt.Add(time.Minute).Format(time.RFC3339)
+	path := fmt.Sprintf("/v1/export?records=bets&format=jsonl&createdFrom=%v&createdTo=%v", from, to)
+
+	var bet api.ExportBet
+
+	err = json.Unmarshal(serve(t, r, http.MethodGet, path, "", "", http.StatusOK), &bet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if bet.Table != created.ID || bet.Stake != 150 || bet.Currency != "GBP" {
+		t.Fatalf("expected the bet placed on the table in minor units, got %+v", bet)
+	}
+
+	serve(t, r, http.MethodGet, "/v1/export?records=bets", "", "", http.StatusBadRequest)
+}
+
+func TestLoad_CreateThenConditionalSpin(t *testing.T) {
+	r := newRouter(t, 14)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/tables", nil)
+	w := httptest.NewRecorder()
+
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected %v, got %v: %v", http.StatusCreated, w.Code, w.Body.String())
+	}
+
+	var created api.TableResponse
+
+	err := json.Unmarshal(w.Body.Bytes(), &created)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	etag := w.Header().Get("ETag")
+	if etag != api.ETag(domain.FirstVersion) {
+		t.Fatalf("expected the etag of the stored version, got %v", etag)
+	}
+
+	serve(t, r, http.MethodPut, fmt.Sprintf("/v1/tables/%v/spin", created.ID), "", etag, http.StatusOK)
+}
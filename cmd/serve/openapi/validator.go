@@ -0,0 +1,191 @@
+package openapi
+
+import (
+	"betting/cmd/serve/problem"
+	"betting/internal/pkg/logging"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+)
+
+// Errors returned by the Validator.
+var (
+	ErrInvalidSpecification = errors.New("openapi specification is not valid")
+	ErrInvalidRequest       = errors.New("request does not match the openapi specification")
+	ErrInvalidResponse      = errors.New("response does not match the openapi specification")
+)
+
+// contentType is the only media type accepted in request bodies.
+const contentType = "application/json"
+
+// uuidPattern matches the textual form of a uuid.UUID in either case, regardless of its version.
+const uuidPattern = `^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`
+
+func init() {
+	openapi3.DefineStringFormat("uuid", uuidPattern)
+}
+
+// Validator checks requests and responses against an OpenAPI specification.
+type Validator struct {
+	Router routers.Router
+	// Strict replaces a response that does not match the specification with an error, otherwise it is only logged.
+	Strict bool
+}
+
+// NewValidator instantiates a Validator for the given specification.
+func NewValidator(specification []byte, strict bool) (Validator, error) {
+	doc, err := Parse(specification)
+	if err != nil {
+		return Validator{}, err
+	}
+
+	router, err := gorillamux.NewRouter(doc)
+	if err != nil {
+		return Validator{}, fmt.Errorf("%v: %w", err, ErrInvalidSpecification)
+	}
+
+	return Validator{
+		Router: router,
+		Strict: strict,
+	}, nil
+}
+
+// Parse parses and validates the given specification.
+func Parse(specification []byte) (*openapi3.T, error) {
+	doc, err := openapi3.NewLoader().LoadFromData(specification)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, ErrInvalidSpecification)
+	}
+
+	err = doc.Validate(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, ErrInvalidSpecification)
+	}
+
+	return doc, nil
+}
+
+// Middleware rejects requests that do not match the specification with a http.StatusBadRequest and checks the
+// response of those that do, in strict mode a mismatch is reported as a http.StatusInternalServerError. Requests to
+// routes absent from the specification are passed on untouched, as are the responses of streamed routes, which are
+// written as they are made rather than held to be checked.
+func (v Validator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route, params, err := v.Router.FindRoute(r)
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if r.ContentLength != 0 && r.Header.Get("Content-Type") == "" {
+			r.Header.Set("Content-Type", contentType)
+		}
+
+		input := &openapi3filter.RequestValidationInput{
+			Request:    r,
+			PathParams: params,
+			Route:      route,
+			Options: &openapi3filter.Options{
+				MultiError: true,
+			},
+		}
+
+		err = openapi3filter.ValidateRequest(r.Context(), input)
+		if err != nil {
+			logging.FromContext(r.Context()).WithError(err).Error("invalid request")
+
+			problem.WriteInvalid(w, r, fmt.Errorf("%v: %w", err, ErrInvalidRequest))
+			return
+		}
+
+		if isStreamed(route) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		recorder := newRecorder()
+		next.ServeHTTP(recorder, r)
+
+		err = openapi3filter.ValidateResponse(r.Context(), &openapi3filter.ResponseValidationInput{
+			RequestValidationInput: input,
+			Status:                 recorder.status,
+			Header:                 recorder.header,
+			Body:                   io.NopCloser(bytes.NewReader(recorder.body.Bytes())),
+			Options: &openapi3filter.Options{
+				IncludeResponseStatus: true,
+				MultiError:            true,
+			},
+		})
+		if err != nil {
+			logging.FromContext(r.Context()).WithError(err).Error("invalid response")
+
+			if v.Strict {
+				problem.Write(w, r, fmt.Errorf("%v: %w", err, ErrInvalidResponse))
+				return
+			}
+		}
+
+		recorder.flush(r.Context(), w)
+	})
+}
+
+// isStreamed reports whether the successful response of the route is made of something other than json, such as an
+// export.
+func isStreamed(route *routers.Route) bool {
+	response := route.Operation.Responses.Get(http.StatusOK)
+	if response == nil || response.Value == nil || len(response.Value.Content) == 0 {
+		return false
+	}
+
+	return response.Value.Content.Get(contentType) == nil
+}
+
+// recorder holds a response so it can be validated before being written.
+type recorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func newRecorder() *recorder {
+	return &recorder{
+		header: make(http.Header),
+		status: http.StatusOK,
+	}
+}
+
+func (r *recorder) Header() http.Header {
+	return r.header
+}
+
+func (r *recorder) Write(b []byte) (int, error) {
+	return r.body.Write(b)
+}
+
+func (r *recorder) WriteHeader(status int) {
+	r.status = status
+}
+
+// flush writes the held response to w.
+func (r *recorder) flush(ctx context.Context, w http.ResponseWriter) {
+	for key, values := range r.header {
+		for _, value := range values {
+			w.Header().Add(key, value)
+		}
+	}
+
+	w.WriteHeader(r.status)
+
+	_, err := w.Write(r.body.Bytes())
+	if err != nil {
+		logging.FromContext(ctx).WithError(err).Error("failed to write response")
+	}
+}
@@ -0,0 +1,178 @@
+package openapi
+
+import (
+	"betting/api"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+)
+
+func TestValidator_Middleware(t *testing.T) {
+	tests := []struct {
+		name           string
+		givenStrict    bool
+		givenMethod    string
+		givenURL       string
+		givenBody      string
+		givenResponse  string
+		givenStatus    int
+		expectedStatus int
+	}{
+		{
+			name:           "given a request and response matching the specification, expect the response to be written",
+			givenStrict:    true,
+			givenMethod:    http.MethodGet,
+			givenURL:       "/v1/bets/49cffe67-9798-4327-9760-c4b81562f928",
+			givenResponse:  validBet,
+			givenStatus:    http.StatusOK,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "given a bet without a stake, expect the request to be rejected",
+			givenStrict:    true,
+			givenMethod:    http.MethodPost,
+			givenURL:       "/v1/tables/0173b64f-e07e-4fa0-bcb3-231856390dce/bet",
+			givenBody:      `{"selectedSpaces": [5]}`,
+			givenResponse:  validBet,
+			givenStatus:    http.StatusCreated,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "given a bet on a position off the wheel, expect the request to be rejected",
+			givenStrict:    true,
+			givenMethod:    http.MethodPost,
+			givenURL:       "/v1/tables/0173b64f-e07e-4fa0-bcb3-231856390dce/bet",
+			givenBody:      `{"selectedSpaces": [37], "stake": {"amount": 100, "currency": "GBP"}}`,
+			givenResponse:  validBet,
+			givenStatus:    http.StatusCreated,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "given a bet without a content type, expect it to be read as json",
+			givenStrict:    true,
+			givenMethod:    http.MethodPost,
+			givenURL:       "/v1/tables/0173b64f-e07e-4fa0-bcb3-231856390dce/bet",
+			givenBody:      `{"selectedSpaces": [5], "stake": {"amount": 100, "currency": "GBP"}}`,
+			givenResponse:  validBet,
+			givenStatus:    http.StatusCreated,
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "given an unknown table state, expect the request to be rejected",
+			givenStrict:    true,
+			givenMethod:    http.MethodGet,
+			givenURL:       "/v1/tables?state=pending",
+			givenResponse:  `[]`,
+			givenStatus:    http.StatusOK,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "given a response not matching the specification when strict, expect it to be replaced",
+			givenStrict:    true,
+			givenMethod:    http.MethodGet,
+			givenURL:       "/v1/bets/49cffe67-9798-4327-9760-c4b81562f928",
+			givenResponse:  `{"id": "49cffe67-9798-4327-9760-c4b81562f928"}`,
+			givenStatus:    http.StatusOK,
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{
+			name:           "given a response not matching the specification when lenient, expect it to be written",
+			givenStrict:    false,
+			givenMethod:    http.MethodGet,
+			givenURL:       "/v1/bets/49cffe67-9798-4327-9760-c4b81562f928",
+			givenResponse:  `{"id": "49cffe67-9798-4327-9760-c4b81562f928"}`,
+			givenStatus:    http.StatusOK,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "given a streamed export, expect its response to be passed on unchecked",
+			givenStrict:    true,
+			givenMethod:    http.MethodGet,
+			givenURL:       "/v1/export?createdFrom=2021-06-01T00:00:00Z&createdTo=2021-07-01T00:00:00Z",
+			givenResponse:  "id,series\n",
+			givenStatus:    http.StatusOK,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "given an export without its range, expect the request to be rejected",
+			givenStrict:    true,
+			givenMethod:    http.MethodGet,
+			givenURL:       "/v1/export?records=bets",
+			givenResponse:  "id,table\n",
+			givenStatus:    http.StatusOK,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "given a route absent from the specification, expect it to be passed on",
+			givenStrict:    true,
+			givenMethod:    http.MethodGet,
+			givenURL:       "/v1/unspecified",
+			givenResponse:  `{}`,
+			givenStatus:    http.StatusOK,
+			expectedStatus: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			v, err := NewValidator(api.Specification, test.givenStrict)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			r := mux.NewRouter()
+			r.PathPrefix("/").HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Add("Content-Type", "application/json")
+				w.WriteHeader(test.givenStatus)
+				_, _ = w.Write([]byte(test.givenResponse))
+			})
+			r.Use(v.Middleware)
+
+			req := httptest.NewRequest(test.givenMethod, test.givenURL, strings.NewReader(test.givenBody))
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			if w.Code != test.expectedStatus {
+				t.Fatalf("expected %v, got %v: %v", test.expectedStatus, w.Code, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestNewValidator_Fail(t *testing.T) {
+	tests := []struct {
+		name               string
+		givenSpecification []byte
+	}{
+		{
+			name:               "given a specification that is not json, expect an error",
+			givenSpecification: []byte("openapi"),
+		},
+		{
+			name:               "given a specification missing its paths, expect an error",
+			givenSpecification: []byte(`{"openapi": "3.0.3", "info": {"title": "Roulette", "version": "1.0.0"}}`),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewValidator(test.givenSpecification, true)
+			if err == nil {
+				t.Fatalf("expected %v, got nil", ErrInvalidSpecification)
+			}
+		})
+	}
+}
+
+const validBet = `{
+	"id": "49cffe67-9798-4327-9760-c4b81562f928",
+	"placedAt": "2021-06-01T00:00:00Z",
+	"status": "unsettled",
+	"settledAt": null,
+	"win": false,
+	"selectedSpaces": [5],
+	"stake": {"amount": 100, "currency": "GBP"},
+	"table": "0173b64f-e07e-4fa0-bcb3-231856390dce"
+}`
@@ -0,0 +1,114 @@
+package problem
+
+import (
+	"betting/api"
+	"betting/internal/bet"
+	"betting/internal/domain"
+	"betting/internal/pkg/correlation"
+	"betting/internal/pkg/exposure"
+	"betting/internal/pkg/logging"
+	"betting/internal/pkg/responses"
+	"betting/internal/table"
+	"betting/storage/memory"
+	"errors"
+	"net/http"
+)
+
+// Problem types describing the errors raised while serving a request.
+const (
+	TypeNotFound          = "/problems/not-found"
+	TypeConflict          = "/problems/conflict"
+	TypeLimitExceeded     = "/problems/limit-exceeded"
+	TypeInsufficientFunds = "/problems/insufficient-funds"
+	TypeInvalid           = "/problems/invalid-request"
+	TypeModified          = "/problems/modified"
+)
+
+// mapping associates an error with the status and problem type it is reported as.
+type mapping struct {
+	err    error
+	status int
+	kind   string
+}
+
+// mappings are checked in order, the first whose error matches is used.
+var mappings = []mapping{
+	{err: memory.ErrNoTables, status: http.StatusNotFound, kind: TypeNotFound},
+	{err: memory.ErrInvalidKey, status: http.StatusNotFound, kind: TypeNotFound},
+	{err: memory.ErrNoEvents, status: http.StatusNotFound, kind: TypeNotFound},
+	{err: table.ErrFailedToFetchTable, status: http.StatusNotFound, kind: TypeNotFound},
+	{err: bet.ErrEmptySlip, status: http.StatusBadRequest, kind: TypeInvalid},
+	{err: bet.ErrNoJackpot, status: http.StatusBadRequest, kind: TypeInvalid},
+	{err: domain.ErrInvalidAnnouncement, status: http.StatusBadRequest, kind: TypeInvalid},
+	{err: domain.ErrInvalidStake, status: http.StatusBadRequest, kind: TypeInvalid},
+	{err: domain.ErrInvalidRules, status: http.StatusBadRequest, kind: TypeInvalid},
+	{err: bet.ErrTableClosed, status: http.StatusConflict, kind: TypeConflict},
+	{err: memory.ErrDuplicateKey, status: http.StatusConflict, kind: TypeConflict},
+	{err: memory.ErrDuplicateTable, status: http.StatusConflict, kind: TypeConflict},
+	{err: table.ErrTableClosed, status: http.StatusConflict, kind: TypeConflict},
+	{err: table.ErrTableSpun, status: http.StatusConflict, kind: TypeConflict},
+	{err: table.ErrTableNotSpun, status: http.StatusConflict, kind: TypeConflict},
+	{err: table.ErrTableSettled, status: http.StatusConflict, kind: TypeConflict},
+	{err: table.ErrPreviousNotSettled, status: http.StatusConflict, kind: TypeConflict},
+	{err: table.ErrPreviousContinued, status: http.StatusConflict, kind: TypeConflict},
+	{err: exposure.ErrLimitExceeded, status: http.StatusUnprocessableEntity, kind: TypeLimitExceeded},
+	{err: memory.ErrInsufficientFunds, status: http.StatusUnprocessableEntity, kind: TypeInsufficientFunds},
+	{err: table.ErrVersionConflict, status: http.StatusPreconditionFailed, kind: TypeModified},
+	{err: api.ErrUnmatchedETag, status: http.StatusPreconditionFailed, kind: TypeModified},
+}
+
+// Status returns the http.Status the given error is reported as, errors that are not known are internal.
+func Status(err error) int {
+	status, _ := lookup(err)
+
+	return status
+}
+
+func lookup(err error) (int, string) {
+	for _, m := range mappings {
+		if errors.Is(err, m.err) {
+			return m.status, m.kind
+		}
+	}
+
+	return http.StatusInternalServerError, responses.BlankType
+}
+
+// Write reports the error raised while serving r as problem details with the status it maps to. The detail of an
+// internal error is withheld from the client.
+func Write(w http.ResponseWriter, r *http.Request, err error) {
+	status, kind := lookup(err)
+
+	detail := err.Error()
+	if status == http.StatusInternalServerError {
+		detail = http.StatusText(status)
+	}
+
+	write(w, r, responses.Error{
+		Type:   kind,
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+	})
+}
+
+// WriteInvalid reports a request that could not be understood as problem details with a http.StatusBadRequest.
+func WriteInvalid(w http.ResponseWriter, r *http.Request, err error) {
+	write(w, r, responses.Error{
+		Type:   TypeInvalid,
+		Title:  http.StatusText(http.StatusBadRequest),
+		Status: http.StatusBadRequest,
+		Detail: err.Error(),
+	})
+}
+
+func write(w http.ResponseWriter, r *http.Request, problem responses.Error) {
+	problem.Instance = r.URL.Path
+	problem.CorrelationID = correlation.FromContext(r.Context())
+
+	if problem.Status == http.StatusInternalServerError {
+		logging.FromContext(r.Context()).Error("request failed with an internal error")
+	}
+
+	responses.NewJSON(w).Problem(problem)
+}
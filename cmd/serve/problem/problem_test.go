@@ -0,0 +1,179 @@
+package problem
+
+import (
+	"betting/internal/bet"
+	"betting/internal/domain"
+	"betting/internal/pkg/correlation"
+	"betting/internal/pkg/exposure"
+	"betting/internal/pkg/responses"
+	"betting/internal/table"
+	"betting/storage/memory"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestStatus(t *testing.T) {
+	tests := []struct {
+		name           string
+		givenError     error
+		expectedStatus int
+	}{
+		{
+			name:           "given a table that does not exist, expect 404",
+			givenError:     memory.ErrNoTables,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "given a bet that does not exist, expect 404",
+			givenError:     memory.ErrInvalidKey,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "given a table without events, expect 404",
+			givenError:     memory.ErrNoEvents,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "given the controller failed to locate the table, expect 404",
+			givenError:     fmt.Errorf("%v: %w", memory.ErrNoTables, table.ErrFailedToFetchTable),
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "given a side bet on a jackpot that is not offered, expect 400",
+			givenError:     bet.ErrNoJackpot,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "given a stake that is not positive, expect 400",
+			givenError:     fmt.Errorf("-£1.00: %w", domain.ErrInvalidStake),
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "given a closed table, expect 409",
+			givenError:     bet.ErrTableClosed,
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "given a table settled before it is spun, expect 409",
+			givenError:     table.ErrTableNotSpun,
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "given a table spun again, expect 409",
+			givenError:     table.ErrTableClosed,
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "given a table settled again, expect 409",
+			givenError:     table.ErrTableSettled,
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "given a previous table not yet settled, expect 409",
+			givenError:     table.ErrPreviousNotSettled,
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "given a previous table continued again, expect 409",
+			givenError:     table.ErrPreviousContinued,
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "given a duplicate bet, expect 409",
+			givenError:     memory.ErrDuplicateKey,
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "given the liability limit is exceeded, expect 422",
+			givenError:     fmt.Errorf("GBP: %w", exposure.ErrLimitExceeded),
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "given the wallet holds less than the stakes, expect 422",
+			givenError:     fmt.Errorf("holds £1.00 of £2.00: %w", memory.ErrInsufficientFunds),
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "given an unknown error, expect 500",
+			givenError:     fmt.Errorf("%v: %w", errors.New("disk full"), table.ErrFailedToSetWinners),
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := Status(test.givenError)
+
+			if !cmp.Equal(actual, test.expectedStatus) {
+				t.Fatal(cmp.Diff(actual, test.expectedStatus))
+			}
+		})
+	}
+}
+
+func TestWrite(t *testing.T) {
+	tests := []struct {
+		name         string
+		givenError   error
+		expectedBody responses.Error
+	}{
+		{
+			name:       "given a known error, expect its detail to be returned",
+			givenError: bet.ErrTableClosed,
+			expectedBody: responses.Error{
+				Type:          TypeConflict,
+				Title:         http.StatusText(http.StatusConflict),
+				Status:        http.StatusConflict,
+				Detail:        bet.ErrTableClosed.Error(),
+				Instance:      "/v1/tables/0173b64f-e07e-4fa0-bcb3-231856390dce/bet",
+				CorrelationID: "a1b2c3",
+			},
+		},
+		{
+			name:       "given an unknown error, expect its detail to be withheld",
+			givenError: errors.New("disk full"),
+			expectedBody: responses.Error{
+				Type:          responses.BlankType,
+				Title:         http.StatusText(http.StatusInternalServerError),
+				Status:        http.StatusInternalServerError,
+				Detail:        http.StatusText(http.StatusInternalServerError),
+				Instance:      "/v1/tables/0173b64f-e07e-4fa0-bcb3-231856390dce/bet",
+				CorrelationID: "a1b2c3",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/v1/tables/0173b64f-e07e-4fa0-bcb3-231856390dce/bet", nil)
+			req = req.WithContext(correlation.NewContext(req.Context(), "a1b2c3"))
+
+			rr := httptest.NewRecorder()
+
+			Write(rr, req, test.givenError)
+
+			if !cmp.Equal(rr.Code, test.expectedBody.Status) {
+				t.Fatal(cmp.Diff(rr.Code, test.expectedBody.Status))
+			}
+
+			if !cmp.Equal(rr.Header().Get("Content-Type"), responses.ProblemContentType) {
+				t.Fatal(cmp.Diff(rr.Header().Get("Content-Type"), responses.ProblemContentType))
+			}
+
+			var actual responses.Error
+
+			err := json.NewDecoder(rr.Body).Decode(&actual)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(actual, test.expectedBody) {
+				t.Fatal(cmp.Diff(actual, test.expectedBody))
+			}
+		})
+	}
+}
@@ -0,0 +1,257 @@
+package rpc
+
+import (
+	"betting/api"
+	"betting/api/pb"
+	"betting/internal/domain"
+	"time"
+
+	"github.com/Rhymond/go-money"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func adaptMoneyFromDomain(m *money.Money) *pb.Money {
+	if m == nil {
+		return nil
+	}
+
+	return &pb.Money{
+		Amount:   m.Amount(),
+		Currency: m.Currency().Code,
+	}
+}
+
+func adaptMoniesFromDomain(monies []*money.Money) []*pb.Money {
+	if monies == nil {
+		return nil
+	}
+
+	m := make([]*pb.Money, len(monies))
+
+	for i := range monies {
+		m[i] = adaptMoneyFromDomain(monies[i])
+	}
+
+	return m
+}
+
+func adaptMoneyToDomain(m *pb.Money) *money.Money {
+	if m == nil {
+		return nil
+	}
+
+	return money.New(m.Amount, m.Currency)
+}
+
+func adaptTimeFromDomain(t *time.Time) *timestamppb.Timestamp {
+	if t == nil || t.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
+
+func adaptSpacesFromDomain(spaces []int) []int32 {
+	s := make([]int32, len(spaces))
+
+	for i := range spaces {
+		s[i] = int32(spaces[i])
+	}
+
+	return s
+}
+
+func adaptSpacesToDomain(spaces []int32) []int {
+	s := make([]int, len(spaces))
+
+	for i := range spaces {
+		s[i] = int(spaces[i])
+	}
+
+	return s
+}
+
+func adaptAnnouncementFromDomain(announcement *domain.Announcement) *pb.Announcement {
+	if announcement == nil {
+		return nil
+	}
+
+	return &pb.Announcement{
+		Call:       announcement.Call.String(),
+		Number:     int32(announcement.Number),
+		Neighbours: int32(announcement.Neighbours),
+	}
+}
+
+func adaptAnnouncementToAPI(announcement *pb.Announcement) *api.Announcement {
+	if announcement == nil {
+		return nil
+	}
+
+	number := int(announcement.Number)
+
+	return &api.Announcement{
+		Call:       domain.Call(announcement.Call),
+		Number:     &number,
+		Neighbours: int(announcement.Neighbours),
+	}
+}
+
+func adaptPlacementsFromDomain(placements []domain.Placement) []*pb.Placement {
+	if placements == nil {
+		return nil
+	}
+
+	p := make([]*pb.Placement, len(placements))
+
+	for i := range placements {
+		p[i] = &pb.Placement{
+			SelectedSpaces: adaptSpacesFromDomain(placements[i].SelectedSpaces),
+			Stake:          adaptMoneyFromDomain(placements[i].Stake),
+			Win:            placements[i].Win,
+		}
+	}
+
+	return p
+}
+
+func adaptBetFromDomain(bet domain.Bet) *pb.Bet {
+	var imprisonedOn string
+	if bet.ImprisonedOn != uuid.Nil {
+		imprisonedOn = bet.ImprisonedOn.String()
+	}
+
+	return &pb.Bet{
+		Id:             bet.ID.String(),
+		Table:          bet.Table.String(),
+		SelectedSpaces: adaptSpacesFromDomain(bet.SelectedSpaces),
+		Stake:          adaptMoneyFromDomain(bet.Stake),
+		Status:         bet.Status.String(),
+		PlacedAt:       adaptTimeFromDomain(&bet.PlacedAt),
+		SettledAt:      adaptTimeFromDomain(bet.SettledAt),
+		Win:            bet.Win,
+		Announced:      adaptAnnouncementFromDomain(bet.Announcement),
+		Placements:     adaptPlacementsFromDomain(bet.Placements),
+		Refund:         adaptMoneyFromDomain(bet.Refund),
+		ImprisonedOn:   imprisonedOn,
+		Jackpot:        bet.Jackpot,
+		Contribution:   adaptMoneyFromDomain(bet.Contribution),
+		JackpotWin:     adaptMoneyFromDomain(bet.JackpotWin),
+	}
+}
+
+func adaptOutcomeFromDomain(outcome *domain.Outcome) *pb.Outcome {
+	if outcome == nil {
+		return nil
+	}
+
+	return &pb.Outcome{
+		Position: int32(outcome.Value),
+		Colour:   outcome.Colour.String(),
+		Seed:     outcome.Seed,
+	}
+}
+
+func adaptOutcomesFromDomain(outcomes []domain.Outcome) []*pb.Outcome {
+	if outcomes == nil {
+		return nil
+	}
+
+	os := make([]*pb.Outcome, len(outcomes))
+
+	for i := range outcomes {
+		os[i] = adaptOutcomeFromDomain(&outcomes[i])
+	}
+
+	return os
+}
+
+func adaptMultipliersFromDomain(multipliers []domain.Multiplier) []*pb.Multiplier {
+	if multipliers == nil {
+		return nil
+	}
+
+	ms := make([]*pb.Multiplier, len(multipliers))
+
+	for i := range multipliers {
+		ms[i] = &pb.Multiplier{
+			Position: int32(multipliers[i].Position),
+			Factor:   int32(multipliers[i].Factor),
+		}
+	}
+
+	return ms
+}
+
+func adaptRulesFromDomain(rules domain.Rules) *pb.Rules {
+	return &pb.Rules{
+		Zero:    rules.Zero.String(),
+		Variant: rules.Variant.String(),
+		Wheels:  int32(rules.Wheels),
+	}
+}
+
+func adaptRulesToDomain(rules *pb.Rules) domain.Rules {
+	if rules == nil {
+		return domain.Rules{}
+	}
+
+	return domain.Rules{
+		Zero:    domain.ZeroRule(rules.Zero),
+		Variant: domain.Variant(rules.Variant),
+		Wheels:  int(rules.Wheels),
+	}
+}
+
+func adaptTableFromDomain(table domain.Table) *pb.Table {
+	bets := make([]*pb.Bet, len(table.Bets))
+
+	for i := range table.Bets {
+		bets[i] = adaptBetFromDomain(table.Bets[i])
+	}
+
+	var previous string
+	if table.Previous != uuid.Nil {
+		previous = table.Previous.String()
+	}
+
+	// outcome predates tables with several balls so only carries the first, outcomes carries them all
+	var outcome *domain.Outcome
+	if table.IsSpun() {
+		outcome = &table.Outcomes[0]
+	}
+
+	return &pb.Table{
+		Id:          table.ID.String(),
+		Bets:        bets,
+		IsClosed:    table.IsClosed,
+		Outcome:     adaptOutcomeFromDomain(outcome),
+		CreatedAt:   adaptTimeFromDomain(&table.CreatedAt),
+		Outcomes:    adaptOutcomesFromDomain(table.Outcomes),
+		Multipliers: adaptMultipliersFromDomain(table.Multipliers),
+		Rules:       adaptRulesFromDomain(table.Rules),
+		Previous:    previous,
+		Version:     table.Version,
+	}
+}
+
+func adaptTableSummaryFromDomain(summary domain.TableSummary) *pb.TableSummary {
+	exposure := make([]*pb.PocketExposure, len(summary.Exposure))
+
+	for i := range summary.Exposure {
+		exposure[i] = &pb.PocketExposure{
+			Position:  int32(summary.Exposure[i].Position),
+			Colour:    summary.Exposure[i].Colour.String(),
+			Liability: adaptMoniesFromDomain(summary.Exposure[i].Liability),
+		}
+	}
+
+	return &pb.TableSummary{
+		Table:       summary.TableID.String(),
+		BetCount:    int32(summary.BetCount),
+		TotalStaked: adaptMoniesFromDomain(summary.TotalStaked),
+		Exposure:    exposure,
+		HouseResult: adaptMoniesFromDomain(summary.HouseResult),
+	}
+}
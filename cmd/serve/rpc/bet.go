@@ -0,0 +1,72 @@
+package rpc
+
+import (
+	"betting/api"
+	"betting/api/pb"
+	"betting/internal/domain"
+	"betting/internal/pkg/logging"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// BetController provides the business logic behind the BetService.
+type BetController interface {
+	Create(ctx context.Context, bet domain.Bet) (domain.Bet, error)
+	Get(ctx context.Context, id uuid.UUID) (domain.Bet, error)
+}
+
+// BetServer serves the BetService.
+type BetServer struct {
+	pb.UnimplementedBetServiceServer
+	Controller BetController
+}
+
+// NewBetServer instantiates a BetServer.
+func NewBetServer(controller BetController) *BetServer {
+	return &BetServer{
+		Controller: controller,
+	}
+}
+
+// PlaceBet inserts the given bet on its table, an announced bet is expanded into its chips as it is over HTTP.
+func (s *BetServer) PlaceBet(ctx context.Context, req *pb.PlaceBetRequest) (*pb.Bet, error) {
+	tableID, err := parseID(ctx, req.GetTable())
+	if err != nil {
+		return nil, err
+	}
+
+	betRequest := api.BetRequest{
+		SelectedSpaces: adaptSpacesToDomain(req.GetSelectedSpaces()),
+		Stake:          adaptMoneyToDomain(req.GetStake()),
+		Announced:      adaptAnnouncementToAPI(req.GetAnnounced()),
+		Jackpot:        req.GetJackpot(),
+		Table:          tableID,
+	}
+
+	bet, err := s.Controller.Create(ctx, api.AdaptBetToDomain(betRequest, tableID))
+	if err != nil {
+		logging.FromContext(ctx).WithField(logging.FieldTableID, tableID).WithError(err).Error("failed to create bet")
+		return nil, statusFromError(err)
+	}
+
+	logging.FromContext(ctx).WithField(logging.FieldBetID, bet.ID).Info("created bet")
+
+	return adaptBetFromDomain(bet), nil
+}
+
+// GetBet retrieves the bet for the given ID.
+func (s *BetServer) GetBet(ctx context.Context, req *pb.GetBetRequest) (*pb.Bet, error) {
+	id, err := parseID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	bet, err := s.Controller.Get(ctx, id)
+	if err != nil {
+		logging.FromContext(ctx).WithField(logging.FieldBetID, id).WithError(err).Error("failed to locate bet")
+		return nil, statusFromError(err)
+	}
+
+	return adaptBetFromDomain(bet), nil
+}
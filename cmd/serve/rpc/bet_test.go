@@ -0,0 +1,349 @@
+package rpc
+
+import (
+	"betting/api/pb"
+	"betting/internal/bet"
+	"betting/internal/domain"
+	"betting/internal/pkg/exposure"
+	"betting/storage/memory"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Rhymond/go-money"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var settledAt = time.Date(2021, 6, 1, 0, 2, 0, 0, time.UTC)
+
+func TestBetServer_PlaceBet_Success(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenRequest    *pb.PlaceBetRequest
+		givenController BetController
+		expectedBet     *pb.Bet
+	}{
+		{
+			name: "given a bet, expect it to be placed",
+			givenRequest: &pb.PlaceBetRequest{
+				Table:          "160998da-2d89-4f06-a690-fd189213958d",
+				SelectedSpaces: []int32{5},
+				Stake:          &pb.Money{Amount: 100, Currency: "GBP"},
+			},
+			givenController: mockBetController{
+				GivenBet: domain.Bet{
+					ID:             uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+					Status:         domain.Unsettled,
+					SelectedSpaces: []int{5},
+					Stake:          money.New(100, "GBP"),
+					PlacedAt:       time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC),
+					Table:          uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				},
+			},
+			expectedBet: &pb.Bet{
+				Id:             "e49779f6-3507-4063-bed8-18d50174868d",
+				Table:          "160998da-2d89-4f06-a690-fd189213958d",
+				SelectedSpaces: []int32{5},
+				Stake:          &pb.Money{Amount: 100, Currency: "GBP"},
+				Status:         "unsettled",
+				PlacedAt:       timestamp(time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name: "given an announced bet, expect it to be placed as its chips",
+			givenRequest: &pb.PlaceBetRequest{
+				Table:     "160998da-2d89-4f06-a690-fd189213958d",
+				Stake:     &pb.Money{Amount: 100, Currency: "GBP"},
+				Announced: &pb.Announcement{Call: "neighbours", Number: 0, Neighbours: 1},
+			},
+			givenController: mockBetController{
+				GivenBet: domain.Bet{
+					ID:             uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+					Status:         domain.Unsettled,
+					SelectedSpaces: []int{0, 26, 32},
+					Stake:          money.New(300, "GBP"),
+					Placements: []domain.Placement{
+						{SelectedSpaces: []int{26}, Stake: money.New(100, "GBP")},
+						{SelectedSpaces: []int{0}, Stake: money.New(100, "GBP")},
+						{SelectedSpaces: []int{32}, Stake: money.New(100, "GBP")},
+					},
+					PlacedAt: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC),
+					Table:    uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				},
+			},
+			expectedBet: &pb.Bet{
+				Id:             "e49779f6-3507-4063-bed8-18d50174868d",
+				Table:          "160998da-2d89-4f06-a690-fd189213958d",
+				SelectedSpaces: []int32{0, 26, 32},
+				Stake:          &pb.Money{Amount: 300, Currency: "GBP"},
+				Status:         "unsettled",
+				PlacedAt:       timestamp(time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)),
+				Announced:      &pb.Announcement{Call: "neighbours", Number: 0, Neighbours: 1},
+				Placements: []*pb.Placement{
+					{SelectedSpaces: []int32{26}, Stake: &pb.Money{Amount: 100, Currency: "GBP"}},
+					{SelectedSpaces: []int32{0}, Stake: &pb.Money{Amount: 100, Currency: "GBP"}},
+					{SelectedSpaces: []int32{32}, Stake: &pb.Money{Amount: 100, Currency: "GBP"}},
+				},
+			},
+		},
+		{
+			name: "given a bet with the jackpot side bet, expect its contribution to the pool",
+			givenRequest: &pb.PlaceBetRequest{
+				Table:          "160998da-2d89-4f06-a690-fd189213958d",
+				SelectedSpaces: []int32{5},
+				Stake:          &pb.Money{Amount: 100, Currency: "GBP"},
+				Jackpot:        true,
+			},
+			givenController: mockBetController{
+				GivenBet: domain.Bet{
+					ID:             uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+					Status:         domain.Unsettled,
+					SelectedSpaces: []int{5},
+					Stake:          money.New(100, "GBP"),
+					PlacedAt:       time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC),
+					Table:          uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+					Contribution:   money.New(1, "GBP"),
+				},
+			},
+			expectedBet: &pb.Bet{
+				Id:             "e49779f6-3507-4063-bed8-18d50174868d",
+				Table:          "160998da-2d89-4f06-a690-fd189213958d",
+				SelectedSpaces: []int32{5},
+				Stake:          &pb.Money{Amount: 100, Currency: "GBP"},
+				Status:         "unsettled",
+				PlacedAt:       timestamp(time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)),
+				Jackpot:        true,
+				Contribution:   &pb.Money{Amount: 1, Currency: "GBP"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := NewBetServer(test.givenController)
+
+			actual, err := s.PlaceBet(context.Background(), test.givenRequest)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(actual, test.expectedBet, protocmp.Transform()) {
+				t.Fatal(cmp.Diff(actual, test.expectedBet, protocmp.Transform()))
+			}
+		})
+	}
+}
+
+func TestBetServer_PlaceBet_Fail(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenRequest    *pb.PlaceBetRequest
+		givenController BetController
+		expectedCode    codes.Code
+	}{
+		{
+			name:            "given an invalid table id, expect an invalid argument",
+			givenRequest:    &pb.PlaceBetRequest{Table: "1"},
+			givenController: mockBetController{},
+			expectedCode:    codes.InvalidArgument,
+		},
+		{
+			name:         "given the table is closed, expect a failed precondition",
+			givenRequest: &pb.PlaceBetRequest{Table: "160998da-2d89-4f06-a690-fd189213958d"},
+			givenController: mockBetController{
+				GivenError: bet.ErrTableClosed,
+			},
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "given the bet exceeds the exposure limit, expect a failed precondition",
+			givenRequest: &pb.PlaceBetRequest{Table: "160998da-2d89-4f06-a690-fd189213958d"},
+			givenController: mockBetController{
+				GivenError: exposure.ErrLimitExceeded,
+			},
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "given a stake that is not positive, expect an invalid argument",
+			givenRequest: &pb.PlaceBetRequest{Table: "160998da-2d89-4f06-a690-fd189213958d"},
+			givenController: mockBetController{
+				GivenError: domain.ErrInvalidStake,
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "given an unknown call, expect an invalid argument",
+			givenRequest: &pb.PlaceBetRequest{
+				Table:     "160998da-2d89-4f06-a690-fd189213958d",
+				Announced: &pb.Announcement{Call: "jeu_zero"},
+			},
+			givenController: mockBetController{
+				GivenError: domain.ErrInvalidAnnouncement,
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "given the wallet holds less than the stake, expect a failed precondition",
+			givenRequest: &pb.PlaceBetRequest{Table: "160998da-2d89-4f06-a690-fd189213958d"},
+			givenController: mockBetController{
+				GivenError: memory.ErrInsufficientFunds,
+			},
+			expectedCode: codes.FailedPrecondition,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := NewBetServer(test.givenController)
+
+			_, err := s.PlaceBet(context.Background(), test.givenRequest)
+			if err == nil {
+				t.Fatalf("expected %v, got nil", test.expectedCode)
+			}
+
+			if status.Code(err) != test.expectedCode {
+				t.Fatalf("expected %v, got %v", test.expectedCode, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestBetServer_GetBet_Success(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenRequest    *pb.GetBetRequest
+		givenController BetController
+		expectedBet     *pb.Bet
+	}{
+		{
+			name:         "given a bet released from prison, expect its refund and the table it was imprisoned on",
+			givenRequest: &pb.GetBetRequest{Id: "e49779f6-3507-4063-bed8-18d50174868d"},
+			givenController: mockBetController{
+				GivenBet: domain.Bet{
+					ID:             uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+					Status:         domain.Settled,
+					SelectedSpaces: []int{1, 3, 5, 7, 9, 12, 14, 16, 18, 19, 21, 23, 25, 27, 30, 32, 34, 36},
+					Stake:          money.New(100, "GBP"),
+					PlacedAt:       time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC),
+					SettledAt:      &settledAt,
+					Refund:         money.New(100, "GBP"),
+					ImprisonedOn:   uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
+					Table:          uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				},
+			},
+			expectedBet: &pb.Bet{
+				Id:             "e49779f6-3507-4063-bed8-18d50174868d",
+				Table:          "160998da-2d89-4f06-a690-fd189213958d",
+				SelectedSpaces: []int32{1, 3, 5, 7, 9, 12, 14, 16, 18, 19, 21, 23, 25, 27, 30, 32, 34, 36},
+				Stake:          &pb.Money{Amount: 100, Currency: "GBP"},
+				Status:         "settled",
+				PlacedAt:       timestamp(time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)),
+				SettledAt:      timestamp(settledAt),
+				Refund:         &pb.Money{Amount: 100, Currency: "GBP"},
+				ImprisonedOn:   "0173b64f-e07e-4fa0-bcb3-231856390dce",
+			},
+		},
+		{
+			name:         "given a bet paid from the jackpot, expect its share of the pool",
+			givenRequest: &pb.GetBetRequest{Id: "e49779f6-3507-4063-bed8-18d50174868d"},
+			givenController: mockBetController{
+				GivenBet: domain.Bet{
+					ID:             uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+					Status:         domain.Settled,
+					SelectedSpaces: []int{5},
+					Stake:          money.New(100, "GBP"),
+					PlacedAt:       time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC),
+					SettledAt:      &settledAt,
+					Win:            true,
+					Table:          uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+					Jackpot:        true,
+					Contribution:   money.New(1, "GBP"),
+					JackpotWin:     money.New(25000, "GBP"),
+				},
+			},
+			expectedBet: &pb.Bet{
+				Id:             "e49779f6-3507-4063-bed8-18d50174868d",
+				Table:          "160998da-2d89-4f06-a690-fd189213958d",
+				SelectedSpaces: []int32{5},
+				Stake:          &pb.Money{Amount: 100, Currency: "GBP"},
+				Status:         "settled",
+				PlacedAt:       timestamp(time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)),
+				SettledAt:      timestamp(settledAt),
+				Win:            true,
+				Jackpot:        true,
+				Contribution:   &pb.Money{Amount: 1, Currency: "GBP"},
+				JackpotWin:     &pb.Money{Amount: 25000, Currency: "GBP"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := NewBetServer(test.givenController)
+
+			actual, err := s.GetBet(context.Background(), test.givenRequest)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(actual, test.expectedBet, protocmp.Transform()) {
+				t.Fatal(cmp.Diff(actual, test.expectedBet, protocmp.Transform()))
+			}
+		})
+	}
+}
+
+func TestBetServer_GetBet_Fail(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenRequest    *pb.GetBetRequest
+		givenController BetController
+		expectedCode    codes.Code
+	}{
+		{
+			name:         "given the bet does not exist, expect not found",
+			givenRequest: &pb.GetBetRequest{Id: "e49779f6-3507-4063-bed8-18d50174868d"},
+			givenController: mockBetController{
+				GivenError: memory.ErrInvalidKey,
+			},
+			expectedCode: codes.NotFound,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := NewBetServer(test.givenController)
+
+			_, err := s.GetBet(context.Background(), test.givenRequest)
+			if err == nil {
+				t.Fatalf("expected %v, got nil", test.expectedCode)
+			}
+
+			if status.Code(err) != test.expectedCode {
+				t.Fatalf("expected %v, got %v", test.expectedCode, status.Code(err))
+			}
+		})
+	}
+}
+
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	return timestamppb.New(t)
+}
+
+type mockBetController struct {
+	GivenBet   domain.Bet
+	GivenError error
+}
+
+func (m mockBetController) Create(_ context.Context, bet domain.Bet) (domain.Bet, error) {
+	placed := m.GivenBet
+	placed.Announcement = bet.Announcement
+	placed.Jackpot = bet.Jackpot
+
+	return placed, m.GivenError
+}
+
+func (m mockBetController) Get(_ context.Context, _ uuid.UUID) (domain.Bet, error) {
+	return m.GivenBet, m.GivenError
+}
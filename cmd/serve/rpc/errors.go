@@ -0,0 +1,39 @@
+package rpc
+
+import (
+	"betting/internal/bet"
+	"betting/internal/domain"
+	"betting/internal/pkg/exposure"
+	"betting/internal/table"
+	"betting/storage/memory"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Errors returned by the servers.
+var (
+	ErrInvalidID = errors.New("id given is not a uuid")
+)
+
+// statusFromError translates an error raised by a controller to a gRPC status.
+func statusFromError(err error) error {
+	switch {
+	case errors.Is(err, memory.ErrNoTables), errors.Is(err, memory.ErrInvalidKey), errors.Is(err, table.ErrFailedToFetchTable):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, bet.ErrTableClosed), errors.Is(err, exposure.ErrLimitExceeded), errors.Is(err, memory.ErrInsufficientFunds):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, table.ErrTableClosed), errors.Is(err, table.ErrTableSpun), errors.Is(err, table.ErrTableNotSpun),
+		errors.Is(err, table.ErrTableSettled), errors.Is(err, table.ErrPreviousNotSettled), errors.Is(err, table.ErrPreviousContinued):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrInvalidStake), errors.Is(err, domain.ErrInvalidRules), errors.Is(err, domain.ErrInvalidAnnouncement):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, memory.ErrDuplicateKey), errors.Is(err, memory.ErrDuplicateTable):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, table.ErrVersionConflict):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
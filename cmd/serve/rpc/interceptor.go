@@ -0,0 +1,111 @@
+package rpc
+
+import (
+	"betting/internal/pkg/actor"
+	"betting/internal/pkg/correlation"
+	"betting/internal/pkg/logging"
+	"betting/internal/pkg/origin"
+	"context"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// requestIDKey is the metadata key carrying the ID of a call, the equivalent of correlation.Header.
+var requestIDKey = strings.ToLower(correlation.Header)
+
+// userKey is the metadata key carrying the user making a call, the equivalent of actor.Header.
+var userKey = strings.ToLower(actor.Header)
+
+// UnaryInterceptor associates every call with a request ID and a logger tagged with it, as the HTTP middleware does
+// for requests. The origin of the call is its method and the digest of its request message.
+func UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+
+	ctx, logger := withRequestID(ctx)
+
+	var body []byte
+	if m, ok := req.(proto.Message); ok {
+		// a message which cannot be marshalled is hashed as an empty body, the call is still served
+		body, _ = proto.MarshalOptions{Deterministic: true}.Marshal(m)
+	}
+
+	ctx = origin.NewContext(ctx, origin.Origin{
+		Route:    info.FullMethod,
+		BodyHash: origin.Hash(body),
+	})
+
+	res, err := handler(ctx, req)
+
+	logCall(logger, info.FullMethod, start, err)
+
+	return res, err
+}
+
+// StreamInterceptor associates every stream with a request ID and a logger tagged with it.
+func StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+
+	ctx, logger := withRequestID(ss.Context())
+
+	err := handler(srv, &stream{
+		ServerStream: ss,
+		ctx:          ctx,
+	})
+
+	logCall(logger, info.FullMethod, start, err)
+
+	return err
+}
+
+// withRequestID carries the request ID given in the metadata of the call, or a new one, and a logger tagged with it
+// through ctx. The ID is returned to the client in the response header. The user making the call is carried too.
+func withRequestID(ctx context.Context) (context.Context, *log.Entry) {
+	var id string
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md.Get(requestIDKey)) > 0 {
+		id = md.Get(requestIDKey)[0]
+	}
+
+	id = correlation.Ensure(id)
+
+	// the header cannot be set once the call has finished, which leaves nothing to be done about the error
+	_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDKey, id))
+
+	logger := logging.FromContext(ctx).WithField(logging.FieldRequestID, id)
+
+	var user string
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md.Get(userKey)) > 0 {
+		user = md.Get(userKey)[0]
+	}
+
+	ctx = correlation.NewContext(ctx, id)
+	ctx = actor.NewContext(ctx, user)
+	ctx = logging.NewContext(ctx, logger)
+
+	return ctx, logger
+}
+
+func logCall(logger *log.Entry, method string, start time.Time, err error) {
+	logger.WithFields(log.Fields{
+		"method":      method,
+		"code":        status.Code(err).String(),
+		"duration_ms": time.Since(start).Milliseconds(),
+	}).Info("served call")
+}
+
+// stream overrides the context of a grpc.ServerStream.
+type stream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *stream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,80 @@
+package rpc
+
+import (
+	"betting/internal/pkg/actor"
+	"betting/internal/pkg/correlation"
+	"betting/internal/pkg/logging"
+	"betting/internal/pkg/origin"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestUnaryInterceptor(t *testing.T) {
+	tests := []struct {
+		name          string
+		givenMetadata metadata.MD
+		expectKept    bool
+		expectedActor string
+	}{
+		{
+			name:          "given a request id and user, expect them to be kept",
+			givenMetadata: metadata.Pairs(requestIDKey, "a1b2c3", userKey, "croupier-7"),
+			expectKept:    true,
+			expectedActor: "croupier-7",
+		},
+		{
+			name:          "given no request id, expect one to be generated and the actor to be anonymous",
+			givenMetadata: metadata.MD{},
+			expectKept:    false,
+			expectedActor: actor.Anonymous,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var id, user string
+			var called origin.Origin
+			var tagged interface{}
+
+			ctx := metadata.NewIncomingContext(context.Background(), test.givenMetadata)
+
+			_, err := UnaryInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/roulette.TableService/GetTable"},
+				func(ctx context.Context, _ interface{}) (interface{}, error) {
+					id = correlation.FromContext(ctx)
+					user = actor.FromContext(ctx)
+					called = origin.FromContext(ctx)
+					tagged = logging.FromContext(ctx).Data[logging.FieldRequestID]
+
+					return nil, nil
+				})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if id == "" {
+				t.Fatal("expected a request id, got none")
+			}
+
+			if !cmp.Equal(tagged, id) {
+				t.Fatal(cmp.Diff(tagged, id))
+			}
+
+			if kept := id == "a1b2c3"; kept != test.expectKept {
+				t.Fatalf("expected kept to be %v, got %v", test.expectKept, kept)
+			}
+
+			if !cmp.Equal(user, test.expectedActor) {
+				t.Fatal(cmp.Diff(user, test.expectedActor))
+			}
+
+			expectedOrigin := origin.Origin{Route: "/roulette.TableService/GetTable", BodyHash: origin.Hash(nil)}
+
+			if !cmp.Equal(called, expectedOrigin) {
+				t.Fatal(cmp.Diff(called, expectedOrigin))
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package rpc
+
+import (
+	"betting/api/pb"
+	"betting/internal/bet"
+	"betting/internal/pkg/aggregator"
+	"betting/internal/pkg/broadcaster"
+	"betting/internal/pkg/winnerlocator"
+	"betting/internal/table"
+
+	"google.golang.org/grpc"
+)
+
+// Jackpot takes the contributions of side bets and pays out its pool to the tables triggering it.
+type Jackpot interface {
+	table.Jackpot
+	bet.Jackpot
+}
+
+// Load registers the TableService and BetService on the given server, table updates are published to and watched
+// through the given broadcaster. Spins, settlements and bets are run within the given unit of work and the outcome of
+// every spin is recorded in the given history.
+func Load(
+	s *grpc.Server,
+	tableStorage table.StorageProvider,
+	betStorage bet.StorageProvider,
+	placer table.BallPlacer,
+	limiter bet.ExposureLimiter,
+	b *broadcaster.Broadcaster,
+	unitOfWork table.UnitOfWork,
+	jackpot Jackpot,
+	wallet bet.Wallet,
+	history table.History,
+	clock table.Clock,
+	auditor table.Auditor,
+) *grpc.Server {
+	tableController := table.NewController(table.ControllerParams{
+		RepositoryProvider:    table.NewRepository(tableStorage),
+		BallPlacer:            placer,
+		WinnerLocator:         winnerlocator.New(),
+		BetRepositoryProvider: bet.NewRepository(betStorage),
+		Aggregator:            aggregator.New(),
+		Notifier:              b,
+		UnitOfWork:            unitOfWork,
+		Jackpot:               jackpot,
+		History:               history,
+		Clock:                 clock,
+		Auditor:               auditor,
+	})
+
+	betController := bet.NewController(bet.ControllerParams{
+		RepositoryProvider: bet.NewRepository(betStorage),
+		TableRepoProvider:  table.NewRepository(tableStorage),
+		ExposureLimiter:    limiter,
+		Jackpot:            jackpot,
+		Wallet:             wallet,
+		UnitOfWork:         unitOfWork,
+		Clock:              clock,
+		Auditor:            auditor,
+	})
+
+	pb.RegisterTableServiceServer(s, NewTableServer(tableController, b))
+	pb.RegisterBetServiceServer(s, NewBetServer(betController))
+
+	return s
+}
@@ -0,0 +1,253 @@
+package rpc
+
+import (
+	"betting/api"
+	"betting/api/pb"
+	"betting/internal/domain"
+	"betting/internal/pkg/logging"
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TableController provides the business logic behind the TableService.
+type TableController interface {
+	Create(ctx context.Context, rules domain.Rules, previous uuid.UUID) (domain.Table, error)
+	Spin(ctx context.Context, id uuid.UUID, version int64) (domain.Table, error)
+	Settle(ctx context.Context, id uuid.UUID, version int64) (domain.Table, error)
+	Get(ctx context.Context, id uuid.UUID) (domain.Table, error)
+	List(ctx context.Context, query domain.TableQuery) (domain.TablePage, error)
+	Summary(ctx context.Context, id uuid.UUID) (domain.TableSummary, error)
+}
+
+// Subscriber provides the updates made to a Table.
+type Subscriber interface {
+	Subscribe(id uuid.UUID) (<-chan domain.Table, func())
+}
+
+// TableServer serves the TableService.
+type TableServer struct {
+	pb.UnimplementedTableServiceServer
+	Controller TableController
+	Subscriber Subscriber
+}
+
+// NewTableServer instantiates a TableServer.
+func NewTableServer(controller TableController, subscriber Subscriber) *TableServer {
+	return &TableServer{
+		Controller: controller,
+		Subscriber: subscriber,
+	}
+}
+
+// CreateTable creates a table where which bets can be placed on it, played by the given rules. Given a previous table
+// the new table is its next round.
+func (s *TableServer) CreateTable(ctx context.Context, req *pb.CreateTableRequest) (*pb.Table, error) {
+	previous := uuid.Nil
+
+	if req.GetPrevious() != "" {
+		id, err := parseID(ctx, req.GetPrevious())
+		if err != nil {
+			return nil, err
+		}
+
+		previous = id
+	}
+
+	table, err := s.Controller.Create(ctx, adaptRulesToDomain(req.GetRules()), previous)
+	if err != nil {
+		logging.FromContext(ctx).WithError(err).Error("failed to create table")
+		return nil, statusFromError(err)
+	}
+
+	logging.FromContext(ctx).WithField(logging.FieldTableID, table.ID).Info("created table")
+
+	return adaptTableFromDomain(table), nil
+}
+
+// GetTable retrieves the table for the given ID.
+func (s *TableServer) GetTable(ctx context.Context, req *pb.GetTableRequest) (*pb.Table, error) {
+	id, err := parseID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	table, err := s.Controller.Get(ctx, id)
+	if err != nil {
+		logging.FromContext(ctx).WithField(logging.FieldTableID, id).WithError(err).Error("failed to locate table")
+		return nil, statusFromError(err)
+	}
+
+	return adaptTableFromDomain(table), nil
+}
+
+// ListTables retrieves a page of tables, it applies the same filters and validation as GET /v1/tables.
+func (s *TableServer) ListTables(ctx context.Context, req *pb.ListTablesRequest) (*pb.ListTablesResponse, error) {
+	query, err := api.ParseTableQuery(adaptListTablesRequest(req))
+	if err != nil {
+		logging.FromContext(ctx).WithError(err).Error("invalid table query")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	page, err := s.Controller.List(ctx, query)
+	if err != nil {
+		logging.FromContext(ctx).WithError(err).Error("failed to list tables")
+		return nil, statusFromError(err)
+	}
+
+	tables := make([]*pb.Table, len(page.Tables))
+
+	for i := range page.Tables {
+		tables[i] = adaptTableFromDomain(page.Tables[i])
+	}
+
+	res := &pb.ListTablesResponse{
+		Tables: tables,
+	}
+
+	if page.Next != nil {
+		res.NextCursor = api.EncodeTableCursor(*page.Next)
+	}
+
+	return res, nil
+}
+
+// GetTableSummary returns the stakes, exposure per pocket and house result for the table with the given ID.
+func (s *TableServer) GetTableSummary(ctx context.Context, req *pb.GetTableSummaryRequest) (*pb.TableSummary, error) {
+	id, err := parseID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := s.Controller.Summary(ctx, id)
+	if err != nil {
+		logging.FromContext(ctx).WithField(logging.FieldTableID, id).WithError(err).Error("failed to summarise table")
+		return nil, statusFromError(err)
+	}
+
+	return adaptTableSummaryFromDomain(summary), nil
+}
+
+// SpinTable places the ball on the table with the given ID and closes it, provided it is still at the expected version.
+func (s *TableServer) SpinTable(ctx context.Context, req *pb.SpinTableRequest) (*pb.Table, error) {
+	id, err := parseID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	table, err := s.Controller.Spin(ctx, id, req.GetExpectedVersion())
+	if err != nil {
+		logging.FromContext(ctx).WithField(logging.FieldTableID, id).WithError(err).Error("failed to spin table")
+		return nil, statusFromError(err)
+	}
+
+	return adaptTableFromDomain(table), nil
+}
+
+// SettleTable settles every bet placed on the table with the given ID, provided it is still at the expected version.
+func (s *TableServer) SettleTable(ctx context.Context, req *pb.SettleTableRequest) (*pb.Table, error) {
+	id, err := parseID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	table, err := s.Controller.Settle(ctx, id, req.GetExpectedVersion())
+	if err != nil {
+		logging.FromContext(ctx).WithField(logging.FieldTableID, id).WithError(err).Error("failed to settle table")
+		return nil, statusFromError(err)
+	}
+
+	return adaptTableFromDomain(table), nil
+}
+
+// WatchTable sends the current state of the table with the given ID followed by every update made to it once spun or
+// settled, until the client goes away.
+func (s *TableServer) WatchTable(req *pb.WatchTableRequest, stream pb.TableService_WatchTableServer) error {
+	ctx := stream.Context()
+
+	id, err := parseID(ctx, req.GetId())
+	if err != nil {
+		return err
+	}
+
+	// subscribing before reading the table ensures no update made in between is missed
+	updates, unsubscribe := s.Subscriber.Subscribe(id)
+	defer unsubscribe()
+
+	table, err := s.Controller.Get(ctx, id)
+	if err != nil {
+		logging.FromContext(ctx).WithField(logging.FieldTableID, id).WithError(err).Error("failed to locate table")
+		return statusFromError(err)
+	}
+
+	err = stream.Send(adaptTableFromDomain(table))
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case update, ok := <-updates:
+			if !ok {
+				return nil
+			}
+
+			err = stream.Send(adaptTableFromDomain(update))
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// adaptListTablesRequest restates a ListTablesRequest as the query parameters of GET /v1/tables.
+func adaptListTablesRequest(req *pb.ListTablesRequest) url.Values {
+	values := url.Values{}
+
+	set := func(key, value string) {
+		if value != "" {
+			values.Set(key, value)
+		}
+	}
+
+	set(api.QueryState, req.GetState())
+	set(api.QueryColour, req.GetColour())
+	set(api.QueryOrder, req.GetOrder())
+	set(api.QueryCursor, req.GetCursor())
+
+	if req.GetCreatedFrom() != nil {
+		set(api.QueryCreatedFrom, req.GetCreatedFrom().AsTime().Format(time.RFC3339Nano))
+	}
+
+	if req.GetCreatedTo() != nil {
+		set(api.QueryCreatedTo, req.GetCreatedTo().AsTime().Format(time.RFC3339Nano))
+	}
+
+	if req.GetLimit() != 0 {
+		set(api.QueryLimit, strconv.Itoa(int(req.GetLimit())))
+	}
+
+	if req.GetOmitBets() {
+		set(api.QueryBets, strconv.FormatBool(false))
+	}
+
+	return values
+}
+
+// parseID parses the given ID, an InvalidArgument status is returned when it is not a uuid.
+func parseID(ctx context.Context, id string) (uuid.UUID, error) {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		logging.FromContext(ctx).WithField(logging.FieldID, id).WithError(ErrInvalidID).Error("invalid id")
+		return uuid.Nil, status.Error(codes.InvalidArgument, ErrInvalidID.Error())
+	}
+
+	return parsed, nil
+}
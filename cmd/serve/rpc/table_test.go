@@ -0,0 +1,674 @@
+package rpc
+
+import (
+	"betting/api/pb"
+	"betting/internal/domain"
+	"betting/internal/pkg/broadcaster"
+	"betting/internal/table"
+	"betting/storage/memory"
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestTableServer_CreateTable_Success(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenRequest    *pb.CreateTableRequest
+		givenController TableController
+		expectedTable   *pb.Table
+	}{
+		{
+			name:         "given no rules, expect a table played by the default rules",
+			givenRequest: &pb.CreateTableRequest{},
+			givenController: mockTableController{
+				GivenTable: domain.Table{ID: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d")},
+			},
+			expectedTable: &pb.Table{
+				Id:    "160998da-2d89-4f06-a690-fd189213958d",
+				Bets:  []*pb.Bet{},
+				Rules: &pb.Rules{},
+			},
+		},
+		{
+			name: "given rules and a previous table, expect the next round played by the rules",
+			givenRequest: &pb.CreateTableRequest{
+				Rules:    &pb.Rules{Zero: "none", Variant: "multi_wheel", Wheels: 3},
+				Previous: "0173b64f-e07e-4fa0-bcb3-231856390dce",
+			},
+			givenController: mockTableController{
+				GivenTable: domain.Table{ID: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d")},
+			},
+			expectedTable: &pb.Table{
+				Id:       "160998da-2d89-4f06-a690-fd189213958d",
+				Bets:     []*pb.Bet{},
+				Rules:    &pb.Rules{Zero: "none", Variant: "multi_wheel", Wheels: 3},
+				Previous: "0173b64f-e07e-4fa0-bcb3-231856390dce",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := NewTableServer(test.givenController, broadcaster.New())
+
+			actual, err := s.CreateTable(context.Background(), test.givenRequest)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(actual, test.expectedTable, protocmp.Transform()) {
+				t.Fatal(cmp.Diff(actual, test.expectedTable, protocmp.Transform()))
+			}
+		})
+	}
+}
+
+func TestTableServer_CreateTable_Fail(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenRequest    *pb.CreateTableRequest
+		givenController TableController
+		expectedCode    codes.Code
+	}{
+		{
+			name:            "given an invalid previous id, expect an invalid argument",
+			givenRequest:    &pb.CreateTableRequest{Previous: "1"},
+			givenController: mockTableController{},
+			expectedCode:    codes.InvalidArgument,
+		},
+		{
+			name:         "given unknown rules, expect an invalid argument",
+			givenRequest: &pb.CreateTableRequest{Rules: &pb.Rules{Variant: "triple_ball"}},
+			givenController: mockTableController{
+				GivenError: domain.ErrInvalidRules,
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "given the previous table is not settled, expect a failed precondition",
+			givenRequest: &pb.CreateTableRequest{Previous: "0173b64f-e07e-4fa0-bcb3-231856390dce"},
+			givenController: mockTableController{
+				GivenError: table.ErrPreviousNotSettled,
+			},
+			expectedCode: codes.FailedPrecondition,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := NewTableServer(test.givenController, broadcaster.New())
+
+			_, err := s.CreateTable(context.Background(), test.givenRequest)
+			if err == nil {
+				t.Fatalf("expected %v, got nil", test.expectedCode)
+			}
+
+			if status.Code(err) != test.expectedCode {
+				t.Fatalf("expected %v, got %v", test.expectedCode, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestTableServer_GetTable_Success(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenRequest    *pb.GetTableRequest
+		givenController TableController
+		expectedTable   *pb.Table
+	}{
+		{
+			name:         "given an id, expect the table to be returned",
+			givenRequest: &pb.GetTableRequest{Id: "160998da-2d89-4f06-a690-fd189213958d"},
+			givenController: mockTableController{
+				GivenTable: domain.Table{
+					ID:       uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+					IsClosed: true,
+					Outcomes: []domain.Outcome{{
+						Value:  16,
+						Colour: domain.Red,
+					}},
+				},
+			},
+			expectedTable: &pb.Table{
+				Id:       "160998da-2d89-4f06-a690-fd189213958d",
+				Bets:     []*pb.Bet{},
+				IsClosed: true,
+				Outcome: &pb.Outcome{
+					Position: 16,
+					Colour:   "red",
+				},
+				Outcomes: []*pb.Outcome{{
+					Position: 16,
+					Colour:   "red",
+				}},
+				Rules: &pb.Rules{},
+			},
+		},
+		{
+			name:         "given a table spun with two balls, expect every outcome to be returned",
+			givenRequest: &pb.GetTableRequest{Id: "160998da-2d89-4f06-a690-fd189213958d"},
+			givenController: mockTableController{
+				GivenTable: domain.Table{
+					ID:       uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+					IsClosed: true,
+					Outcomes: []domain.Outcome{{Value: 16, Colour: domain.Red}, {Value: 15, Colour: domain.Black}},
+					Rules:    domain.Rules{Zero: domain.NoZeroRule, Variant: domain.DoubleBall},
+				},
+			},
+			expectedTable: &pb.Table{
+				Id:       "160998da-2d89-4f06-a690-fd189213958d",
+				Bets:     []*pb.Bet{},
+				IsClosed: true,
+				Outcome:  &pb.Outcome{Position: 16, Colour: "red"},
+				Outcomes: []*pb.Outcome{{Position: 16, Colour: "red"}, {Position: 15, Colour: "black"}},
+				Rules:    &pb.Rules{Zero: "none", Variant: "double_ball"},
+			},
+		},
+		{
+			name:         "given a lightning table, expect the multipliers struck to be returned",
+			givenRequest: &pb.GetTableRequest{Id: "160998da-2d89-4f06-a690-fd189213958d"},
+			givenController: mockTableController{
+				GivenTable: domain.Table{
+					ID:          uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+					Multipliers: []domain.Multiplier{{Position: 17, Factor: 500}, {Position: 32, Factor: 50}},
+					Rules:       domain.Rules{Variant: domain.Lightning},
+				},
+			},
+			expectedTable: &pb.Table{
+				Id:          "160998da-2d89-4f06-a690-fd189213958d",
+				Bets:        []*pb.Bet{},
+				Multipliers: []*pb.Multiplier{{Position: 17, Factor: 500}, {Position: 32, Factor: 50}},
+				Rules:       &pb.Rules{Variant: "lightning"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := NewTableServer(test.givenController, broadcaster.New())
+
+			actual, err := s.GetTable(context.Background(), test.givenRequest)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(actual, test.expectedTable, protocmp.Transform()) {
+				t.Fatal(cmp.Diff(actual, test.expectedTable, protocmp.Transform()))
+			}
+		})
+	}
+}
+
+func TestTableServer_GetTable_Fail(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenRequest    *pb.GetTableRequest
+		givenController TableController
+		expectedCode    codes.Code
+	}{
+		{
+			name:            "given an invalid id, expect an invalid argument",
+			givenRequest:    &pb.GetTableRequest{Id: "1"},
+			givenController: mockTableController{},
+			expectedCode:    codes.InvalidArgument,
+		},
+		{
+			name:         "given the table does not exist, expect not found",
+			givenRequest: &pb.GetTableRequest{Id: "160998da-2d89-4f06-a690-fd189213958d"},
+			givenController: mockTableController{
+				GivenError: memory.ErrNoTables,
+			},
+			expectedCode: codes.NotFound,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := NewTableServer(test.givenController, broadcaster.New())
+
+			_, err := s.GetTable(context.Background(), test.givenRequest)
+			if err == nil {
+				t.Fatalf("expected %v, got nil", test.expectedCode)
+			}
+
+			if status.Code(err) != test.expectedCode {
+				t.Fatalf("expected %v, got %v", test.expectedCode, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestTableServer_ListTables_Success(t *testing.T) {
+	tests := []struct {
+		name             string
+		givenRequest     *pb.ListTablesRequest
+		givenController  TableController
+		expectedResponse *pb.ListTablesResponse
+	}{
+		{
+			name:         "given more tables follow, expect the next cursor to be returned",
+			givenRequest: &pb.ListTablesRequest{Limit: 1, OmitBets: true},
+			givenController: mockTableController{
+				GivenPage: domain.TablePage{
+					Tables: []domain.Table{
+						{
+							ID:        uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+							CreatedAt: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC),
+						},
+					},
+					Next: &domain.TableCursor{
+						CreatedAt: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC),
+						ID:        uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+					},
+				},
+			},
+			expectedResponse: &pb.ListTablesResponse{
+				Tables: []*pb.Table{
+					{
+						Id:        "160998da-2d89-4f06-a690-fd189213958d",
+						Bets:      []*pb.Bet{},
+						CreatedAt: timestamp(time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)),
+						Rules:     &pb.Rules{},
+					},
+				},
+				NextCursor: "MjAyMS0wNi0wMVQwMDowMDowMFp8MTYwOTk4ZGEtMmQ4OS00ZjA2LWE2OTAtZmQxODkyMTM5NThk",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := NewTableServer(test.givenController, broadcaster.New())
+
+			actual, err := s.ListTables(context.Background(), test.givenRequest)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(actual, test.expectedResponse, protocmp.Transform()) {
+				t.Fatal(cmp.Diff(actual, test.expectedResponse, protocmp.Transform()))
+			}
+		})
+	}
+}
+
+func TestTableServer_ListTables_Fail(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenRequest    *pb.ListTablesRequest
+		givenController TableController
+		expectedCode    codes.Code
+	}{
+		{
+			name:            "given an unknown state, expect an invalid argument",
+			givenRequest:    &pb.ListTablesRequest{State: "pending"},
+			givenController: mockTableController{},
+			expectedCode:    codes.InvalidArgument,
+		},
+		{
+			name:            "given a negative limit, expect an invalid argument",
+			givenRequest:    &pb.ListTablesRequest{Limit: -1},
+			givenController: mockTableController{},
+			expectedCode:    codes.InvalidArgument,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := NewTableServer(test.givenController, broadcaster.New())
+
+			_, err := s.ListTables(context.Background(), test.givenRequest)
+			if err == nil {
+				t.Fatalf("expected %v, got nil", test.expectedCode)
+			}
+
+			if status.Code(err) != test.expectedCode {
+				t.Fatalf("expected %v, got %v", test.expectedCode, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestTableServer_SpinTable_Success(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenRequest    *pb.SpinTableRequest
+		givenController TableController
+		expectedTable   *pb.Table
+	}{
+		{
+			name:         "given no expected version, expect the table to be spun",
+			givenRequest: &pb.SpinTableRequest{Id: "160998da-2d89-4f06-a690-fd189213958d"},
+			givenController: mockTableController{
+				GivenTable: domain.Table{ID: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"), Version: 2},
+			},
+			expectedTable: &pb.Table{
+				Id:      "160998da-2d89-4f06-a690-fd189213958d",
+				Bets:    []*pb.Bet{},
+				Rules:   &pb.Rules{},
+				Version: 2,
+			},
+		},
+		{
+			name:         "given the current version, expect the table to be spun",
+			givenRequest: &pb.SpinTableRequest{Id: "160998da-2d89-4f06-a690-fd189213958d", ExpectedVersion: 2},
+			givenController: mockTableController{
+				GivenTable: domain.Table{ID: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"), Version: 2},
+			},
+			expectedTable: &pb.Table{
+				Id:      "160998da-2d89-4f06-a690-fd189213958d",
+				Bets:    []*pb.Bet{},
+				Rules:   &pb.Rules{},
+				Version: 2,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := NewTableServer(test.givenController, broadcaster.New())
+
+			actual, err := s.SpinTable(context.Background(), test.givenRequest)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(actual, test.expectedTable, protocmp.Transform()) {
+				t.Fatal(cmp.Diff(actual, test.expectedTable, protocmp.Transform()))
+			}
+		})
+	}
+}
+
+func TestTableServer_SpinTable_Fail(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenRequest    *pb.SpinTableRequest
+		givenController TableController
+		expectedCode    codes.Code
+	}{
+		{
+			name:         "given a stale expected version, expect a failed precondition",
+			givenRequest: &pb.SpinTableRequest{Id: "160998da-2d89-4f06-a690-fd189213958d", ExpectedVersion: 1},
+			givenController: mockTableController{
+				GivenTable: domain.Table{ID: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"), Version: 2},
+			},
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "given the table is already spun, expect a failed precondition",
+			givenRequest: &pb.SpinTableRequest{Id: "160998da-2d89-4f06-a690-fd189213958d"},
+			givenController: mockTableController{
+				GivenError: table.ErrTableSpun,
+			},
+			expectedCode: codes.FailedPrecondition,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := NewTableServer(test.givenController, broadcaster.New())
+
+			_, err := s.SpinTable(context.Background(), test.givenRequest)
+			if err == nil {
+				t.Fatalf("expected %v, got nil", test.expectedCode)
+			}
+
+			if status.Code(err) != test.expectedCode {
+				t.Fatalf("expected %v, got %v", test.expectedCode, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestTableServer_SettleTable_Fail(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenRequest    *pb.SettleTableRequest
+		givenController TableController
+		expectedCode    codes.Code
+	}{
+		{
+			name:         "given a stale expected version, expect a failed precondition",
+			givenRequest: &pb.SettleTableRequest{Id: "160998da-2d89-4f06-a690-fd189213958d", ExpectedVersion: 2},
+			givenController: mockTableController{
+				GivenTable: domain.Table{ID: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"), Version: 3},
+			},
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "given the table is not spun, expect a failed precondition",
+			givenRequest: &pb.SettleTableRequest{Id: "160998da-2d89-4f06-a690-fd189213958d", ExpectedVersion: 1},
+			givenController: mockTableController{
+				GivenTable: domain.Table{ID: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"), Version: 1},
+				GivenError: table.ErrTableNotSpun,
+			},
+			expectedCode: codes.FailedPrecondition,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := NewTableServer(test.givenController, broadcaster.New())
+
+			_, err := s.SettleTable(context.Background(), test.givenRequest)
+			if err == nil {
+				t.Fatalf("expected %v, got nil", test.expectedCode)
+			}
+
+			if status.Code(err) != test.expectedCode {
+				t.Fatalf("expected %v, got %v", test.expectedCode, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestTableServer_WatchTable(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenController TableController
+		givenUpdates    []domain.Table
+		expectedTables  []*pb.Table
+	}{
+		{
+			name: "given the table is spun, expect the current table followed by the update",
+			givenController: mockTableController{
+				GivenTable: domain.Table{
+					ID: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				},
+			},
+			givenUpdates: []domain.Table{
+				{
+					ID:       uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+					IsClosed: true,
+					Outcomes: []domain.Outcome{{
+						Value:  0,
+						Colour: domain.Green,
+					}},
+				},
+			},
+			expectedTables: []*pb.Table{
+				{
+					Id:    "160998da-2d89-4f06-a690-fd189213958d",
+					Bets:  []*pb.Bet{},
+					Rules: &pb.Rules{},
+				},
+				{
+					Id:       "160998da-2d89-4f06-a690-fd189213958d",
+					Bets:     []*pb.Bet{},
+					IsClosed: true,
+					Outcome: &pb.Outcome{
+						Colour: "green",
+					},
+					Outcomes: []*pb.Outcome{{
+						Colour: "green",
+					}},
+					Rules: &pb.Rules{},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b := broadcaster.New()
+
+			client := newTableClient(t, NewTableServer(test.givenController, b))
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			stream, err := client.WatchTable(ctx, &pb.WatchTableRequest{Id: "160998da-2d89-4f06-a690-fd189213958d"})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var actual []*pb.Table
+
+			table, err := stream.Recv()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			actual = append(actual, table)
+
+			for i := range test.givenUpdates {
+				b.Publish(ctx, test.givenUpdates[i])
+
+				table, err = stream.Recv()
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				actual = append(actual, table)
+			}
+
+			if !cmp.Equal(actual, test.expectedTables, protocmp.Transform()) {
+				t.Fatal(cmp.Diff(actual, test.expectedTables, protocmp.Transform()))
+			}
+		})
+	}
+}
+
+func TestTableServer_WatchTable_Fail(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenID         string
+		givenController TableController
+		expectedCode    codes.Code
+	}{
+		{
+			name:            "given an invalid id, expect an invalid argument",
+			givenID:         "1",
+			givenController: mockTableController{},
+			expectedCode:    codes.InvalidArgument,
+		},
+		{
+			name:    "given the table does not exist, expect not found",
+			givenID: "160998da-2d89-4f06-a690-fd189213958d",
+			givenController: mockTableController{
+				GivenError: memory.ErrNoTables,
+			},
+			expectedCode: codes.NotFound,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := newTableClient(t, NewTableServer(test.givenController, broadcaster.New()))
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			stream, err := client.WatchTable(ctx, &pb.WatchTableRequest{Id: test.givenID})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = stream.Recv()
+			if err == nil {
+				t.Fatalf("expected %v, got nil", test.expectedCode)
+			}
+
+			if status.Code(err) != test.expectedCode {
+				t.Fatalf("expected %v, got %v", test.expectedCode, status.Code(err))
+			}
+		})
+	}
+}
+
+// newTableClient serves the given TableServer over an in memory connection.
+func newTableClient(t *testing.T, s *TableServer) pb.TableServiceClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+
+	server := grpc.NewServer()
+	pb.RegisterTableServiceServer(server, s)
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	conn, err := grpc.DialContext(
+		context.Background(),
+		"bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return listener.Dial()
+		}),
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_ = conn.Close()
+		server.Stop()
+	})
+
+	return pb.NewTableServiceClient(conn)
+}
+
+type mockTableController struct {
+	GivenTable   domain.Table
+	GivenPage    domain.TablePage
+	GivenSummary domain.TableSummary
+	GivenError   error
+}
+
+func (m mockTableController) Create(_ context.Context, rules domain.Rules, previous uuid.UUID) (domain.Table, error) {
+	table := m.GivenTable
+	table.Rules = rules
+	table.Previous = previous
+
+	return table, m.GivenError
+}
+
+func (m mockTableController) Spin(_ context.Context, _ uuid.UUID, version int64) (domain.Table, error) {
+	if version != domain.AnyVersion && version != m.GivenTable.Version {
+		return domain.Table{}, table.ErrVersionConflict
+	}
+
+	return m.GivenTable, m.GivenError
+}
+
+func (m mockTableController) Settle(_ context.Context, _ uuid.UUID, version int64) (domain.Table, error) {
+	if version != domain.AnyVersion && version != m.GivenTable.Version {
+		return domain.Table{}, table.ErrVersionConflict
+	}
+
+	return m.GivenTable, m.GivenError
+}
+
+func (m mockTableController) Get(_ context.Context, _ uuid.UUID) (domain.Table, error) {
+	return m.GivenTable, m.GivenError
+}
+
+func (m mockTableController) List(_ context.Context, _ domain.TableQuery) (domain.TablePage, error) {
+	return m.GivenPage, m.GivenError
+}
+
+func (m mockTableController) Summary(_ context.Context, _ uuid.UUID) (domain.TableSummary, error) {
+	return m.GivenSummary, m.GivenError
+}
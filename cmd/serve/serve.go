@@ -1,15 +1,40 @@
 package serve
 
 import (
+	"betting/api"
+	"betting/cmd/serve/audit"
 	"betting/cmd/serve/bet"
+	"betting/cmd/serve/event"
+	"betting/cmd/serve/export"
+	"betting/cmd/serve/jackpot"
+	"betting/cmd/serve/openapi"
+	"betting/cmd/serve/rpc"
+	"betting/cmd/serve/statistics"
 	"betting/cmd/serve/table"
+	betting "betting/internal/bet"
+	"betting/internal/domain"
+	"betting/internal/pkg/actor"
+	"betting/internal/pkg/ballplacer"
+	"betting/internal/pkg/broadcaster"
+	"betting/internal/pkg/clock"
+	"betting/internal/pkg/correlation"
+	"betting/internal/pkg/exposure"
+	"betting/internal/pkg/logging"
+	"betting/internal/pkg/origin"
+	"betting/internal/pkg/tracing"
 	"betting/storage/memory"
+	"context"
+	"fmt"
+	"net"
 	"net/http"
+	"strings"
 
 	"github.com/gorilla/mux"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gorilla/mux/otelmux"
+	"google.golang.org/grpc"
 )
 
 // NewCmd associates the serve command with the instantiation of a Server.
@@ -22,17 +47,185 @@ func NewCmd() *cobra.Command {
 }
 
 func StartServer(_ *cobra.Command, _ []string) {
+	err := logging.Configure(viper.GetString("log.level"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	shutdown, err := tracing.Configure(context.Background(), tracing.Config{
+		ServiceName: tracing.InstrumentationName,
+		Exporter:    viper.GetString("tracing.exporter"),
+		Endpoint:    viper.GetString("tracing.endpoint"),
+		Insecure:    viper.GetBool("tracing.insecure"),
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	router := mux.NewRouter()
 
-	tableStorage := memory.NewTableStorage()
-	betStorage := memory.NewBetStorage()
+	systemClock := clock.New()
+	eventStore := memory.NewEventStore(systemClock)
+	tableStorage := memory.NewTableStorage(eventStore)
+	betStorage := memory.NewBetStorage(tableStorage, eventStore, systemClock)
+	jackpotStorage := memory.NewJackpotStorage()
+	statisticsStorage := memory.NewStatisticsStorage()
+	auditStorage := memory.NewAuditStorage()
 
-	t := table.Load(router, tableStorage, betStorage)
-	b := bet.Load(t, tableStorage, betStorage)
+	err = rebuild(context.Background(), tableStorage, betStorage)
+	if err != nil {
+		log.Fatal(err)
+	}
 
-	log.Info("started server")
+	placer, err := ballplacer.NewFromConfig(ballplacer.Config{
+		Environment: viper.GetString("environment"),
+		Mode:        viper.GetString("ballPlacer.mode"),
+		Seed:        viper.GetInt64("ballPlacer.seed"),
+		Sequence:    viper.GetIntSlice("ballPlacer.sequence"),
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	limiter, err := newExposureLimiter()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	jackpotRules, err := newJackpotRules()
+	if err != nil {
+		log.Fatal(err)
+	}
 
-	if err := http.ListenAndServe(viper.GetString("port"), b); err != nil {
+	wallet, err := newWallet()
+	if err != nil {
 		log.Fatal(err)
 	}
+
+	jackpotController := jackpot.NewController(jackpotStorage, tableStorage, jackpotRules)
+	statisticsController := statistics.NewController(statisticsStorage, systemClock)
+	auditController := audit.NewController(auditStorage, systemClock)
+
+	router.Use(otelmux.Middleware(tracing.InstrumentationName), correlation.Middleware, actor.Middleware, origin.Middleware,
+		logging.Middleware)
+
+	o, err := openapi.Load(router, api.Specification, viper.GetBool("openapi.strict"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	updates := broadcaster.New()
+	unitOfWork := memory.NewUnitOfWork()
+
+	t := table.Load(o, tableStorage, betStorage, placer, updates, unitOfWork, jackpotController, statisticsController, systemClock,
+		auditController)
+	b := bet.Load(t, tableStorage, betStorage, limiter, jackpotController, wallet, unitOfWork, systemClock, auditController)
+	j := jackpot.Load(b, jackpotController)
+	s := statistics.Load(j, statisticsController)
+	a := audit.Load(s, auditController)
+	e := event.Load(a, event.NewController(eventStore))
+	x := export.Load(e, export.NewController(tableStorage, betStorage))
+
+	listener, err := net.Listen("tcp", viper.GetString("grpcPort"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	server := grpc.NewServer(grpc.UnaryInterceptor(rpc.UnaryInterceptor), grpc.StreamInterceptor(rpc.StreamInterceptor))
+
+	g := rpc.Load(server, tableStorage, betStorage, placer, limiter, updates, unitOfWork, jackpotController, wallet,
+		statisticsController, systemClock, auditController)
+
+	go func() {
+		if serveErr := g.Serve(listener); serveErr != nil {
+			log.Fatal(serveErr)
+		}
+	}()
+
+	log.Info("started server")
+
+	err = http.ListenAndServe(viper.GetString("port"), x)
+
+	if shutdownErr := shutdown(context.Background()); shutdownErr != nil {
+		log.Error(shutdownErr)
+	}
+
+	log.Fatal(err)
+}
+
+// rebuild projects the tables and bets held in storage from the events recorded before the server started.
+func rebuild(ctx context.Context, tableStorage *memory.TableStorage, betStorage *memory.BetStorage) error {
+	err := tableStorage.Rebuild(ctx)
+	if err != nil {
+		return err
+	}
+
+	return betStorage.Rebuild(ctx)
+}
+
+// exposureConfig holds the liability limits in minor units keyed by currency code, the global limits apply to every
+// table playing a variant without limits of its own.
+type exposureConfig struct {
+	Global   map[string]int64
+	Variants map[string]map[string]int64
+}
+
+// newExposureLimiter builds an exposure.Limiter from the exposure settings, refusing limits of a variant not played.
+func newExposureLimiter() (exposure.Limiter, error) {
+	var cfg exposureConfig
+
+	err := viper.UnmarshalKey("exposure", &cfg)
+	if err != nil {
+		return exposure.Limiter{}, err
+	}
+
+	variants := make(map[domain.Variant]exposure.Limits, len(cfg.Variants))
+
+	for name, limits := range cfg.Variants {
+		variant := domain.Variant(name)
+
+		switch variant {
+		case domain.SingleBall, domain.DoubleBall, domain.MultiWheel, domain.Lightning:
+		default:
+			return exposure.Limiter{}, fmt.Errorf("exposure limits of variant %v: %w", name, domain.ErrInvalidRules)
+		}
+
+		variants[variant] = adaptLimits(limits)
+	}
+
+	return exposure.New(adaptLimits(cfg.Global), variants), nil
+}
+
+// adaptLimits restores the case of currency codes which are lower cased when read from settings.
+func adaptLimits(limits map[string]int64) exposure.Limits {
+	l := make(exposure.Limits, len(limits))
+
+	for currency, limit := range limits {
+		l[strings.ToUpper(currency)] = limit
+	}
+
+	return l
+}
+
+// newWallet builds the wallets of players from the opening balances in minor units keyed by currency code in the wallet
+// settings, without any opening balances stakes are not taken from a wallet.
+func newWallet() (betting.Wallet, error) {
+	var opening map[string]int64
+
+	err := viper.UnmarshalKey("wallet.opening", &opening)
+	if err != nil || len(opening) == 0 {
+		return nil, err
+	}
+
+	return memory.NewWalletStorage(adaptLimits(opening)), nil
+}
+
+// newJackpotRules reads the rules of the progressive jackpot from the jackpot settings.
+func newJackpotRules() (domain.JackpotRules, error) {
+	rules := domain.JackpotRules{
+		Percent: viper.GetInt64("jackpot.percent"),
+		Repeats: viper.GetInt("jackpot.repeats"),
+	}
+
+	return rules, rules.Validate()
 }
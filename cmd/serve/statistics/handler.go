@@ -0,0 +1,53 @@
+package statistics
+
+import (
+	"betting/api"
+	"betting/cmd/serve/problem"
+	"betting/internal/domain"
+	"betting/internal/pkg/logging"
+	"betting/internal/pkg/responses"
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// Controller provides business logic capable of reading Statistics.
+type Controller interface {
+	Get(ctx context.Context, series uuid.UUID) (domain.Statistics, error)
+}
+
+// Handler handles requests relating to statistics.
+type Handler struct {
+	Controller Controller
+}
+
+// New instantiates a Handler.
+func New(controller Controller) Handler {
+	return Handler{
+		Controller: controller,
+	}
+}
+
+// Get shows the latest results along with the hits, gaps and streaks of every table, or of a single series of tables.
+func (h Handler) Get(w http.ResponseWriter, r *http.Request) {
+	series, err := api.ParseStatisticsQuery(r.URL.Query())
+	if err != nil {
+		logging.FromContext(r.Context()).WithError(err).Error("invalid statistics query")
+
+		problem.WriteInvalid(w, r, err)
+		return
+	}
+
+	statistics, err := h.Controller.Get(r.Context(), series)
+	if err != nil {
+		logging.FromContext(r.Context()).WithError(err).Error("failed to get statistics")
+
+		problem.Write(w, r, err)
+		return
+	}
+
+	resBody := api.AdaptStatisticsFromDomain(statistics)
+
+	responses.NewJSON(w).Success(http.StatusOK, resBody)
+}
@@ -0,0 +1,195 @@
+package statistics
+
+import (
+	"betting/api"
+	"betting/cmd/serve/problem"
+	"betting/internal/domain"
+	"betting/internal/pkg/responses"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+func TestHandler_Get_Success(t *testing.T) {
+	spunAt := time.Date(2021, 10, 11, 12, 0, 0, 0, time.UTC)
+	series := uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d")
+
+	tests := []struct {
+		name            string
+		givenController *mockController
+		givenURL        string
+		expectedSeries  uuid.UUID
+		expectedBody    api.StatisticsResponse
+		expectedNumber  api.NumberStatistics
+	}{
+		{
+			name:            "given nothing spun, expect 200 with every number cold",
+			givenController: &mockController{},
+			givenURL:        "/v1/statistics",
+			expectedBody: api.StatisticsResponse{
+				Recent:  []api.Result{},
+				Hot:     []int{},
+				Cold:    []int{0, 1, 2, 3, 4},
+				Streaks: []api.Streak{},
+			},
+			expectedNumber: api.NumberStatistics{Position: 0, Colour: domain.Green},
+		},
+		{
+			name: "given a series, expect 200 with the statistics of the series",
+			givenController: &mockController{
+				GivenStatistics: domain.Statistics{}.
+					Record(domain.Result{Table: series, Value: 14, Colour: domain.Red, SpunAt: spunAt}).
+					Record(domain.Result{Table: series, Value: 32, Colour: domain.Red, SpunAt: spunAt}),
+			},
+			givenURL:       "/v1/statistics?series=160998da-2d89-4f06-a690-fd189213958d",
+			expectedSeries: series,
+			expectedBody: api.StatisticsResponse{
+				Spins: 2,
+				Recent: []api.Result{
+					{Table: series, Position: 32, Colour: domain.Red, SpunAt: spunAt},
+					{Table: series, Position: 14, Colour: domain.Red, SpunAt: spunAt},
+				},
+				Hot:  []int{14, 32},
+				Cold: []int{0, 1, 2, 3, 4},
+				Streaks: []api.Streak{
+					{Kind: domain.ColourStreak, Value: "red", Length: 2},
+					{Kind: domain.DozenStreak, Value: "third", Length: 1},
+					{Kind: domain.ColumnStreak, Value: "second", Length: 2},
+				},
+			},
+			expectedNumber: api.NumberStatistics{Position: 14, Colour: domain.Red, Hits: 1, Gap: 1},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler := New(test.givenController)
+
+			rr := httptest.NewRecorder()
+
+			req := httptest.NewRequest(http.MethodGet, test.givenURL, nil)
+
+			router := new(mux.Router)
+			router.HandleFunc("/v1/statistics", handler.Get)
+			router.ServeHTTP(rr, req)
+
+			resp := rr.Result()
+
+			if !cmp.Equal(resp.StatusCode, http.StatusOK) {
+				t.Fatal(cmp.Diff(resp.StatusCode, http.StatusOK))
+			}
+
+			if !cmp.Equal(test.givenController.series, test.expectedSeries) {
+				t.Fatal(cmp.Diff(test.givenController.series, test.expectedSeries))
+			}
+
+			var res api.StatisticsResponse
+			err := json.NewDecoder(resp.Body).Decode(&res)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ignore := cmpopts.IgnoreFields(api.StatisticsResponse{}, "Numbers")
+
+			if !cmp.Equal(res, test.expectedBody, ignore) {
+				t.Fatal(cmp.Diff(res, test.expectedBody, ignore))
+			}
+
+			if !cmp.Equal(len(res.Numbers), domain.Pockets) {
+				t.Fatal(cmp.Diff(len(res.Numbers), domain.Pockets))
+			}
+
+			if !cmp.Equal(res.Numbers[test.expectedNumber.Position], test.expectedNumber) {
+				t.Fatal(cmp.Diff(res.Numbers[test.expectedNumber.Position], test.expectedNumber))
+			}
+		})
+	}
+}
+
+func TestHandler_Get_Fail(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenController Controller
+		givenURL        string
+		expectedStatus  int
+		expectedBody    responses.Error
+	}{
+		{
+			name:            "given a series which is not a uuid, expect 400",
+			givenController: &mockController{},
+			givenURL:        "/v1/statistics?series=abc",
+			expectedStatus:  http.StatusBadRequest,
+			expectedBody: responses.Error{
+				Type:     problem.TypeInvalid,
+				Title:    http.StatusText(http.StatusBadRequest),
+				Status:   http.StatusBadRequest,
+				Detail:   "abc: series must be a uuid",
+				Instance: "/v1/statistics",
+			},
+		},
+		{
+			name: "given controller error, expect 500 without its detail",
+			givenController: &mockController{
+				GivenError: errors.New("storage unavailable"),
+			},
+			givenURL:       "/v1/statistics",
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: responses.Error{
+				Type:     responses.BlankType,
+				Title:    http.StatusText(http.StatusInternalServerError),
+				Status:   http.StatusInternalServerError,
+				Detail:   http.StatusText(http.StatusInternalServerError),
+				Instance: "/v1/statistics",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler := New(test.givenController)
+
+			rr := httptest.NewRecorder()
+
+			req := httptest.NewRequest(http.MethodGet, test.givenURL, nil)
+
+			router := new(mux.Router)
+			router.HandleFunc("/v1/statistics", handler.Get)
+			router.ServeHTTP(rr, req)
+
+			resp := rr.Result()
+
+			if !cmp.Equal(resp.StatusCode, test.expectedStatus) {
+				t.Fatal(cmp.Diff(resp.StatusCode, test.expectedStatus))
+			}
+
+			var res responses.Error
+			err := json.NewDecoder(resp.Body).Decode(&res)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(res, test.expectedBody) {
+				t.Fatal(cmp.Diff(res, test.expectedBody))
+			}
+		})
+	}
+}
+
+type mockController struct {
+	GivenStatistics domain.Statistics
+	GivenError      error
+	series          uuid.UUID
+}
+
+func (m *mockController) Get(_ context.Context, series uuid.UUID) (domain.Statistics, error) {
+	m.series = series
+
+	return m.GivenStatistics, m.GivenError
+}
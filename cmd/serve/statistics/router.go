@@ -0,0 +1,22 @@
+package statistics
+
+import (
+	"betting/internal/statistics"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// NewController builds the statistics of the results spun, held in the given storage.
+func NewController(statisticsStorage statistics.StorageProvider, clock statistics.Clock) statistics.Controller {
+	return statistics.NewController(statistics.NewRepository(statisticsStorage), clock)
+}
+
+// Load registers the statistics routes, served by the given Controller.
+func Load(r *mux.Router, controller Controller) *mux.Router {
+	handler := New(controller)
+
+	r.HandleFunc("/v1/statistics", handler.Get).Methods(http.MethodGet)
+
+	return r
+}
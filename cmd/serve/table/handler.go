@@ -2,14 +2,17 @@ package table
 
 import (
 	"betting/api"
+	"betting/cmd/serve/problem"
 	"betting/internal/domain"
+	"betting/internal/pkg/logging"
 	"betting/internal/pkg/responses"
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"net/http"
 
-	log "github.com/sirupsen/logrus"
-
 	"github.com/gorilla/mux"
 
 	"github.com/google/uuid"
@@ -17,9 +20,8 @@ import (
 
 // Errors returned by the Handler.
 var (
-	ErrFailedToCreateTable = errors.New("failed to create table")
-	ErrNoIDPresent         = errors.New("failed locate table")
-	ErrInvalidID           = errors.New("id given is not a uuid")
+	ErrNoIDPresent = errors.New("failed locate table")
+	ErrInvalidID   = errors.New("id given is not a uuid")
 )
 
 // Controller provides business logic capable of both read and writes.
@@ -30,15 +32,16 @@ type Controller interface {
 
 // ControllerWriter provides business logic capable of writes.
 type ControllerWriter interface {
-	Create(ctx context.Context) (domain.Table, error)
-	Spin(ctx context.Context, id uuid.UUID) (domain.Table, error)
-	Settle(ctx context.Context, id uuid.UUID) (domain.Table, error)
+	Create(ctx context.Context, rules domain.Rules, previous uuid.UUID) (domain.Table, error)
+	Spin(ctx context.Context, id uuid.UUID, version int64) (domain.Table, error)
+	Settle(ctx context.Context, id uuid.UUID, version int64) (domain.Table, error)
 }
 
 // ControllerReader provides business logic capable of reads.
 type ControllerReader interface {
 	Get(ctx context.Context, id uuid.UUID) (domain.Table, error)
-	List(ctx context.Context) ([]domain.Table, error)
+	List(ctx context.Context, query domain.TableQuery) (domain.TablePage, error)
+	Summary(ctx context.Context, id uuid.UUID) (domain.TableSummary, error)
 }
 
 // Handler handles requests relating to tables.
@@ -53,137 +56,227 @@ func New(controller Controller) Handler {
 	}
 }
 
-// Create creates a table in storage where which bets can be placed on it.
+// Create creates a table in storage where which bets can be placed on it. The body is optional, it may give the rules of
+// the table and the previous table whose imprisoned bets it carries.
 func (h Handler) Create(w http.ResponseWriter, r *http.Request) {
-	table, err := h.Controller.Create(r.Context())
+	var tableRequest api.TableRequest
+
+	err := json.NewDecoder(r.Body).Decode(&tableRequest)
+	if err != nil && !errors.Is(err, io.EOF) {
+		logging.FromContext(r.Context()).WithError(err).Error("could not decode request body")
+
+		problem.WriteInvalid(w, r, err)
+		return
+	}
+
+	rules, previous := api.AdaptTableRequestToDomain(tableRequest)
+
+	table, err := h.Controller.Create(r.Context(), rules, previous)
 	if err != nil {
-		log.Errorf("failed to create table: %v", err)
+		logging.FromContext(r.Context()).WithError(err).Error("failed to create table")
 
-		responses.NewJSON(w).Fail(http.StatusBadRequest, ErrFailedToCreateTable)
+		problem.Write(w, r, err)
 		return
 	}
 
-	log.Infof("created table: %v", table.ID)
+	logging.FromContext(r.Context()).WithField(logging.FieldTableID, table.ID).Info("created table")
+
+	w.Header().Set("ETag", api.ETag(table.Version))
 
 	responses.NewJSON(w).Success(http.StatusCreated, api.AdaptTableFromDomain(table))
 }
 
-// Get retrieves the table for the given ID.
+// Get retrieves the table for the given ID, its ETag identifies the version of the table.
 func (h Handler) Get(w http.ResponseWriter, r *http.Request) {
 	path := mux.Vars(r)
 
 	id, ok := path["id"]
 	if !ok {
-		log.Errorf("invalid id: %v", ErrNoIDPresent)
+		logging.FromContext(r.Context()).WithError(ErrNoIDPresent).Error("invalid id")
 
-		responses.NewJSON(w).Fail(http.StatusBadRequest, ErrNoIDPresent)
+		problem.WriteInvalid(w, r, ErrNoIDPresent)
 		return
 	}
 
 	tableID, err := uuid.Parse(id)
 	if err != nil {
-		log.Errorf("invalid id: %v, %v", id, ErrInvalidID)
+		logging.FromContext(r.Context()).WithField(logging.FieldID, id).WithError(ErrInvalidID).Error("invalid id")
 
-		responses.NewJSON(w).Fail(http.StatusBadRequest, ErrInvalidID)
+		problem.WriteInvalid(w, r, ErrInvalidID)
 		return
 	}
 
 	t, err := h.Controller.Get(r.Context(), tableID)
 	if err != nil {
-		log.Errorf("failed to locate table: %v, %v", tableID, err)
+		logging.FromContext(r.Context()).WithField(logging.FieldTableID, tableID).WithError(err).Error("failed to locate table")
 
-		responses.NewJSON(w).Fail(http.StatusBadRequest, err)
+		problem.Write(w, r, err)
 		return
 	}
 
 	resBody := api.AdaptTableFromDomain(t)
 
+	w.Header().Set("ETag", api.ETag(t.Version))
+
+	responses.NewJSON(w).Success(http.StatusOK, resBody)
+}
+
+// Summary returns the stakes, exposure per pocket and house result for the table with the given ID.
+func (h Handler) Summary(w http.ResponseWriter, r *http.Request) {
+	path := mux.Vars(r)
+
+	id, ok := path["id"]
+	if !ok {
+		logging.FromContext(r.Context()).WithError(ErrNoIDPresent).Error("invalid id")
+
+		problem.WriteInvalid(w, r, ErrNoIDPresent)
+		return
+	}
+
+	tableID, err := uuid.Parse(id)
+	if err != nil {
+		logging.FromContext(r.Context()).WithField(logging.FieldID, id).WithError(ErrInvalidID).Error("invalid id")
+
+		problem.WriteInvalid(w, r, ErrInvalidID)
+		return
+	}
+
+	summary, err := h.Controller.Summary(r.Context(), tableID)
+	if err != nil {
+		logging.FromContext(r.Context()).WithField(logging.FieldTableID, tableID).WithError(err).Error("failed to summarise table")
+
+		problem.Write(w, r, err)
+		return
+	}
+
+	resBody := api.AdaptTableSummaryFromDomain(summary)
+
 	responses.NewJSON(w).Success(http.StatusOK, resBody)
 }
 
-// Spin locks the table and returns the outcome.
+// Spin locks the table and returns the outcome. When If-Match is given the table must be at the version it names,
+// otherwise nothing is changed and a http.StatusPreconditionFailed is returned.
 func (h Handler) Spin(w http.ResponseWriter, r *http.Request) {
 	path := mux.Vars(r)
 
 	id, ok := path["id"]
 	if !ok {
-		log.Errorf("invalid id: %v", ErrNoIDPresent)
+		logging.FromContext(r.Context()).WithError(ErrNoIDPresent).Error("invalid id")
 
-		responses.NewJSON(w).Fail(http.StatusBadRequest, ErrNoIDPresent)
+		problem.WriteInvalid(w, r, ErrNoIDPresent)
 		return
 	}
 
 	tableID, err := uuid.Parse(id)
 	if err != nil {
-		log.Errorf("invalid id: %v, %v", id, ErrInvalidID)
+		logging.FromContext(r.Context()).WithField(logging.FieldID, id).WithError(ErrInvalidID).Error("invalid id")
 
-		responses.NewJSON(w).Fail(http.StatusBadRequest, ErrInvalidID)
+		problem.WriteInvalid(w, r, ErrInvalidID)
 		return
 	}
 
-	t, err := h.Controller.Spin(r.Context(), tableID)
+	version, err := api.ParseIfMatch(r.Header.Get("If-Match"))
 	if err != nil {
-		log.Errorf("failed to spin table: %v, %v", tableID, err)
+		logging.FromContext(r.Context()).WithField(logging.FieldTableID, tableID).WithError(err).Error("failed to spin table")
 
-		responses.NewJSON(w).Fail(http.StatusBadRequest, err)
+		problem.Write(w, r, err)
+		return
+	}
+
+	t, err := h.Controller.Spin(r.Context(), tableID, version)
+	if err != nil {
+		logging.FromContext(r.Context()).WithField(logging.FieldTableID, tableID).WithError(err).Error("failed to spin table")
+
+		problem.Write(w, r, err)
 		return
 	}
 
 	resBody := api.AdaptTableFromDomain(t)
 
-	log.Infof("table has been spun: %v", t.ID)
+	logging.FromContext(r.Context()).WithField(logging.FieldTableID, t.ID).Info("table has been spun")
+
+	w.Header().Set("ETag", api.ETag(t.Version))
 
 	responses.NewJSON(w).Success(http.StatusOK, resBody)
 }
 
-// Settle moves all bets to settled and finds any winners.
+// Settle moves all bets to settled and finds any winners. When If-Match is given the table must be at the version it
+// names, otherwise nothing is changed and a http.StatusPreconditionFailed is returned.
 func (h Handler) Settle(w http.ResponseWriter, r *http.Request) {
 	path := mux.Vars(r)
 
 	id, ok := path["id"]
 	if !ok {
-		log.Errorf("invalid id: %v", ErrNoIDPresent)
+		logging.FromContext(r.Context()).WithError(ErrNoIDPresent).Error("invalid id")
 
-		responses.NewJSON(w).Fail(http.StatusBadRequest, ErrNoIDPresent)
+		problem.WriteInvalid(w, r, ErrNoIDPresent)
 		return
 	}
 
 	tableID, err := uuid.Parse(id)
 	if err != nil {
-		log.Errorf("invalid id: %v, %v", id, ErrInvalidID)
+		logging.FromContext(r.Context()).WithField(logging.FieldID, id).WithError(ErrInvalidID).Error("invalid id")
+
+		problem.WriteInvalid(w, r, ErrInvalidID)
+		return
+	}
+
+	version, err := api.ParseIfMatch(r.Header.Get("If-Match"))
+	if err != nil {
+		logging.FromContext(r.Context()).WithField(logging.FieldTableID, tableID).WithError(err).Error("failed to settle table")
 
-		responses.NewJSON(w).Fail(http.StatusBadRequest, ErrInvalidID)
+		problem.Write(w, r, err)
 		return
 	}
 
-	t, err := h.Controller.Settle(r.Context(), tableID)
+	t, err := h.Controller.Settle(r.Context(), tableID, version)
 	if err != nil {
-		log.Errorf("failed to settle table: %v, %v", tableID, err)
+		logging.FromContext(r.Context()).WithField(logging.FieldTableID, tableID).WithError(err).Error("failed to settle table")
 
-		responses.NewJSON(w).Fail(http.StatusBadRequest, err)
+		problem.Write(w, r, err)
 		return
 	}
 
 	resBody := api.AdaptTableFromDomain(t)
 
-	log.Infof("settled table: %v", t.ID)
+	logging.FromContext(r.Context()).WithField(logging.FieldTableID, t.ID).Info("settled table")
+
+	w.Header().Set("ETag", api.ETag(t.Version))
 
 	responses.NewJSON(w).Success(http.StatusOK, resBody)
 }
 
-// List returns all tables from storage.
+// List returns a page of tables from storage matching the query parameters. When further tables follow, a Link
+// header with rel="next" holds the URL of the next page.
 func (h Handler) List(w http.ResponseWriter, r *http.Request) {
-	t, err := h.Controller.List(r.Context())
+	query, err := api.ParseTableQuery(r.URL.Query())
 	if err != nil {
-		log.Errorf("failed to locate bets: %v", err)
+		logging.FromContext(r.Context()).WithError(err).Error("invalid query")
 
-		responses.NewJSON(w).Fail(http.StatusBadRequest, err)
+		problem.WriteInvalid(w, r, err)
 		return
 	}
 
-	resBody := api.AdaptTablesFromDomain(t)
+	page, err := h.Controller.List(r.Context(), query)
+	if err != nil {
+		logging.FromContext(r.Context()).WithError(err).Error("failed to locate bets")
+
+		problem.Write(w, r, err)
+		return
+	}
+
+	if page.Next != nil {
+		next := *r.URL
+		values := next.Query()
+		values.Set(api.QueryCursor, api.EncodeTableCursor(*page.Next))
+		next.RawQuery = values.Encode()
+
+		w.Header().Set("Link", fmt.Sprintf("<%v>; rel=\"next\"", next.RequestURI()))
+	}
+
+	resBody := api.AdaptTablesFromDomain(page.Tables)
 
-	log.Infof("located tables")
+	logging.FromContext(r.Context()).Info("located tables")
 
 	responses.NewJSON(w).Success(http.StatusOK, resBody)
 }
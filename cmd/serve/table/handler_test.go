@@ -2,25 +2,36 @@ package table
 
 import (
 	"betting/api"
+	"betting/cmd/serve/problem"
 	"betting/internal/domain"
 	"betting/internal/pkg/responses"
+	"betting/internal/table"
 	"betting/storage/memory"
+	"betting/testing/opts"
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/Rhymond/go-money"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
 )
 
 func TestHandler_Create_Success(t *testing.T) {
+	previous := uuid.MustParse("70ee9bba-87ac-4155-8ec7-f83c8663315e")
+
 	tests := []struct {
 		name            string
 		givenController Controller
 		givenURL        string
+		givenBody       string
 		expectedStatus  int
 		expectedBody    api.TableResponse
 	}{
@@ -31,7 +42,6 @@ func TestHandler_Create_Success(t *testing.T) {
 					ID:       uuid.MustParse("00812e8f-7fca-49a9-b141-9a52a0d0a82e"),
 					Bets:     nil,
 					IsClosed: false,
-					Outcome:  nil,
 				},
 			},
 			givenURL:       "/v1/tables",
@@ -40,7 +50,25 @@ func TestHandler_Create_Success(t *testing.T) {
 				ID:       uuid.MustParse("00812e8f-7fca-49a9-b141-9a52a0d0a82e"),
 				Bets:     []api.BetResponse{},
 				IsClosed: false,
-				Outcome:  nil,
+			},
+		},
+		{
+			name: "given rules and a previous table, expect 201 with them",
+			givenController: mockController{
+				GivenCreateTable: domain.Table{
+					ID:       uuid.MustParse("00812e8f-7fca-49a9-b141-9a52a0d0a82e"),
+					Rules:    domain.Rules{Zero: domain.EnPrison},
+					Previous: previous,
+				},
+			},
+			givenURL:       "/v1/tables",
+			givenBody:      `{"rules": {"zero": "en_prison"}, "previous": "70ee9bba-87ac-4155-8ec7-f83c8663315e"}`,
+			expectedStatus: http.StatusCreated,
+			expectedBody: api.TableResponse{
+				ID:       uuid.MustParse("00812e8f-7fca-49a9-b141-9a52a0d0a82e"),
+				Bets:     []api.BetResponse{},
+				Rules:    api.Rules{Zero: domain.EnPrison},
+				Previous: &previous,
 			},
 		},
 	}
@@ -50,7 +78,7 @@ func TestHandler_Create_Success(t *testing.T) {
 
 			rr := httptest.NewRecorder()
 
-			req := httptest.NewRequest(http.MethodPost, test.givenURL, nil)
+			req := httptest.NewRequest(http.MethodPost, test.givenURL, strings.NewReader(test.givenBody))
 
 			router := new(mux.Router)
 			router.HandleFunc(test.givenURL, handler.Create)
@@ -80,19 +108,69 @@ func TestHandler_Create_Fail(t *testing.T) {
 		name            string
 		givenController Controller
 		givenURL        string
+		givenBody       string
 		expectedStatus  int
 		expectedBody    responses.Error
 	}{
 		{
-			name: "given controller error, expect 400",
+			name: "given controller error, expect 500",
+			givenController: mockController{
+				GivenCreateError: errors.New("unexpected"),
+			},
+			givenURL:       "/v1/tables",
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: responses.Error{
+				Type:     responses.BlankType,
+				Title:    http.StatusText(http.StatusInternalServerError),
+				Status:   http.StatusInternalServerError,
+				Detail:   http.StatusText(http.StatusInternalServerError),
+				Instance: "/v1/tables",
+			},
+		},
+		{
+			name:            "given a malformed body, expect 400",
+			givenController: mockController{},
+			givenURL:        "/v1/tables",
+			givenBody:       `{"rules":`,
+			expectedStatus:  http.StatusBadRequest,
+			expectedBody: responses.Error{
+				Type:     problem.TypeInvalid,
+				Title:    http.StatusText(http.StatusBadRequest),
+				Status:   http.StatusBadRequest,
+				Detail:   "unexpected EOF",
+				Instance: "/v1/tables",
+			},
+		},
+		{
+			name: "given invalid rules, expect 400",
 			givenController: mockController{
-				GivenCreateError: memory.ErrDuplicateKey,
+				GivenCreateError: domain.ErrInvalidRules,
 			},
 			givenURL:       "/v1/tables",
+			givenBody:      `{"rules": {"zero": "surrender"}}`,
 			expectedStatus: http.StatusBadRequest,
 			expectedBody: responses.Error{
-				Status: http.StatusBadRequest,
-				Detail: ErrFailedToCreateTable.Error(),
+				Type:     problem.TypeInvalid,
+				Title:    http.StatusText(http.StatusBadRequest),
+				Status:   http.StatusBadRequest,
+				Detail:   domain.ErrInvalidRules.Error(),
+				Instance: "/v1/tables",
+			},
+		},
+		{
+			name: "given a previous table that does not exist, expect 404",
+			givenController: mockController{
+				GivenCreateError: memory.ErrNoTables,
+			},
+			givenURL:       "/v1/tables",
+			givenBody:      `{"previous": "70ee9bba-87ac-4155-8ec7-f83c8663315e"}`,
+			expectedStatus: http.StatusNotFound,
+			expectedBody: responses.Error{
+				Type:     problem.TypeNotFound,
+				Title:    http.StatusText(http.StatusNotFound),
+				Status:   http.StatusNotFound,
+				Detail:   memory.ErrNoTables.Error(),
+				Instance: "/v1/tables",
 			},
 		},
 	}
@@ -102,7 +180,7 @@ func TestHandler_Create_Fail(t *testing.T) {
 
 			rr := httptest.NewRecorder()
 
-			req := httptest.NewRequest(http.MethodPost, test.givenURL, nil)
+			req := httptest.NewRequest(http.MethodPost, test.givenURL, strings.NewReader(test.givenBody))
 
 			router := new(mux.Router)
 			router.HandleFunc(test.givenURL, handler.Create)
@@ -142,7 +220,6 @@ func TestHandler_Get_Success(t *testing.T) {
 					ID:       uuid.MustParse("00812e8f-7fca-49a9-b141-9a52a0d0a82e"),
 					Bets:     nil,
 					IsClosed: false,
-					Outcome:  nil,
 				},
 			},
 			givenURL:       "/v1/tables/00812e8f-7fca-49a9-b141-9a52a0d0a82e",
@@ -151,7 +228,6 @@ func TestHandler_Get_Success(t *testing.T) {
 				ID:       uuid.MustParse("00812e8f-7fca-49a9-b141-9a52a0d0a82e"),
 				Bets:     []api.BetResponse{},
 				IsClosed: false,
-				Outcome:  nil,
 			},
 		},
 	}
@@ -200,20 +276,26 @@ func TestHandler_Get_Fail(t *testing.T) {
 			givenURL:        "/v1/tables/test",
 			expectedStatus:  http.StatusBadRequest,
 			expectedBody: responses.Error{
-				Status: http.StatusBadRequest,
-				Detail: ErrInvalidID.Error(),
+				Type:     problem.TypeInvalid,
+				Title:    http.StatusText(http.StatusBadRequest),
+				Status:   http.StatusBadRequest,
+				Detail:   ErrInvalidID.Error(),
+				Instance: "/v1/tables/test",
 			},
 		},
 		{
-			name: "given controller error, expect 400",
+			name: "given controller error, expect 404",
 			givenController: mockController{
 				GivenGetError: memory.ErrInvalidKey,
 			},
 			givenURL:       "/v1/tables/70ee9bba-87ac-4155-8ec7-f83c8663315e",
-			expectedStatus: http.StatusBadRequest,
+			expectedStatus: http.StatusNotFound,
 			expectedBody: responses.Error{
-				Status: http.StatusBadRequest,
-				Detail: memory.ErrInvalidKey.Error(),
+				Type:     problem.TypeNotFound,
+				Title:    http.StatusText(http.StatusNotFound),
+				Status:   http.StatusNotFound,
+				Detail:   memory.ErrInvalidKey.Error(),
+				Instance: "/v1/tables/70ee9bba-87ac-4155-8ec7-f83c8663315e",
 			},
 		},
 	}
@@ -254,6 +336,7 @@ func TestHandler_Spin_Success(t *testing.T) {
 		givenController Controller
 		givenURL        string
 		expectedStatus  int
+		expectedETag    string
 		expectedBody    api.TableResponse
 	}{
 		{
@@ -263,14 +346,16 @@ func TestHandler_Spin_Success(t *testing.T) {
 					ID:       uuid.MustParse("00812e8f-7fca-49a9-b141-9a52a0d0a82e"),
 					Bets:     nil,
 					IsClosed: true,
-					Outcome: &domain.Outcome{
+					Outcomes: []domain.Outcome{{
 						Value:  16,
 						Colour: domain.Red,
-					},
+					}},
+					Version: 3,
 				},
 			},
 			givenURL:       "/v1/tables/00812e8f-7fca-49a9-b141-9a52a0d0a82e/spin",
 			expectedStatus: http.StatusOK,
+			expectedETag:   `"3"`,
 			expectedBody: api.TableResponse{
 				ID:       uuid.MustParse("00812e8f-7fca-49a9-b141-9a52a0d0a82e"),
 				Bets:     []api.BetResponse{},
@@ -279,6 +364,10 @@ func TestHandler_Spin_Success(t *testing.T) {
 					Position: 16,
 					Colour:   domain.Red,
 				},
+				Outcomes: []api.Outcome{{
+					Position: 16,
+					Colour:   domain.Red,
+				}},
 			},
 		},
 	}
@@ -300,6 +389,10 @@ func TestHandler_Spin_Success(t *testing.T) {
 				t.Fatal(cmp.Diff(resp.StatusCode, test.expectedStatus))
 			}
 
+			if !cmp.Equal(resp.Header.Get("ETag"), test.expectedETag) {
+				t.Fatal(cmp.Diff(resp.Header.Get("ETag"), test.expectedETag))
+			}
+
 			var res api.TableResponse
 			err := json.NewDecoder(resp.Body).Decode(&res)
 			if err != nil {
@@ -318,6 +411,7 @@ func TestHandler_Spin_Fail(t *testing.T) {
 		name            string
 		givenController Controller
 		givenURL        string
+		givenIfMatch    string
 		expectedStatus  int
 		expectedBody    responses.Error
 	}{
@@ -327,20 +421,56 @@ func TestHandler_Spin_Fail(t *testing.T) {
 			givenURL:        "/v1/tables/test/spin",
 			expectedStatus:  http.StatusBadRequest,
 			expectedBody: responses.Error{
-				Status: http.StatusBadRequest,
-				Detail: ErrInvalidID.Error(),
+				Type:     problem.TypeInvalid,
+				Title:    http.StatusText(http.StatusBadRequest),
+				Status:   http.StatusBadRequest,
+				Detail:   ErrInvalidID.Error(),
+				Instance: "/v1/tables/test/spin",
 			},
 		},
 		{
-			name: "given controller error, expect 400",
+			name: "given controller error, expect 404",
 			givenController: mockController{
 				GivenSpinError: memory.ErrInvalidKey,
 			},
 			givenURL:       "/v1/tables/70ee9bba-87ac-4155-8ec7-f83c8663315e/spin",
-			expectedStatus: http.StatusBadRequest,
+			expectedStatus: http.StatusNotFound,
+			expectedBody: responses.Error{
+				Type:     problem.TypeNotFound,
+				Title:    http.StatusText(http.StatusNotFound),
+				Status:   http.StatusNotFound,
+				Detail:   memory.ErrInvalidKey.Error(),
+				Instance: "/v1/tables/70ee9bba-87ac-4155-8ec7-f83c8663315e/spin",
+			},
+		},
+		{
+			name:            "given a weak If-Match, expect 412",
+			givenController: mockController{},
+			givenURL:        "/v1/tables/70ee9bba-87ac-4155-8ec7-f83c8663315e/spin",
+			givenIfMatch:    `W/"1"`,
+			expectedStatus:  http.StatusPreconditionFailed,
 			expectedBody: responses.Error{
-				Status: http.StatusBadRequest,
-				Detail: memory.ErrInvalidKey.Error(),
+				Type:     problem.TypeModified,
+				Title:    http.StatusText(http.StatusPreconditionFailed),
+				Status:   http.StatusPreconditionFailed,
+				Detail:   fmt.Sprintf("W/\"1\": %v", api.ErrUnmatchedETag),
+				Instance: "/v1/tables/70ee9bba-87ac-4155-8ec7-f83c8663315e/spin",
+			},
+		},
+		{
+			name: "given a table modified since the If-Match version, expect 412",
+			givenController: mockController{
+				GivenSpinError: table.ErrVersionConflict,
+			},
+			givenURL:       "/v1/tables/70ee9bba-87ac-4155-8ec7-f83c8663315e/spin",
+			givenIfMatch:   `"1"`,
+			expectedStatus: http.StatusPreconditionFailed,
+			expectedBody: responses.Error{
+				Type:     problem.TypeModified,
+				Title:    http.StatusText(http.StatusPreconditionFailed),
+				Status:   http.StatusPreconditionFailed,
+				Detail:   table.ErrVersionConflict.Error(),
+				Instance: "/v1/tables/70ee9bba-87ac-4155-8ec7-f83c8663315e/spin",
 			},
 		},
 	}
@@ -351,6 +481,9 @@ func TestHandler_Spin_Fail(t *testing.T) {
 			rr := httptest.NewRecorder()
 
 			req := httptest.NewRequest(http.MethodPut, test.givenURL, nil)
+			if test.givenIfMatch != "" {
+				req.Header.Set("If-Match", test.givenIfMatch)
+			}
 
 			router := new(mux.Router)
 			router.HandleFunc("/v1/tables/{id}/spin", handler.Spin)
@@ -394,10 +527,10 @@ func TestHandler_Settle_Success(t *testing.T) {
 						},
 					},
 					IsClosed: true,
-					Outcome: &domain.Outcome{
+					Outcomes: []domain.Outcome{{
 						Value:  16,
 						Colour: domain.Red,
-					},
+					}},
 				},
 			},
 			givenURL:       "/v1/tables/00812e8f-7fca-49a9-b141-9a52a0d0a82e/settle",
@@ -414,6 +547,10 @@ func TestHandler_Settle_Success(t *testing.T) {
 					Position: 16,
 					Colour:   domain.Red,
 				},
+				Outcomes: []api.Outcome{{
+					Position: 16,
+					Colour:   domain.Red,
+				}},
 			},
 		},
 	}
@@ -462,20 +599,26 @@ func TestHandler_Settle_Fail(t *testing.T) {
 			givenURL:        "/v1/tables/test/settle",
 			expectedStatus:  http.StatusBadRequest,
 			expectedBody: responses.Error{
-				Status: http.StatusBadRequest,
-				Detail: ErrInvalidID.Error(),
+				Type:     problem.TypeInvalid,
+				Title:    http.StatusText(http.StatusBadRequest),
+				Status:   http.StatusBadRequest,
+				Detail:   ErrInvalidID.Error(),
+				Instance: "/v1/tables/test/settle",
 			},
 		},
 		{
-			name: "given controller error, expect 400",
+			name: "given controller error, expect 404",
 			givenController: mockController{
 				GivenSettleError: memory.ErrInvalidKey,
 			},
 			givenURL:       "/v1/tables/70ee9bba-87ac-4155-8ec7-f83c8663315e/settle",
-			expectedStatus: http.StatusBadRequest,
+			expectedStatus: http.StatusNotFound,
 			expectedBody: responses.Error{
-				Status: http.StatusBadRequest,
-				Detail: memory.ErrInvalidKey.Error(),
+				Type:     problem.TypeNotFound,
+				Title:    http.StatusText(http.StatusNotFound),
+				Status:   http.StatusNotFound,
+				Detail:   memory.ErrInvalidKey.Error(),
+				Instance: "/v1/tables/70ee9bba-87ac-4155-8ec7-f83c8663315e/settle",
 			},
 		},
 	}
@@ -510,40 +653,181 @@ func TestHandler_Settle_Fail(t *testing.T) {
 	}
 }
 
+func TestHandler_Summary_Success(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenController Controller
+		givenURL        string
+		expectedStatus  int
+		expectedBody    api.TableSummaryResponse
+	}{
+		{
+			name: "given controller success, expect 200",
+			givenController: mockController{
+				GivenSummary: domain.TableSummary{
+					TableID:     uuid.MustParse("00812e8f-7fca-49a9-b141-9a52a0d0a82e"),
+					BetCount:    1,
+					TotalStaked: []*money.Money{money.New(100, "GBP")},
+					Exposure: []domain.PocketExposure{
+						{
+							Position:  0,
+							Colour:    domain.Green,
+							Liability: []*money.Money{money.New(3500, "GBP")},
+						},
+					},
+				},
+			},
+			givenURL:       "/v1/tables/00812e8f-7fca-49a9-b141-9a52a0d0a82e/summary",
+			expectedStatus: http.StatusOK,
+			expectedBody: api.TableSummaryResponse{
+				Table:       uuid.MustParse("00812e8f-7fca-49a9-b141-9a52a0d0a82e"),
+				BetCount:    1,
+				TotalStaked: []*money.Money{money.New(100, "GBP")},
+				Exposure: []api.PocketExposure{
+					{
+						Position:  0,
+						Colour:    domain.Green,
+						Liability: []*money.Money{money.New(3500, "GBP")},
+					},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler := New(test.givenController)
+
+			rr := httptest.NewRecorder()
+
+			req := httptest.NewRequest(http.MethodGet, test.givenURL, nil)
+
+			router := new(mux.Router)
+			router.HandleFunc("/v1/tables/{id}/summary", handler.Summary)
+			router.ServeHTTP(rr, req)
+
+			resp := rr.Result()
+
+			if !cmp.Equal(resp.StatusCode, test.expectedStatus) {
+				t.Fatal(cmp.Diff(resp.StatusCode, test.expectedStatus))
+			}
+
+			var res api.TableSummaryResponse
+			err := json.NewDecoder(resp.Body).Decode(&res)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(res, test.expectedBody, opts.MoneyComparer) {
+				t.Fatal(cmp.Diff(res, test.expectedBody, opts.MoneyComparer))
+			}
+		})
+	}
+}
+
+func TestHandler_Summary_Fail(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenController Controller
+		givenURL        string
+		expectedStatus  int
+		expectedBody    responses.Error
+	}{
+		{
+			name:            "given an invalid id, expect 400",
+			givenController: mockController{},
+			givenURL:        "/v1/tables/test/summary",
+			expectedStatus:  http.StatusBadRequest,
+			expectedBody: responses.Error{
+				Type:     problem.TypeInvalid,
+				Title:    http.StatusText(http.StatusBadRequest),
+				Status:   http.StatusBadRequest,
+				Detail:   ErrInvalidID.Error(),
+				Instance: "/v1/tables/test/summary",
+			},
+		},
+		{
+			name: "given controller error, expect 404",
+			givenController: mockController{
+				GivenSummaryError: memory.ErrNoTables,
+			},
+			givenURL:       "/v1/tables/00812e8f-7fca-49a9-b141-9a52a0d0a82e/summary",
+			expectedStatus: http.StatusNotFound,
+			expectedBody: responses.Error{
+				Type:     problem.TypeNotFound,
+				Title:    http.StatusText(http.StatusNotFound),
+				Status:   http.StatusNotFound,
+				Detail:   memory.ErrNoTables.Error(),
+				Instance: "/v1/tables/00812e8f-7fca-49a9-b141-9a52a0d0a82e/summary",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler := New(test.givenController)
+
+			rr := httptest.NewRecorder()
+
+			req := httptest.NewRequest(http.MethodGet, test.givenURL, nil)
+
+			router := new(mux.Router)
+			router.HandleFunc("/v1/tables/{id}/summary", handler.Summary)
+			router.ServeHTTP(rr, req)
+
+			resp := rr.Result()
+
+			if !cmp.Equal(resp.StatusCode, test.expectedStatus) {
+				t.Fatal(cmp.Diff(resp.StatusCode, test.expectedStatus))
+			}
+
+			var res responses.Error
+			err := json.NewDecoder(resp.Body).Decode(&res)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(res, test.expectedBody) {
+				t.Fatal(cmp.Diff(res, test.expectedBody))
+			}
+		})
+	}
+}
+
 func TestHandler_List_Success(t *testing.T) {
 	tests := []struct {
 		name            string
 		givenController Controller
 		givenURL        string
 		expectedStatus  int
+		expectedLink    string
 		expectedBody    []api.TableResponse
 	}{
 		{
 			name: "given controller success, expect 200",
 			givenController: mockController{
-				GivenListTables: []domain.Table{
-					{
-						ID: uuid.MustParse("00812e8f-7fca-49a9-b141-9a52a0d0a82e"),
-						Bets: []domain.Bet{
-							{
-								Status: domain.Settled,
+				GivenListPage: domain.TablePage{
+					Tables: []domain.Table{
+						{
+							ID: uuid.MustParse("00812e8f-7fca-49a9-b141-9a52a0d0a82e"),
+							Bets: []domain.Bet{
+								{
+									Status: domain.Settled,
+								},
 							},
+							IsClosed: true,
+							Outcomes: []domain.Outcome{{
+								Value:  16,
+								Colour: domain.Red,
+							}},
 						},
-						IsClosed: true,
-						Outcome: &domain.Outcome{
-							Value:  16,
-							Colour: domain.Red,
-						},
-					},
-					{
-						ID: uuid.MustParse("161ffdc3-564a-42b1-8340-0cf18b3cbfef"),
-						Bets: []domain.Bet{
-							{
-								Status: domain.Unsettled,
+						{
+							ID: uuid.MustParse("161ffdc3-564a-42b1-8340-0cf18b3cbfef"),
+							Bets: []domain.Bet{
+								{
+									Status: domain.Unsettled,
+								},
 							},
+							IsClosed: false,
 						},
-						IsClosed: false,
-						Outcome:  nil,
 					},
 				},
 			},
@@ -562,6 +846,10 @@ func TestHandler_List_Success(t *testing.T) {
 						Position: 16,
 						Colour:   domain.Red,
 					},
+					Outcomes: []api.Outcome{{
+						Position: 16,
+						Colour:   domain.Red,
+					}},
 				},
 				{
 					ID: uuid.MustParse("161ffdc3-564a-42b1-8340-0cf18b3cbfef"),
@@ -571,7 +859,35 @@ func TestHandler_List_Success(t *testing.T) {
 						},
 					},
 					IsClosed: false,
-					Outcome:  nil,
+				},
+			},
+		},
+		{
+			name: "given more tables follow, expect a link to the next page",
+			givenController: mockController{
+				GivenListPage: domain.TablePage{
+					Tables: []domain.Table{
+						{
+							ID: uuid.MustParse("00812e8f-7fca-49a9-b141-9a52a0d0a82e"),
+						},
+					},
+					Next: &domain.TableCursor{
+						CreatedAt: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC),
+						ID:        uuid.MustParse("00812e8f-7fca-49a9-b141-9a52a0d0a82e"),
+					},
+				},
+			},
+			givenURL:       "/v1/tables?limit=1&state=closed",
+			expectedStatus: http.StatusOK,
+			expectedLink: fmt.Sprintf("</v1/tables?cursor=%v&limit=1&state=closed>; rel=\"next\"",
+				api.EncodeTableCursor(domain.TableCursor{
+					CreatedAt: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC),
+					ID:        uuid.MustParse("00812e8f-7fca-49a9-b141-9a52a0d0a82e"),
+				})),
+			expectedBody: []api.TableResponse{
+				{
+					ID:   uuid.MustParse("00812e8f-7fca-49a9-b141-9a52a0d0a82e"),
+					Bets: []api.BetResponse{},
 				},
 			},
 		},
@@ -594,6 +910,10 @@ func TestHandler_List_Success(t *testing.T) {
 				t.Fatal(cmp.Diff(resp.StatusCode, test.expectedStatus))
 			}
 
+			if !cmp.Equal(resp.Header.Get("Link"), test.expectedLink) {
+				t.Fatal(cmp.Diff(resp.Header.Get("Link"), test.expectedLink))
+			}
+
 			var res []api.TableResponse
 			err := json.NewDecoder(resp.Body).Decode(&res)
 			if err != nil {
@@ -616,15 +936,31 @@ func TestHandler_List_Fail(t *testing.T) {
 		expectedBody    responses.Error
 	}{
 		{
-			name: "given controller error, expect 400",
+			name: "given controller error, expect 500",
 			givenController: mockController{
-				GivenListError: memory.ErrInvalidKey,
+				GivenListError: table.ErrFailedToListTables,
 			},
 			givenURL:       "/v1/tables",
-			expectedStatus: http.StatusBadRequest,
+			expectedStatus: http.StatusInternalServerError,
 			expectedBody: responses.Error{
-				Status: http.StatusBadRequest,
-				Detail: memory.ErrInvalidKey.Error(),
+				Type:     responses.BlankType,
+				Title:    http.StatusText(http.StatusInternalServerError),
+				Status:   http.StatusInternalServerError,
+				Detail:   http.StatusText(http.StatusInternalServerError),
+				Instance: "/v1/tables",
+			},
+		},
+		{
+			name:            "given an invalid query, expect 400",
+			givenController: mockController{},
+			givenURL:        "/v1/tables?order=sideways",
+			expectedStatus:  http.StatusBadRequest,
+			expectedBody: responses.Error{
+				Type:     problem.TypeInvalid,
+				Title:    http.StatusText(http.StatusBadRequest),
+				Status:   http.StatusBadRequest,
+				Detail:   "sideways: " + api.ErrInvalidOrder.Error(),
+				Instance: "/v1/tables",
 			},
 		},
 	}
@@ -660,34 +996,40 @@ func TestHandler_List_Fail(t *testing.T) {
 }
 
 type mockController struct {
-	GivenGetTable    domain.Table
-	GivenGetError    error
-	GivenListTables  []domain.Table
-	GivenListError   error
-	GivenCreateTable domain.Table
-	GivenCreateError error
-	GivenSpinTable   domain.Table
-	GivenSpinError   error
-	GivenSettleTable domain.Table
-	GivenSettleError error
+	GivenGetTable     domain.Table
+	GivenGetError     error
+	GivenListPage     domain.TablePage
+	GivenListError    error
+	GivenCreateTable  domain.Table
+	GivenCreateError  error
+	GivenSpinTable    domain.Table
+	GivenSpinError    error
+	GivenSettleTable  domain.Table
+	GivenSettleError  error
+	GivenSummary      domain.TableSummary
+	GivenSummaryError error
 }
 
 func (m mockController) Get(_ context.Context, _ uuid.UUID) (domain.Table, error) {
 	return m.GivenGetTable, m.GivenGetError
 }
 
-func (m mockController) List(_ context.Context) ([]domain.Table, error) {
-	return m.GivenListTables, m.GivenListError
+func (m mockController) List(_ context.Context, _ domain.TableQuery) (domain.TablePage, error) {
+	return m.GivenListPage, m.GivenListError
 }
 
-func (m mockController) Create(_ context.Context) (domain.Table, error) {
+func (m mockController) Create(_ context.Context, _ domain.Rules, _ uuid.UUID) (domain.Table, error) {
 	return m.GivenCreateTable, m.GivenCreateError
 }
 
-func (m mockController) Spin(_ context.Context, _ uuid.UUID) (domain.Table, error) {
+func (m mockController) Spin(_ context.Context, _ uuid.UUID, _ int64) (domain.Table, error) {
 	return m.GivenSpinTable, m.GivenSpinError
 }
 
-func (m mockController) Settle(_ context.Context, _ uuid.UUID) (domain.Table, error) {
+func (m mockController) Settle(_ context.Context, _ uuid.UUID, _ int64) (domain.Table, error) {
 	return m.GivenSettleTable, m.GivenSettleError
 }
+
+func (m mockController) Summary(_ context.Context, _ uuid.UUID) (domain.TableSummary, error) {
+	return m.GivenSummary, m.GivenSummaryError
+}
@@ -2,7 +2,7 @@ package table
 
 import (
 	"betting/internal/bet"
-	"betting/internal/pkg/ballplacer"
+	"betting/internal/pkg/aggregator"
 	"betting/internal/pkg/winnerlocator"
 	"betting/internal/table"
 	"net/http"
@@ -10,12 +10,30 @@ import (
 	"github.com/gorilla/mux"
 )
 
-func Load(r *mux.Router, tableStorage table.StorageProvider, betStorage bet.StorageProvider) *mux.Router {
+func Load(
+	r *mux.Router,
+	tableStorage table.StorageProvider,
+	betStorage bet.StorageProvider,
+	placer table.BallPlacer,
+	notifier table.Notifier,
+	unitOfWork table.UnitOfWork,
+	jackpot table.Jackpot,
+	history table.History,
+	clock table.Clock,
+	auditor table.Auditor,
+) *mux.Router {
 	controller := table.NewController(table.ControllerParams{
 		RepositoryProvider:    table.NewRepository(tableStorage),
-		BallPlacer:            ballplacer.New(),
+		BallPlacer:            placer,
 		WinnerLocator:         winnerlocator.New(),
 		BetRepositoryProvider: bet.NewRepository(betStorage),
+		Aggregator:            aggregator.New(),
+		Notifier:              notifier,
+		UnitOfWork:            unitOfWork,
+		Jackpot:               jackpot,
+		History:               history,
+		Clock:                 clock,
+		Auditor:               auditor,
 	})
 
 	handler := New(controller)
@@ -23,6 +41,7 @@ func Load(r *mux.Router, tableStorage table.StorageProvider, betStorage bet.Stor
 	r.HandleFunc("/v1/tables", handler.Create).Methods(http.MethodPost)
 	r.HandleFunc("/v1/tables", handler.List).Methods(http.MethodGet)
 	r.HandleFunc("/v1/tables/{id}", handler.Get).Methods(http.MethodGet)
+	r.HandleFunc("/v1/tables/{id}/summary", handler.Summary).Methods(http.MethodGet)
 	r.HandleFunc("/v1/tables/{id}/spin", handler.Spin).Methods(http.MethodPut)
 	r.HandleFunc("/v1/tables/{id}/settle", handler.Settle).Methods(http.MethodPut)
 
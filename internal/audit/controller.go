@@ -0,0 +1,182 @@
+package audit
+
+import (
+	"betting/internal/domain"
+	"betting/internal/pkg/actor"
+	"betting/internal/pkg/correlation"
+	"betting/internal/pkg/logging"
+	"betting/internal/pkg/origin"
+	"betting/internal/pkg/tracing"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Domain errors.
+var (
+	ErrFailedToFetchAudit  = errors.New("failed to locate audit entries")
+	ErrFailedToDiff        = errors.New("failed to compare states")
+	ErrFailedToAppendAudit = errors.New("failed to append audit entry")
+)
+
+// RepositoryProvider provides read and append operations for AuditEntries.
+type RepositoryProvider interface {
+	Last(ctx context.Context) (domain.AuditEntry, error)
+	Append(ctx context.Context, entry domain.AuditEntry) error
+	List(ctx context.Context, query domain.AuditQuery) (domain.AuditPage, error)
+}
+
+// Clock tells the time AuditEntries are recorded at.
+type Clock interface {
+	Now() time.Time
+}
+
+// Controller is responsible for the audit log, the chain of AuditEntries recording every state-changing call.
+type Controller struct {
+	RepositoryProvider RepositoryProvider
+	Clock              Clock
+}
+
+// NewController instantiates Controller.
+func NewController(provider RepositoryProvider, clock Clock) Controller {
+	return Controller{
+		RepositoryProvider: provider,
+		Clock:              clock,
+	}
+}
+
+// Record appends an AuditEntry for the given action on subject, made by the actor of ctx on the origin of ctx, with the
+// Changes between the state before and after it. A nil before records every field of after, as the call created it.
+// Recorded within a unit of work, the entry is only kept when the changes it records are.
+func (c Controller) Record(ctx context.Context, action domain.AuditAction, subject uuid.UUID, before, after interface{}) error {
+	ctx, span := tracing.Start(ctx, "audit.Controller.Record")
+	defer span.End()
+
+	changes, err := diff(before, after)
+	if err != nil {
+		return tracing.Fail(span, fmt.Errorf("%v: %w", err, ErrFailedToDiff))
+	}
+
+	last, err := c.RepositoryProvider.Last(ctx)
+	if err != nil {
+		return tracing.Fail(span, fmt.Errorf("%v: %w", err, ErrFailedToFetchAudit))
+	}
+
+	o := origin.FromContext(ctx)
+
+	entry := domain.AuditEntry{
+		Sequence:     last.Sequence + 1,
+		Action:       action,
+		Subject:      subject,
+		Actor:        actor.FromContext(ctx),
+		RequestID:    correlation.FromContext(ctx),
+		Route:        o.Route,
+		BodyHash:     o.BodyHash,
+		RecordedAt:   c.Clock.Now(),
+		Changes:      changes,
+		PreviousHash: last.Hash,
+	}
+
+	entry.Hash = entry.Digest()
+
+	err = c.RepositoryProvider.Append(ctx, entry)
+	if err != nil {
+		return tracing.Fail(span, fmt.Errorf("%v: %w", err, ErrFailedToAppendAudit))
+	}
+
+	logging.FromContext(ctx).WithField("sequence", entry.Sequence).Debug("recorded audit entry")
+
+	return nil
+}
+
+// List returns a page of the AuditEntries matching the query.
+func (c Controller) List(ctx context.Context, query domain.AuditQuery) (domain.AuditPage, error) {
+	ctx, span := tracing.Start(ctx, "audit.Controller.List")
+	defer span.End()
+
+	page, err := c.RepositoryProvider.List(ctx, query)
+	if err != nil {
+		return domain.AuditPage{}, tracing.Fail(span, fmt.Errorf("%v: %w", err, ErrFailedToFetchAudit))
+	}
+
+	return page, nil
+}
+
+// diff returns the fields of after, JSON encoded, which differ from those of before, in order of field name. A field
+// missing from either state is taken to be null.
+func diff(before, after interface{}) ([]domain.Change, error) {
+	b, err := fields(before)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := fields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(a))
+
+	for name := range a {
+		names = append(names, name)
+	}
+
+	for name := range b {
+		if _, ok := a[name]; !ok {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	var changes []domain.Change
+
+	for _, name := range names {
+		if bytes.Equal(orNull(b[name]), orNull(a[name])) {
+			continue
+		}
+
+		changes = append(changes, domain.Change{
+			Field:  name,
+			Before: string(b[name]),
+			After:  string(a[name]),
+		})
+	}
+
+	return changes, nil
+}
+
+// fields returns each field of the given state JSON encoded, keyed by its name, none for a nil state.
+func fields(state interface{}) (map[string]json.RawMessage, error) {
+	f := make(map[string]json.RawMessage)
+
+	if state == nil {
+		return f, nil
+	}
+
+	raw, err := json.Marshal(state)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(raw, &f)
+	if err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func orNull(value json.RawMessage) json.RawMessage {
+	if value == nil {
+		return json.RawMessage("null")
+	}
+
+	return value
+}
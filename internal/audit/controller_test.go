@@ -0,0 +1,194 @@
+package audit
+
+import (
+	"betting/internal/domain"
+	"betting/internal/pkg/actor"
+	"betting/internal/pkg/clock"
+	"betting/internal/pkg/correlation"
+	"betting/internal/pkg/origin"
+	"betting/storage/memory"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+)
+
+var (
+	recordedAt = time.Date(2021, 10, 11, 12, 0, 0, 0, time.UTC)
+	subject    = uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce")
+)
+
+// state stands in for the Table or Bet acted on.
+type state struct {
+	Status string
+	Stake  int
+	Note   *string
+}
+
+func TestController_Record_Success(t *testing.T) {
+	type record struct {
+		action        domain.AuditAction
+		before, after interface{}
+	}
+
+	tests := []struct {
+		name            string
+		givenRecords    []record
+		expectedEntries []domain.AuditEntry
+	}{
+		{
+			name: "given a creation, expect every field but those left null",
+			givenRecords: []record{
+				{action: domain.CreateTable, after: state{Status: "open"}},
+			},
+			expectedEntries: []domain.AuditEntry{
+				{
+					Sequence: 1,
+					Action:   domain.CreateTable,
+					Changes: []domain.Change{
+						{Field: "Stake", After: "0"},
+						{Field: "Status", After: `"open"`},
+					},
+				},
+			},
+		},
+		{
+			name: "given a change after a creation, expect only the fields changed, chained to the creation",
+			givenRecords: []record{
+				{action: domain.CreateTable, after: state{Status: "open"}},
+				{action: domain.SpinTable, before: state{Status: "open"}, after: state{Status: "closed"}},
+			},
+			expectedEntries: []domain.AuditEntry{
+				{
+					Sequence: 1,
+					Action:   domain.CreateTable,
+					Changes: []domain.Change{
+						{Field: "Stake", After: "0"},
+						{Field: "Status", After: `"open"`},
+					},
+				},
+				{
+					Sequence: 2,
+					Action:   domain.SpinTable,
+					Changes: []domain.Change{
+						{Field: "Status", Before: `"open"`, After: `"closed"`},
+					},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := NewController(NewRepository(memory.NewAuditStorage()), clock.NewFake(recordedAt))
+
+			ctx := actor.NewContext(context.Background(), "croupier")
+			ctx = correlation.NewContext(ctx, "a1b2c3")
+			ctx = origin.NewContext(ctx, origin.Origin{Route: "PUT /v1/tables/{id}/spin", BodyHash: origin.Hash(nil)})
+
+			for _, r := range test.givenRecords {
+				err := c.Record(ctx, r.action, subject, r.before, r.after)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			page, err := c.List(context.Background(), domain.AuditQuery{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = domain.VerifyAudit(page.Entries)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for i := range test.expectedEntries {
+				test.expectedEntries[i].Subject = subject
+				test.expectedEntries[i].Actor = "croupier"
+				test.expectedEntries[i].RequestID = "a1b2c3"
+				test.expectedEntries[i].Route = "PUT /v1/tables/{id}/spin"
+				test.expectedEntries[i].BodyHash = origin.Hash(nil)
+				test.expectedEntries[i].RecordedAt = recordedAt
+			}
+
+			ignore := cmpopts.IgnoreFields(domain.AuditEntry{}, "PreviousHash", "Hash")
+
+			if !cmp.Equal(page.Entries, test.expectedEntries, ignore) {
+				t.Fatal(cmp.Diff(page.Entries, test.expectedEntries, ignore))
+			}
+		})
+	}
+}
+
+func TestController_Record_Fail(t *testing.T) {
+	tests := []struct {
+		name          string
+		givenRepo     RepositoryProvider
+		givenAfter    interface{}
+		expectedError error
+	}{
+		{
+			name:          "given a state that cannot be encoded, expect ErrFailedToDiff",
+			givenRepo:     mockRepository{},
+			givenAfter:    func() {},
+			expectedError: ErrFailedToDiff,
+		},
+		{
+			name:          "given the latest entry cannot be read, expect ErrFailedToFetchAudit",
+			givenRepo:     mockRepository{GivenLastError: errors.New("storage unavailable")},
+			givenAfter:    state{Status: "open"},
+			expectedError: ErrFailedToFetchAudit,
+		},
+		{
+			name:          "given the entry cannot be appended, expect ErrFailedToAppendAudit",
+			givenRepo:     mockRepository{GivenAppendError: errors.New("storage unavailable")},
+			givenAfter:    state{Status: "open"},
+			expectedError: ErrFailedToAppendAudit,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := NewController(test.givenRepo, clock.NewFake(recordedAt))
+
+			err := c.Record(context.Background(), domain.CreateTable, subject, nil, test.givenAfter)
+			if err == nil {
+				t.Fatalf("expected %v, got nil", test.expectedError)
+			}
+
+			if !cmp.Equal(err, test.expectedError, cmpopts.EquateErrors()) {
+				t.Fatal(cmp.Diff(err, test.expectedError, cmpopts.EquateErrors()))
+			}
+		})
+	}
+}
+
+func TestController_List_Fail(t *testing.T) {
+	c := NewController(mockRepository{GivenListError: errors.New("storage unavailable")}, clock.NewFake(recordedAt))
+
+	_, err := c.List(context.Background(), domain.AuditQuery{})
+	if !cmp.Equal(err, ErrFailedToFetchAudit, cmpopts.EquateErrors()) {
+		t.Fatal(cmp.Diff(err, ErrFailedToFetchAudit, cmpopts.EquateErrors()))
+	}
+}
+
+type mockRepository struct {
+	GivenLastError   error
+	GivenAppendError error
+	GivenListError   error
+}
+
+func (m mockRepository) Last(_ context.Context) (domain.AuditEntry, error) {
+	return domain.AuditEntry{}, m.GivenLastError
+}
+
+func (m mockRepository) Append(_ context.Context, _ domain.AuditEntry) error {
+	return m.GivenAppendError
+}
+
+func (m mockRepository) List(_ context.Context, _ domain.AuditQuery) (domain.AuditPage, error) {
+	return domain.AuditPage{}, m.GivenListError
+}
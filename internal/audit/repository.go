@@ -0,0 +1,76 @@
+package audit
+
+import (
+	"betting/internal/domain"
+	"betting/internal/pkg/tracing"
+	"betting/storage"
+	"context"
+)
+
+// StorageProvider provides both read and append operations for AuditEntries.
+type StorageProvider interface {
+	StorageReader
+	StorageWriter
+}
+
+// StorageReader provides read operations for AuditEntries.
+type StorageReader interface {
+	Last(ctx context.Context) (storage.AuditEntry, error)
+	List(ctx context.Context, query storage.AuditQuery) (storage.AuditPage, error)
+}
+
+// StorageWriter provides the append operation for AuditEntries, which are never updated or removed.
+type StorageWriter interface {
+	Append(ctx context.Context, entry storage.AuditEntry) error
+}
+
+// Repository allows for AuditEntries to be stored.
+type Repository struct {
+	StorageProvider StorageProvider
+}
+
+// NewRepository instantiates a Repository.
+func NewRepository(provider StorageProvider) Repository {
+	return Repository{
+		StorageProvider: provider,
+	}
+}
+
+// Last returns the latest AuditEntry, the zero AuditEntry when none has been recorded.
+func (r Repository) Last(ctx context.Context) (domain.AuditEntry, error) {
+	ctx, span := tracing.Start(ctx, "audit.Repository.Last")
+	defer span.End()
+
+	entry, err := r.StorageProvider.Last(ctx)
+	if err != nil {
+		return domain.AuditEntry{}, tracing.Fail(span, err)
+	}
+
+	return storage.AdaptAuditEntryToDomain(entry), nil
+}
+
+// Append records the given AuditEntry after the latest one.
+func (r Repository) Append(ctx context.Context, entry domain.AuditEntry) error {
+	ctx, span := tracing.Start(ctx, "audit.Repository.Append")
+	defer span.End()
+
+	err := r.StorageProvider.Append(ctx, storage.AdaptAuditEntryFromDomain(entry))
+	if err != nil {
+		return tracing.Fail(span, err)
+	}
+
+	return nil
+}
+
+// List returns a page of the AuditEntries matching the query.
+func (r Repository) List(ctx context.Context, query domain.AuditQuery) (domain.AuditPage, error) {
+	ctx, span := tracing.Start(ctx, "audit.Repository.List")
+	defer span.End()
+
+	page, err := r.StorageProvider.List(ctx, storage.AdaptAuditQueryFromDomain(query))
+	if err != nil {
+		return domain.AuditPage{}, tracing.Fail(span, err)
+	}
+
+	return storage.AdaptAuditPageToDomain(page), nil
+}
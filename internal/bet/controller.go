@@ -2,9 +2,19 @@ package bet
 
 import (
 	"betting/internal/domain"
+	"betting/internal/pkg/actor"
+	"betting/internal/pkg/clock"
+	"betting/internal/pkg/logging"
+	"betting/internal/pkg/tracing"
 	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
 
+	"github.com/Rhymond/go-money"
 	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
 )
 
 type RepositoryProvider interface {
@@ -14,10 +24,13 @@ type RepositoryProvider interface {
 
 type RepoWriter interface {
 	Insert(ctx context.Context, bet domain.Bet) error
+	InsertSlip(ctx context.Context, slip domain.Slip) error
 }
 
 type RepoReader interface {
 	Get(ctx context.Context, id uuid.UUID) (domain.Bet, error)
+	GetSlip(ctx context.Context, id uuid.UUID) (domain.Slip, error)
+	List(ctx context.Context, id uuid.UUID) ([]domain.Bet, error)
 }
 
 type TableRepoProvider interface {
@@ -29,41 +42,391 @@ type TableRepoReader interface {
 	Get(ctx context.Context, id uuid.UUID) (domain.Table, error)
 }
 
+// Jackpot takes the contributions of Bets placed with the Jackpot side bet.
+type Jackpot interface {
+	Contribute(ctx context.Context, bet domain.Bet) (domain.Bet, error)
+}
+
+// UnitOfWork runs fn as a single unit, undoing its writes to storage should it return an error.
+type UnitOfWork interface {
+	Transact(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// ExposureLimiter rejects Bets which would leave the house liable for more than a Table allows.
+type ExposureLimiter interface {
+	Check(ctx context.Context, table domain.Table, bet domain.Bet) error
+}
+
+// Wallet holds the balance of each player, from which the Stakes of their Bets are taken.
+type Wallet interface {
+	Debit(ctx context.Context, player string, stakes []*money.Money) error
+}
+
+// Auditor records the placing of each Bet and Slip.
+type Auditor interface {
+	Record(ctx context.Context, action domain.AuditAction, subject uuid.UUID, before, after interface{}) error
+}
+
+// Clock tells the time Bets are placed at.
+type Clock interface {
+	Now() time.Time
+}
+
 type Controller struct {
 	RepositoryProvider RepositoryProvider
 	TableRepoProvider  TableRepoProvider
+	ExposureLimiter    ExposureLimiter
+	Jackpot            Jackpot
+	Wallet             Wallet
+	UnitOfWork         UnitOfWork
+	Clock              Clock
+	Auditor            Auditor
+}
+
+// ControllerParams hold the dependencies required for a Controller, the Jackpot, Wallet, UnitOfWork, Clock and Auditor
+// are optional. Without a Jackpot Bets cannot be placed with the side bet, without a Wallet Stakes are not taken from a
+// balance, without a UnitOfWork a contribution is kept when its Bet is refused by storage, without a Clock the system
+// clock is used and without an Auditor Bets are not audited.
+type ControllerParams struct {
+	RepositoryProvider RepositoryProvider
+	TableRepoProvider  TableRepoProvider
+	ExposureLimiter    ExposureLimiter
+	Jackpot            Jackpot
+	Wallet             Wallet
+	UnitOfWork         UnitOfWork
+	Clock              Clock
+	Auditor            Auditor
 }
 
-func NewController(provider RepositoryProvider, repoProvider TableRepoProvider) Controller {
+// NewController instantiates Controller.
+func NewController(p ControllerParams) Controller {
+	if p.Clock == nil {
+		p.Clock = clock.New()
+	}
+
 	return Controller{
-		RepositoryProvider: provider,
-		TableRepoProvider:  repoProvider,
+		RepositoryProvider: p.RepositoryProvider,
+		TableRepoProvider:  p.TableRepoProvider,
+		ExposureLimiter:    p.ExposureLimiter,
+		Jackpot:            p.Jackpot,
+		Wallet:             p.Wallet,
+		UnitOfWork:         p.UnitOfWork,
+		Clock:              p.Clock,
+		Auditor:            p.Auditor,
 	}
 }
 
+// Create places the Bet on its Table, provided it stays within the exposure limit, stamped with the time of the Clock.
+// The Bets already on the Table are read and checked against the limit in the same unit of work as the Bet is stored,
+// so concurrent Bets cannot exceed it together, and the Stake is taken from the wallet of the actor of ctx. A Bet
+// placed with the Jackpot side bet pays its contribution into the Pool as it is stored and the Bet is audited, within
+// that unit of work.
 func (c Controller) Create(ctx context.Context, bet domain.Bet) (domain.Bet, error) {
+	ctx, span := tracing.Start(ctx, "bet.Controller.Create",
+		tracing.KeyTableID.String(bet.Table.String()),
+		tracing.KeyBetID.String(bet.ID.String()),
+	)
+	defer span.End()
+
+	logger := logging.FromContext(ctx).WithFields(log.Fields{
+		logging.FieldTableID: bet.Table,
+		logging.FieldBetID:   bet.ID,
+	})
+
+	bet, err := expand(bet)
+	if err != nil {
+		return domain.Bet{}, tracing.Fail(span, err)
+	}
+
+	err = bet.ValidateStake()
+	if err != nil {
+		return domain.Bet{}, tracing.Fail(span, err)
+	}
+
+	if bet.Jackpot && c.Jackpot == nil {
+		return domain.Bet{}, tracing.Fail(span, ErrNoJackpot)
+	}
+
+	bet.PlacedAt = c.Clock.Now()
+
 	table, err := c.TableRepoProvider.Get(ctx, bet.Table)
 	if err != nil {
-		return domain.Bet{}, err
+		return domain.Bet{}, tracing.Fail(span, err)
+	}
+
+	if table.IsClosed {
+		logger.Debug("table is closed")
+
+		return domain.Bet{}, tracing.Fail(span, ErrTableClosed)
+	}
+
+	logger.Debug("inserting bet")
+
+	err = c.transact(ctx, func(ctx context.Context) error {
+		var err error
+
+		table.Bets, err = c.RepositoryProvider.List(ctx, table.ID)
+		if err != nil {
+			return err
+		}
+
+		span.SetAttributes(tracing.KeyBetCount.Int(len(table.Bets)))
+
+		err = c.ExposureLimiter.Check(ctx, table, bet)
+		if err != nil {
+			logger.WithError(err).Debug("bet exceeds exposure limit")
+
+			return err
+		}
+
+		err = c.debit(ctx, bet)
+		if err != nil {
+			logger.WithError(err).Debug("bet exceeds wallet balance")
+
+			return err
+		}
+
+		bet, err = c.contribute(ctx, bet)
+		if err != nil {
+			return err
+		}
+
+		err = c.RepositoryProvider.Insert(ctx, bet)
+		if err != nil {
+			return err
+		}
+
+		return c.audit(ctx, domain.PlaceBet, bet.ID, bet)
+	})
+	if err != nil {
+		if errors.Is(err, ErrTableClosed) {
+			logger.Debug("table closed before the bet was stored")
+		}
+
+		return domain.Bet{}, tracing.Fail(span, err)
+	}
+
+	return bet, nil
+}
+
+// CreateSlip places every Bet of the Slip on its Table or none of them. The Bets are checked against the exposure limit
+// together, each as though those before it had already been accepted, and are all stamped with the same time. As with
+// Create, the check is made in the unit of work storing the Slip, the Stakes of every Bet are taken from the wallet of
+// the actor of ctx, or none are when it holds too little, the contributions of side bets are paid into the Pool and the
+// Slip is audited as it is stored.
+func (c Controller) CreateSlip(ctx context.Context, slip domain.Slip) (domain.Slip, error) {
+	ctx, span := tracing.Start(ctx, "bet.Controller.CreateSlip",
+		tracing.KeyTableID.String(slip.Table.String()),
+		tracing.KeySlipID.String(slip.ID.String()),
+		tracing.KeyBetCount.Int(len(slip.Bets)),
+	)
+	defer span.End()
+
+	logger := logging.FromContext(ctx).WithFields(log.Fields{
+		logging.FieldTableID: slip.Table,
+		logging.FieldSlipID:  slip.ID,
+	})
+
+	if len(slip.Bets) == 0 {
+		return domain.Slip{}, tracing.Fail(span, ErrEmptySlip)
+	}
+
+	bets := make([]domain.Bet, len(slip.Bets))
+	placedAt := c.Clock.Now()
+
+	for i := range slip.Bets {
+		bet, err := expand(slip.Bets[i])
+		if err != nil {
+			return domain.Slip{}, tracing.Fail(span, err)
+		}
+
+		err = bet.ValidateStake()
+		if err != nil {
+			return domain.Slip{}, tracing.Fail(span, err)
+		}
+
+		if bet.Jackpot && c.Jackpot == nil {
+			return domain.Slip{}, tracing.Fail(span, ErrNoJackpot)
+		}
+
+		bet.PlacedAt = placedAt
+		bets[i] = bet
+	}
+
+	slip.Bets = bets
+
+	table, err := c.TableRepoProvider.Get(ctx, slip.Table)
+	if err != nil {
+		return domain.Slip{}, tracing.Fail(span, err)
 	}
 
 	if table.IsClosed {
-		return domain.Bet{}, ErrTableClosed
+		logger.Debug("table is closed")
+
+		return domain.Slip{}, tracing.Fail(span, ErrTableClosed)
 	}
 
-	err = c.RepositoryProvider.Insert(ctx, bet)
+	logger.Debug("inserting slip")
+
+	err = c.transact(ctx, func(ctx context.Context) error {
+		var err error
+
+		table.Bets, err = c.RepositoryProvider.List(ctx, table.ID)
+		if err != nil {
+			return err
+		}
+
+		for i := range slip.Bets {
+			err = c.ExposureLimiter.Check(ctx, table, slip.Bets[i])
+			if err != nil {
+				logger.WithError(err).WithField(logging.FieldBetID, slip.Bets[i].ID).Debug("slip exceeds exposure limit")
+
+				return err
+			}
+
+			table.Bets = append(table.Bets, slip.Bets[i])
+		}
+
+		err = c.debit(ctx, slip.Bets...)
+		if err != nil {
+			logger.WithError(err).Debug("slip exceeds wallet balance")
+
+			return err
+		}
+
+		for i := range slip.Bets {
+			slip.Bets[i], err = c.contribute(ctx, slip.Bets[i])
+			if err != nil {
+				return err
+			}
+		}
+
+		err = c.RepositoryProvider.InsertSlip(ctx, slip)
+		if err != nil {
+			return err
+		}
+
+		return c.audit(ctx, domain.PlaceSlip, slip.ID, slip)
+	})
+	if err != nil {
+		if errors.Is(err, ErrTableClosed) {
+			logger.Debug("table closed before the slip was stored")
+		}
+
+		return domain.Slip{}, tracing.Fail(span, err)
+	}
+
+	return slip, nil
+}
+
+// debit takes the Stakes of the given Bets from the wallet of the actor of ctx, if there is a Wallet.
+func (c Controller) debit(ctx context.Context, bets ...domain.Bet) error {
+	if c.Wallet == nil {
+		return nil
+	}
+
+	stakes := make([]*money.Money, 0, len(bets))
+
+	for i := range bets {
+		if bets[i].Stake != nil {
+			stakes = append(stakes, bets[i].Stake)
+		}
+	}
+
+	return c.Wallet.Debit(ctx, actor.FromContext(ctx), stakes)
+}
+
+// contribute pays the contribution of a Bet placed with the side bet into the Pool of the Jackpot.
+func (c Controller) contribute(ctx context.Context, bet domain.Bet) (domain.Bet, error) {
+	if !bet.Jackpot {
+		return bet, nil
+	}
+
+	return c.Jackpot.Contribute(ctx, bet)
+}
+
+// audit records the placing of the given Bet or Slip with the Auditor, if there is one.
+func (c Controller) audit(ctx context.Context, action domain.AuditAction, id uuid.UUID, placed interface{}) error {
+	if c.Auditor == nil {
+		return nil
+	}
+
+	err := c.Auditor.Record(ctx, action, id, nil, placed)
+	if err != nil {
+		return fmt.Errorf("%v: %w", err, ErrFailedToAudit)
+	}
+
+	return nil
+}
+
+func (c Controller) transact(ctx context.Context, fn func(ctx context.Context) error) error {
+	if c.UnitOfWork == nil {
+		return fn(ctx)
+	}
+
+	return c.UnitOfWork.Transact(ctx, fn)
+}
+
+// expand replaces an announced Bet's Stake, taken as the stake of each chip, with the chips its Call is made of. The Bet
+// then covers every position its chips do and is staked their total. Bets which are not announced are returned as is.
+func expand(bet domain.Bet) (domain.Bet, error) {
+	if bet.Announcement == nil {
+		return bet, nil
+	}
+
+	if len(bet.SelectedSpaces) > 0 || bet.Stake == nil {
+		return domain.Bet{}, fmt.Errorf("%v must be staked per chip without spaces: %w", bet.Announcement.Call, domain.ErrInvalidAnnouncement)
+	}
+
+	placements, err := bet.Announcement.Placements(bet.Stake)
 	if err != nil {
 		return domain.Bet{}, err
 	}
 
+	seen := make(map[int]bool)
+	spaces := make([]int, 0, domain.Pockets)
+
+	for i := range placements {
+		for _, space := range placements[i].SelectedSpaces {
+			if seen[space] {
+				continue
+			}
+
+			seen[space] = true
+			spaces = append(spaces, space)
+		}
+	}
+
+	sort.Ints(spaces)
+
+	bet.Placements = placements
+	bet.SelectedSpaces = spaces
+	bet.Stake = money.New(bet.Stake.Amount()*int64(len(placements)), bet.Stake.Currency().Code)
+
 	return bet, nil
 }
 
 func (c Controller) Get(ctx context.Context, id uuid.UUID) (domain.Bet, error) {
+	ctx, span := tracing.Start(ctx, "bet.Controller.Get", tracing.KeyBetID.String(id.String()))
+	defer span.End()
+
 	bet, err := c.RepositoryProvider.Get(ctx, id)
 	if err != nil {
-		return domain.Bet{}, err
+		return domain.Bet{}, tracing.Fail(span, err)
 	}
 
 	return bet, nil
 }
+
+// GetSlip returns the Slip for the given ID with its Bets.
+func (c Controller) GetSlip(ctx context.Context, id uuid.UUID) (domain.Slip, error) {
+	ctx, span := tracing.Start(ctx, "bet.Controller.GetSlip", tracing.KeySlipID.String(id.String()))
+	defer span.End()
+
+	slip, err := c.RepositoryProvider.GetSlip(ctx, id)
+	if err != nil {
+		return domain.Slip{}, tracing.Fail(span, err)
+	}
+
+	return slip, nil
+}
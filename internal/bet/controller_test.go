@@ -2,11 +2,18 @@ package bet
 
 import (
 	"betting/internal/domain"
+	"betting/internal/pkg/clock"
+	"betting/internal/pkg/exposure"
+	"betting/storage"
 	"betting/storage/memory"
+	"betting/testing/opts"
 	"context"
+	"errors"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/Rhymond/go-money"
 	"github.com/google/go-cmp/cmp/cmpopts"
 
 	"github.com/google/go-cmp/cmp"
@@ -14,12 +21,16 @@ import (
 	"github.com/google/uuid"
 )
 
+var placedAt = time.Date(2021, 10, 11, 12, 0, 0, 0, time.UTC)
+
 func TestController_Create_Success(t *testing.T) {
 	tests := []struct {
 		name           string
 		givenBet       domain.Bet
 		givenTableRepo TableRepoProvider
 		givenBetRepo   RepositoryProvider
+		givenLimiter   ExposureLimiter
+		givenJackpot   Jackpot
 		expectedBet    domain.Bet
 	}{
 		{
@@ -28,7 +39,7 @@ func TestController_Create_Success(t *testing.T) {
 				ID:             uuid.MustParse("49cffe67-9798-4327-9760-c4b81562f928"),
 				Status:         "",
 				SelectedSpaces: []int{5},
-				Stake:          nil,
+				Stake:          money.New(100, "GBP"),
 				PlacedAt:       time.Time{},
 				SettledAt:      nil,
 				Win:            false,
@@ -39,33 +50,122 @@ func TestController_Create_Success(t *testing.T) {
 					ID:       uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
 					Bets:     nil,
 					IsClosed: false,
-					Outcome:  nil,
 				},
 			},
 			givenBetRepo: mockBetRepo{},
+			givenLimiter: mockLimiter{},
 			expectedBet: domain.Bet{
 				ID:             uuid.MustParse("49cffe67-9798-4327-9760-c4b81562f928"),
 				Status:         "",
 				SelectedSpaces: []int{5},
-				Stake:          nil,
-				PlacedAt:       time.Time{},
+				Stake:          money.New(100, "GBP"),
+				PlacedAt:       placedAt,
 				SettledAt:      nil,
 				Win:            false,
 				Table:          uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
 			},
 		},
+		{
+			name: "given a voisins du zero bet, expect it to be expanded into its nine chips",
+			givenBet: domain.Bet{
+				ID:           uuid.MustParse("49cffe67-9798-4327-9760-c4b81562f928"),
+				Stake:        money.New(100, "GBP"),
+				Announcement: &domain.Announcement{Call: domain.VoisinsDuZero},
+				Table:        uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
+			},
+			givenTableRepo: mockTableRepo{},
+			givenBetRepo:   mockBetRepo{},
+			givenLimiter:   mockLimiter{},
+			expectedBet: domain.Bet{
+				ID:             uuid.MustParse("49cffe67-9798-4327-9760-c4b81562f928"),
+				SelectedSpaces: []int{0, 2, 3, 4, 7, 12, 15, 18, 19, 21, 22, 25, 26, 28, 29, 32, 35},
+				Stake:          money.New(900, "GBP"),
+				Announcement:   &domain.Announcement{Call: domain.VoisinsDuZero},
+				Placements: []domain.Placement{
+					{SelectedSpaces: []int{0, 2, 3}, Stake: money.New(100, "GBP")},
+					{SelectedSpaces: []int{0, 2, 3}, Stake: money.New(100, "GBP")},
+					{SelectedSpaces: []int{4, 7}, Stake: money.New(100, "GBP")},
+					{SelectedSpaces: []int{12, 15}, Stake: money.New(100, "GBP")},
+					{SelectedSpaces: []int{18, 21}, Stake: money.New(100, "GBP")},
+					{SelectedSpaces: []int{19, 22}, Stake: money.New(100, "GBP")},
+					{SelectedSpaces: []int{25, 26, 28, 29}, Stake: money.New(100, "GBP")},
+					{SelectedSpaces: []int{25, 26, 28, 29}, Stake: money.New(100, "GBP")},
+					{SelectedSpaces: []int{32, 35}, Stake: money.New(100, "GBP")},
+				},
+				PlacedAt: placedAt,
+				Table:    uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
+			},
+		},
+		{
+			name: "given two neighbours of zero, expect straight up chips either side of it on the wheel",
+			givenBet: domain.Bet{
+				ID:           uuid.MustParse("49cffe67-9798-4327-9760-c4b81562f928"),
+				Stake:        money.New(100, "GBP"),
+				Announcement: &domain.Announcement{Call: domain.NeighboursOf, Number: 0, Neighbours: 2},
+				Table:        uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
+			},
+			givenTableRepo: mockTableRepo{},
+			givenBetRepo:   mockBetRepo{},
+			givenLimiter:   mockLimiter{},
+			expectedBet: domain.Bet{
+				ID:             uuid.MustParse("49cffe67-9798-4327-9760-c4b81562f928"),
+				SelectedSpaces: []int{0, 3, 15, 26, 32},
+				Stake:          money.New(500, "GBP"),
+				Announcement:   &domain.Announcement{Call: domain.NeighboursOf, Number: 0, Neighbours: 2},
+				Placements: []domain.Placement{
+					{SelectedSpaces: []int{3}, Stake: money.New(100, "GBP")},
+					{SelectedSpaces: []int{26}, Stake: money.New(100, "GBP")},
+					{SelectedSpaces: []int{0}, Stake: money.New(100, "GBP")},
+					{SelectedSpaces: []int{32}, Stake: money.New(100, "GBP")},
+					{SelectedSpaces: []int{15}, Stake: money.New(100, "GBP")},
+				},
+				PlacedAt: placedAt,
+				Table:    uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
+			},
+		},
+		{
+			name: "given a bet with the jackpot side bet, expect its contribution to be recorded",
+			givenBet: domain.Bet{
+				ID:             uuid.MustParse("49cffe67-9798-4327-9760-c4b81562f928"),
+				SelectedSpaces: []int{5},
+				Stake:          money.New(1000, "GBP"),
+				Jackpot:        true,
+				Table:          uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
+			},
+			givenTableRepo: mockTableRepo{},
+			givenBetRepo:   mockBetRepo{},
+			givenLimiter:   mockLimiter{},
+			givenJackpot: mockJackpot{
+				GivenContribution: money.New(10, "GBP"),
+			},
+			expectedBet: domain.Bet{
+				ID:             uuid.MustParse("49cffe67-9798-4327-9760-c4b81562f928"),
+				SelectedSpaces: []int{5},
+				Stake:          money.New(1000, "GBP"),
+				Jackpot:        true,
+				Contribution:   money.New(10, "GBP"),
+				PlacedAt:       placedAt,
+				Table:          uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			c := NewController(test.givenBetRepo, test.givenTableRepo)
+			c := NewController(ControllerParams{
+				RepositoryProvider: test.givenBetRepo,
+				TableRepoProvider:  test.givenTableRepo,
+				ExposureLimiter:    test.givenLimiter,
+				Jackpot:            test.givenJackpot,
+				Clock:              clock.NewFake(placedAt),
+			})
 
 			actual, err := c.Create(context.Background(), test.givenBet)
 			if err != nil {
 				t.Fatal(err)
 			}
 
-			if !cmp.Equal(actual, test.expectedBet) {
-				t.Fatal(cmp.Diff(actual, test.expectedBet))
+			if !cmp.Equal(actual, test.expectedBet, opts.MoneyComparer) {
+				t.Fatal(cmp.Diff(actual, test.expectedBet, opts.MoneyComparer))
 			}
 		})
 	}
@@ -77,6 +177,10 @@ func TestController_Create_Fail(t *testing.T) {
 		givenBet       domain.Bet
 		givenTableRepo TableRepoProvider
 		givenBetRepo   RepositoryProvider
+		givenLimiter   ExposureLimiter
+		givenJackpot   Jackpot
+		givenWallet    Wallet
+		givenAuditor   Auditor
 		expectedError  error
 	}{
 		{
@@ -85,7 +189,7 @@ func TestController_Create_Fail(t *testing.T) {
 				ID:             uuid.MustParse("49cffe67-9798-4327-9760-c4b81562f928"),
 				Status:         "",
 				SelectedSpaces: []int{5},
-				Stake:          nil,
+				Stake:          money.New(100, "GBP"),
 				PlacedAt:       time.Time{},
 				SettledAt:      nil,
 				Win:            false,
@@ -95,6 +199,7 @@ func TestController_Create_Fail(t *testing.T) {
 				GivenGetError: memory.ErrInvalidKey,
 			},
 			givenBetRepo:  mockBetRepo{},
+			givenLimiter:  mockLimiter{},
 			expectedError: memory.ErrInvalidKey,
 		},
 		{
@@ -103,7 +208,7 @@ func TestController_Create_Fail(t *testing.T) {
 				ID:             uuid.MustParse("49cffe67-9798-4327-9760-c4b81562f928"),
 				Status:         "",
 				SelectedSpaces: []int{5},
-				Stake:          nil,
+				Stake:          money.New(100, "GBP"),
 				PlacedAt:       time.Time{},
 				SettledAt:      nil,
 				Win:            false,
@@ -114,19 +219,105 @@ func TestController_Create_Fail(t *testing.T) {
 					ID:       uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
 					Bets:     nil,
 					IsClosed: true,
-					Outcome:  nil,
 				},
 			},
 			givenBetRepo:  mockBetRepo{},
+			givenLimiter:  mockLimiter{},
 			expectedError: ErrTableClosed,
 		},
+		{
+			name: "given a bet repo list error, expect it to be returned",
+			givenBet: domain.Bet{
+				ID:             uuid.MustParse("49cffe67-9798-4327-9760-c4b81562f928"),
+				SelectedSpaces: []int{5},
+				Stake:          money.New(100, "GBP"),
+				Table:          uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
+			},
+			givenTableRepo: mockTableRepo{},
+			givenBetRepo: mockBetRepo{
+				GivenListError: memory.ErrInvalidKey,
+			},
+			givenLimiter:  mockLimiter{},
+			expectedError: memory.ErrInvalidKey,
+		},
+		{
+			name: "given a bet exceeding the exposure limit, expect it to be rejected",
+			givenBet: domain.Bet{
+				ID:             uuid.MustParse("49cffe67-9798-4327-9760-c4b81562f928"),
+				SelectedSpaces: []int{5},
+				Stake:          money.New(100, "GBP"),
+				Table:          uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
+			},
+			givenTableRepo: mockTableRepo{},
+			givenBetRepo:   mockBetRepo{},
+			givenLimiter: mockLimiter{
+				GivenCheckError: exposure.ErrLimitExceeded,
+			},
+			expectedError: exposure.ErrLimitExceeded,
+		},
+		{
+			name: "given a stake exceeding the wallet balance, expect ErrInsufficientFunds",
+			givenBet: domain.Bet{
+				SelectedSpaces: []int{5},
+				Stake:          money.New(100, "GBP"),
+			},
+			givenTableRepo: mockTableRepo{},
+			givenBetRepo:   mockBetRepo{},
+			givenLimiter:   mockLimiter{},
+			givenWallet:    memory.NewWalletStorage(map[string]int64{"GBP": 50}),
+			expectedError:  memory.ErrInsufficientFunds,
+		},
+		{
+			name: "given a negative stake, expect ErrInvalidStake before the wallet is debited",
+			givenBet: domain.Bet{
+				SelectedSpaces: []int{5},
+				Stake:          money.New(-100000000, "GBP"),
+			},
+			givenTableRepo: mockTableRepo{},
+			givenBetRepo:   mockBetRepo{},
+			givenLimiter:   mockLimiter{},
+			givenWallet:    mockWallet{GivenError: errors.New("wallet must not be debited")},
+			expectedError:  domain.ErrInvalidStake,
+		},
+		{
+			name: "given a zero stake, expect ErrInvalidStake",
+			givenBet: domain.Bet{
+				SelectedSpaces: []int{5},
+				Stake:          money.New(0, "GBP"),
+			},
+			givenTableRepo: mockTableRepo{},
+			givenBetRepo:   mockBetRepo{},
+			givenLimiter:   mockLimiter{},
+			expectedError:  domain.ErrInvalidStake,
+		},
+		{
+			name: "given no stake, expect ErrInvalidStake",
+			givenBet: domain.Bet{
+				SelectedSpaces: []int{5},
+			},
+			givenTableRepo: mockTableRepo{},
+			givenBetRepo:   mockBetRepo{},
+			givenLimiter:   mockLimiter{},
+			expectedError:  domain.ErrInvalidStake,
+		},
+		{
+			name: "given an announced bet staked a negative amount per chip, expect ErrInvalidStake",
+			givenBet: domain.Bet{
+				Stake:        money.New(-100, "GBP"),
+				Announcement: &domain.Announcement{Call: domain.Orphelins},
+			},
+			givenTableRepo: mockTableRepo{},
+			givenBetRepo:   mockBetRepo{},
+			givenLimiter:   mockLimiter{},
+			expectedError:  domain.ErrInvalidStake,
+		},
 		{
 			name: "given an insert repo error, expect it to be returned",
 			givenBet: domain.Bet{
 				ID:             uuid.MustParse("49cffe67-9798-4327-9760-c4b81562f928"),
 				Status:         "",
 				SelectedSpaces: []int{5},
-				Stake:          nil,
+				Stake:          money.New(100, "GBP"),
 				PlacedAt:       time.Time{},
 				SettledAt:      nil,
 				Win:            false,
@@ -136,12 +327,93 @@ func TestController_Create_Fail(t *testing.T) {
 			givenBetRepo: mockBetRepo{
 				GivenInsertError: memory.ErrDuplicateKey,
 			},
+			givenLimiter:  mockLimiter{},
 			expectedError: memory.ErrDuplicateKey,
 		},
+		{
+			name: "given an announced bet which also selects spaces, expect ErrInvalidAnnouncement",
+			givenBet: domain.Bet{
+				SelectedSpaces: []int{5},
+				Stake:          money.New(100, "GBP"),
+				Announcement:   &domain.Announcement{Call: domain.Orphelins},
+			},
+			givenTableRepo: mockTableRepo{},
+			givenBetRepo:   mockBetRepo{},
+			givenLimiter:   mockLimiter{},
+			expectedError:  domain.ErrInvalidAnnouncement,
+		},
+		{
+			name: "given more neighbours than the wheel holds, expect ErrInvalidAnnouncement",
+			givenBet: domain.Bet{
+				Stake:        money.New(100, "GBP"),
+				Announcement: &domain.Announcement{Call: domain.NeighboursOf, Number: 17, Neighbours: 19},
+			},
+			givenTableRepo: mockTableRepo{},
+			givenBetRepo:   mockBetRepo{},
+			givenLimiter:   mockLimiter{},
+			expectedError:  domain.ErrInvalidAnnouncement,
+		},
+		{
+			name: "given a call that is not known, expect ErrInvalidAnnouncement",
+			givenBet: domain.Bet{
+				Stake:        money.New(100, "GBP"),
+				Announcement: &domain.Announcement{Call: "jeu zero"},
+			},
+			givenTableRepo: mockTableRepo{},
+			givenBetRepo:   mockBetRepo{},
+			givenLimiter:   mockLimiter{},
+			expectedError:  domain.ErrInvalidAnnouncement,
+		},
+		{
+			name: "given a side bet without a jackpot to contribute to, expect ErrNoJackpot",
+			givenBet: domain.Bet{
+				SelectedSpaces: []int{5},
+				Stake:          money.New(100, "GBP"),
+				Jackpot:        true,
+			},
+			givenTableRepo: mockTableRepo{},
+			givenBetRepo:   mockBetRepo{},
+			givenLimiter:   mockLimiter{},
+			expectedError:  ErrNoJackpot,
+		},
+		{
+			name: "given the contribution fails, expect the error to be returned",
+			givenBet: domain.Bet{
+				SelectedSpaces: []int{5},
+				Stake:          money.New(100, "GBP"),
+				Jackpot:        true,
+			},
+			givenTableRepo: mockTableRepo{},
+			givenBetRepo:   mockBetRepo{},
+			givenLimiter:   mockLimiter{},
+			givenJackpot: mockJackpot{
+				GivenContributeError: memory.ErrPoolExhausted,
+			},
+			expectedError: memory.ErrPoolExhausted,
+		},
+		{
+			name: "given the bet fails to be audited, expect ErrFailedToAudit",
+			givenBet: domain.Bet{
+				SelectedSpaces: []int{5},
+				Stake:          money.New(100, "GBP"),
+			},
+			givenTableRepo: mockTableRepo{},
+			givenBetRepo:   mockBetRepo{},
+			givenLimiter:   mockLimiter{},
+			givenAuditor:   mockAuditor{GivenError: errors.New("storage unavailable")},
+			expectedError:  ErrFailedToAudit,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			c := NewController(test.givenBetRepo, test.givenTableRepo)
+			c := NewController(ControllerParams{
+				RepositoryProvider: test.givenBetRepo,
+				TableRepoProvider:  test.givenTableRepo,
+				ExposureLimiter:    test.givenLimiter,
+				Jackpot:            test.givenJackpot,
+				Wallet:             test.givenWallet,
+				Auditor:            test.givenAuditor,
+			})
 
 			_, err := c.Create(context.Background(), test.givenBet)
 			if err == nil {
@@ -161,6 +433,7 @@ func TestController_Get_Success(t *testing.T) {
 		givenID        uuid.UUID
 		givenTableRepo TableRepoProvider
 		givenBetRepo   RepositoryProvider
+		givenLimiter   ExposureLimiter
 		expectedBet    domain.Bet
 	}{
 		{
@@ -193,7 +466,11 @@ func TestController_Get_Success(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			c := NewController(test.givenBetRepo, test.givenTableRepo)
+			c := NewController(ControllerParams{
+				RepositoryProvider: test.givenBetRepo,
+				TableRepoProvider:  test.givenTableRepo,
+				ExposureLimiter:    test.givenLimiter,
+			})
 
 			actual, err := c.Get(context.Background(), test.givenID)
 			if err != nil {
@@ -213,6 +490,7 @@ func TestController_Get_Fail(t *testing.T) {
 		givenID        uuid.UUID
 		givenTableRepo TableRepoProvider
 		givenBetRepo   RepositoryProvider
+		givenLimiter   ExposureLimiter
 		expectedError  error
 	}{
 		{
@@ -227,7 +505,11 @@ func TestController_Get_Fail(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			c := NewController(test.givenBetRepo, test.givenTableRepo)
+			c := NewController(ControllerParams{
+				RepositoryProvider: test.givenBetRepo,
+				TableRepoProvider:  test.givenTableRepo,
+				ExposureLimiter:    test.givenLimiter,
+			})
 
 			_, err := c.Get(context.Background(), test.givenID)
 			if err == nil {
@@ -241,20 +523,373 @@ func TestController_Get_Fail(t *testing.T) {
 	}
 }
 
+func TestController_CreateSlip_Success(t *testing.T) {
+	tests := []struct {
+		name           string
+		givenSlip      domain.Slip
+		givenTableRepo TableRepoProvider
+		givenBetRepo   RepositoryProvider
+		givenLimiter   ExposureLimiter
+		expectedSlip   domain.Slip
+	}{
+		{
+			name: "given a slip of bets within the limit together, expect it to be inserted",
+			givenSlip: domain.Slip{
+				ID:    uuid.MustParse("8f1f5f5c-7a43-4d0e-a4a8-1b8a2b8f4c11"),
+				Table: uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
+				Bets: []domain.Bet{
+					{
+						ID:             uuid.MustParse("49cffe67-9798-4327-9760-c4b81562f928"),
+						SelectedSpaces: []int{5},
+						Stake:          money.New(100, "GBP"),
+						Table:          uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
+						Slip:           uuid.MustParse("8f1f5f5c-7a43-4d0e-a4a8-1b8a2b8f4c11"),
+					},
+					{
+						ID:             uuid.MustParse("c4b39dc0-2ff4-4405-b3cb-c4f87a9c82fb"),
+						SelectedSpaces: []int{6},
+						Stake:          money.New(100, "GBP"),
+						Table:          uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
+						Slip:           uuid.MustParse("8f1f5f5c-7a43-4d0e-a4a8-1b8a2b8f4c11"),
+					},
+				},
+			},
+			givenTableRepo: mockTableRepo{
+				GivenGetTable: domain.Table{
+					ID: uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
+				},
+			},
+			givenBetRepo: mockBetRepo{},
+			givenLimiter: exposure.New(exposure.Limits{"GBP": 3600}, nil),
+			expectedSlip: domain.Slip{
+				ID:    uuid.MustParse("8f1f5f5c-7a43-4d0e-a4a8-1b8a2b8f4c11"),
+				Table: uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
+				Bets: []domain.Bet{
+					{
+						ID:             uuid.MustParse("49cffe67-9798-4327-9760-c4b81562f928"),
+						SelectedSpaces: []int{5},
+						Stake:          money.New(100, "GBP"),
+						PlacedAt:       placedAt,
+						Table:          uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
+						Slip:           uuid.MustParse("8f1f5f5c-7a43-4d0e-a4a8-1b8a2b8f4c11"),
+					},
+					{
+						ID:             uuid.MustParse("c4b39dc0-2ff4-4405-b3cb-c4f87a9c82fb"),
+						SelectedSpaces: []int{6},
+						Stake:          money.New(100, "GBP"),
+						PlacedAt:       placedAt,
+						Table:          uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
+						Slip:           uuid.MustParse("8f1f5f5c-7a43-4d0e-a4a8-1b8a2b8f4c11"),
+					},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := NewController(ControllerParams{
+				RepositoryProvider: test.givenBetRepo,
+				TableRepoProvider:  test.givenTableRepo,
+				ExposureLimiter:    test.givenLimiter,
+				Clock:              clock.NewFake(placedAt),
+			})
+
+			actual, err := c.CreateSlip(context.Background(), test.givenSlip)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(actual, test.expectedSlip, opts.MoneyComparer) {
+				t.Fatal(cmp.Diff(actual, test.expectedSlip, opts.MoneyComparer))
+			}
+		})
+	}
+}
+
+func TestController_CreateSlip_Fail(t *testing.T) {
+	slip := domain.Slip{
+		ID:    uuid.MustParse("8f1f5f5c-7a43-4d0e-a4a8-1b8a2b8f4c11"),
+		Table: uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
+		Bets: []domain.Bet{
+			{
+				ID:             uuid.MustParse("49cffe67-9798-4327-9760-c4b81562f928"),
+				SelectedSpaces: []int{5},
+				Stake:          money.New(100, "GBP"),
+				Table:          uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
+			},
+			{
+				ID:             uuid.MustParse("c4b39dc0-2ff4-4405-b3cb-c4f87a9c82fb"),
+				SelectedSpaces: []int{5},
+				Stake:          money.New(100, "GBP"),
+				Table:          uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		givenSlip      domain.Slip
+		givenTableRepo TableRepoProvider
+		givenBetRepo   RepositoryProvider
+		givenLimiter   ExposureLimiter
+		givenWallet    Wallet
+		givenAuditor   Auditor
+		expectedError  error
+	}{
+		{
+			name:           "given a slip without bets, expect ErrEmptySlip",
+			givenSlip:      domain.Slip{ID: slip.ID, Table: slip.Table},
+			givenTableRepo: mockTableRepo{},
+			givenBetRepo:   mockBetRepo{},
+			givenLimiter:   mockLimiter{},
+			expectedError:  ErrEmptySlip,
+		},
+		{
+			name:      "given a table repo error, expect it to be returned",
+			givenSlip: slip,
+			givenTableRepo: mockTableRepo{
+				GivenGetError: memory.ErrNoTables,
+			},
+			givenBetRepo:  mockBetRepo{},
+			givenLimiter:  mockLimiter{},
+			expectedError: memory.ErrNoTables,
+		},
+		{
+			name:      "given a table that is closed, expect ErrTableClosed",
+			givenSlip: slip,
+			givenTableRepo: mockTableRepo{
+				GivenGetTable: domain.Table{IsClosed: true},
+			},
+			givenBetRepo:  mockBetRepo{},
+			givenLimiter:  mockLimiter{},
+			expectedError: ErrTableClosed,
+		},
+		{
+			name:           "given bets each within the limit which exceed it together, expect the slip to be rejected",
+			givenSlip:      slip,
+			givenTableRepo: mockTableRepo{},
+			givenBetRepo:   mockBetRepo{},
+			givenLimiter:   exposure.New(exposure.Limits{"GBP": 3600}, nil),
+			expectedError:  exposure.ErrLimitExceeded,
+		},
+		{
+			name:           "given bets each within the wallet balance which exceed it together, expect ErrInsufficientFunds",
+			givenSlip:      slip,
+			givenTableRepo: mockTableRepo{},
+			givenBetRepo:   mockBetRepo{},
+			givenLimiter:   mockLimiter{},
+			givenWallet:    memory.NewWalletStorage(map[string]int64{"GBP": 150}),
+			expectedError:  memory.ErrInsufficientFunds,
+		},
+		{
+			name: "given a slip with a negative stake among its bets, expect ErrInvalidStake before the wallet is debited",
+			givenSlip: domain.Slip{
+				ID:    slip.ID,
+				Table: slip.Table,
+				Bets: []domain.Bet{
+					slip.Bets[0],
+					{SelectedSpaces: []int{17}, Stake: money.New(-100000, "GBP"), Table: slip.Table},
+				},
+			},
+			givenTableRepo: mockTableRepo{},
+			givenBetRepo:   mockBetRepo{},
+			givenLimiter:   mockLimiter{},
+			givenWallet:    mockWallet{GivenError: errors.New("wallet must not be debited")},
+			expectedError:  domain.ErrInvalidStake,
+		},
+		{
+			name: "given a slip with a zero stake among its bets, expect ErrInvalidStake",
+			givenSlip: domain.Slip{
+				ID:    slip.ID,
+				Table: slip.Table,
+				Bets:  []domain.Bet{slip.Bets[0], {SelectedSpaces: []int{17}, Stake: money.New(0, "GBP"), Table: slip.Table}},
+			},
+			givenTableRepo: mockTableRepo{},
+			givenBetRepo:   mockBetRepo{},
+			givenLimiter:   mockLimiter{},
+			expectedError:  domain.ErrInvalidStake,
+		},
+		{
+			name:           "given the table closes before the slip is stored, expect ErrTableClosed",
+			givenSlip:      slip,
+			givenTableRepo: mockTableRepo{},
+			givenBetRepo: mockBetRepo{
+				GivenInsertSlipError: ErrTableClosed,
+			},
+			givenLimiter:  mockLimiter{},
+			expectedError: ErrTableClosed,
+		},
+		{
+			name:           "given the slip fails to be audited, expect ErrFailedToAudit",
+			givenSlip:      slip,
+			givenTableRepo: mockTableRepo{},
+			givenBetRepo:   mockBetRepo{},
+			givenLimiter:   mockLimiter{},
+			givenAuditor:   mockAuditor{GivenError: errors.New("storage unavailable")},
+			expectedError:  ErrFailedToAudit,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := NewController(ControllerParams{
+				RepositoryProvider: test.givenBetRepo,
+				TableRepoProvider:  test.givenTableRepo,
+				ExposureLimiter:    test.givenLimiter,
+				Wallet:             test.givenWallet,
+				Auditor:            test.givenAuditor,
+			})
+
+			_, err := c.CreateSlip(context.Background(), test.givenSlip)
+			if err == nil {
+				t.Fatalf("expected %v, got nil", test.expectedError)
+			}
+
+			if !cmp.Equal(err, test.expectedError, cmpopts.EquateErrors()) {
+				t.Fatal(cmp.Diff(err, test.expectedError, cmpopts.EquateErrors()))
+			}
+		})
+	}
+}
+
+func TestController_Create_Concurrent(t *testing.T) {
+	tableID := uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce")
+	systemClock := clock.NewFake(placedAt)
+	events := memory.NewEventStore(systemClock)
+	tables := memory.NewTableStorage(events)
+
+	err := tables.Insert(context.Background(), storage.Table{ID: tableID, CreatedAt: placedAt})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := NewController(ControllerParams{
+		RepositoryProvider: NewRepository(memory.NewBetStorage(tables, events, systemClock)),
+		TableRepoProvider:  mockTableRepo{GivenGetTable: domain.Table{ID: tableID}},
+		ExposureLimiter:    slowLimiter{ExposureLimiter: exposure.New(exposure.Limits{"GBP": 3600}, nil)},
+		UnitOfWork:         memory.NewUnitOfWork(),
+		Clock:              systemClock,
+	})
+
+	const attempts = 10
+
+	var wg sync.WaitGroup
+
+	errs := make([]error, attempts)
+
+	for i := 0; i < attempts; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			bet := domain.Bet{ID: uuid.New(), SelectedSpaces: []int{5}, Stake: money.New(100, "GBP"), Table: tableID}
+
+			if i%2 == 0 {
+				_, errs[i] = c.Create(context.Background(), bet)
+				return
+			}
+
+			_, errs[i] = c.CreateSlip(context.Background(), domain.Slip{ID: uuid.New(), Table: tableID, Bets: []domain.Bet{bet}})
+		}(i)
+	}
+
+	wg.Wait()
+
+	var accepted int
+
+	for i := range errs {
+		switch {
+		case errs[i] == nil:
+			accepted++
+		case !errors.Is(errs[i], exposure.ErrLimitExceeded):
+			t.Fatalf("expected %v, got %v", exposure.ErrLimitExceeded, errs[i])
+		}
+	}
+
+	if accepted != 1 {
+		t.Fatalf("expected a single bet within the limit to be accepted, got %v", accepted)
+	}
+}
+
+func TestController_GetSlip(t *testing.T) {
+	tests := []struct {
+		name          string
+		givenBetRepo  RepositoryProvider
+		expectedSlip  domain.Slip
+		expectedError error
+	}{
+		{
+			name: "given a slip, expect it to be returned",
+			givenBetRepo: mockBetRepo{
+				GivenGetSlip: domain.Slip{
+					ID:    uuid.MustParse("8f1f5f5c-7a43-4d0e-a4a8-1b8a2b8f4c11"),
+					Table: uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
+				},
+			},
+			expectedSlip: domain.Slip{
+				ID:    uuid.MustParse("8f1f5f5c-7a43-4d0e-a4a8-1b8a2b8f4c11"),
+				Table: uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
+			},
+		},
+		{
+			name: "given a slip that does not exist, expect the error to be returned",
+			givenBetRepo: mockBetRepo{
+				GivenGetSlipError: memory.ErrInvalidKey,
+			},
+			expectedError: memory.ErrInvalidKey,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := NewController(ControllerParams{
+				RepositoryProvider: test.givenBetRepo,
+				TableRepoProvider:  mockTableRepo{},
+				ExposureLimiter:    mockLimiter{},
+			})
+
+			actual, err := c.GetSlip(context.Background(), uuid.MustParse("8f1f5f5c-7a43-4d0e-a4a8-1b8a2b8f4c11"))
+			if !cmp.Equal(err, test.expectedError, cmpopts.EquateErrors()) {
+				t.Fatal(cmp.Diff(err, test.expectedError, cmpopts.EquateErrors()))
+			}
+
+			if !cmp.Equal(actual, test.expectedSlip) {
+				t.Fatal(cmp.Diff(actual, test.expectedSlip))
+			}
+		})
+	}
+}
+
 type mockBetRepo struct {
-	GivenGetBet      domain.Bet
-	GivenGetError    error
-	GivenInsertError error
+	GivenGetBet          domain.Bet
+	GivenGetError        error
+	GivenGetSlip         domain.Slip
+	GivenGetSlipError    error
+	GivenListBets        []domain.Bet
+	GivenListError       error
+	GivenInsertError     error
+	GivenInsertSlipError error
 }
 
 func (m mockBetRepo) Get(_ context.Context, _ uuid.UUID) (domain.Bet, error) {
 	return m.GivenGetBet, m.GivenGetError
 }
 
+func (m mockBetRepo) List(_ context.Context, _ uuid.UUID) ([]domain.Bet, error) {
+	return m.GivenListBets, m.GivenListError
+}
+
 func (m mockBetRepo) Insert(_ context.Context, _ domain.Bet) error {
 	return m.GivenInsertError
 }
 
+func (m mockBetRepo) GetSlip(_ context.Context, _ uuid.UUID) (domain.Slip, error) {
+	return m.GivenGetSlip, m.GivenGetSlipError
+}
+
+func (m mockBetRepo) InsertSlip(_ context.Context, _ domain.Slip) error {
+	return m.GivenInsertSlipError
+}
+
 type mockTableRepo struct {
 	GivenGetTable domain.Table
 	GivenGetError error
@@ -263,3 +898,53 @@ type mockTableRepo struct {
 func (m mockTableRepo) Get(_ context.Context, _ uuid.UUID) (domain.Table, error) {
 	return m.GivenGetTable, m.GivenGetError
 }
+
+// slowLimiter pauses before each check so that concurrent Bets overlap between being checked and stored.
+type slowLimiter struct {
+	ExposureLimiter
+}
+
+func (s slowLimiter) Check(ctx context.Context, table domain.Table, bet domain.Bet) error {
+	time.Sleep(time.Millisecond)
+
+	return s.ExposureLimiter.Check(ctx, table, bet)
+}
+
+type mockLimiter struct {
+	GivenCheckError error
+}
+
+func (m mockLimiter) Check(_ context.Context, _ domain.Table, _ domain.Bet) error {
+	return m.GivenCheckError
+}
+
+type mockJackpot struct {
+	GivenContribution    *money.Money
+	GivenContributeError error
+}
+
+func (m mockJackpot) Contribute(_ context.Context, bet domain.Bet) (domain.Bet, error) {
+	if m.GivenContributeError != nil {
+		return domain.Bet{}, m.GivenContributeError
+	}
+
+	bet.Contribution = m.GivenContribution
+
+	return bet, nil
+}
+
+type mockWallet struct {
+	GivenError error
+}
+
+func (m mockWallet) Debit(_ context.Context, _ string, _ []*money.Money) error {
+	return m.GivenError
+}
+
+type mockAuditor struct {
+	GivenError error
+}
+
+func (m mockAuditor) Record(_ context.Context, _ domain.AuditAction, _ uuid.UUID, _, _ interface{}) error {
+	return m.GivenError
+}
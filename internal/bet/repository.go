@@ -2,6 +2,8 @@ package bet
 
 import (
 	"betting/internal/domain"
+	"betting/internal/pkg/logging"
+	"betting/internal/pkg/tracing"
 	"betting/storage"
 	"context"
 	"errors"
@@ -9,9 +11,13 @@ import (
 	"github.com/google/uuid"
 )
 
-// ErrTableClosed returned if a Bet is made against a closed Table.
+// Errors returned when placing Bets. ErrTableClosed is returned if a Bet is made against a closed Table and ErrNoJackpot
+// if it is placed with a side bet that is not offered.
 var (
-	ErrTableClosed = errors.New("table is not accepting anymore bets")
+	ErrTableClosed   = errors.New("table is not accepting anymore bets")
+	ErrEmptySlip     = errors.New("slip holds no bets")
+	ErrNoJackpot     = errors.New("jackpot side bet is not offered")
+	ErrFailedToAudit = errors.New("failed to audit bet")
 )
 
 // StorageProvider provides both read and write operations for Bets.
@@ -24,11 +30,18 @@ type StorageProvider interface {
 type StorageReader interface {
 	Get(ctx context.Context, id uuid.UUID) (storage.Bet, error)
 	List(ctx context.Context, id uuid.UUID) ([]storage.Bet, error)
+	ListBySlip(ctx context.Context, id uuid.UUID) ([]storage.Bet, error)
+	ListByTables(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID][]storage.Bet, error)
 }
 
-// StorageWriter provides write operations for Bets.
+// StorageWriter provides write operations for Bets. Insert and InsertSlip must refuse Bets, with storage.ErrTableClosed,
+// once their Table has closed, checking the Table as part of the write so a Bet cannot be stored after the Table's Bets
+// have moved on. InsertSlip stores all of the given Bets or none of them. CarryImprisoned moves the imprisoned Bets of a
+// Table onto another which must still be open.
 type StorageWriter interface {
 	Insert(context.Context, storage.Bet) error
+	InsertSlip(ctx context.Context, bets []storage.Bet) error
+	CarryImprisoned(ctx context.Context, from, to uuid.UUID) error
 	SetWinners(ctx context.Context, bets []storage.Bet) error
 	UpdateStateByTableID(ctx context.Context, id uuid.UUID, status domain.BetStatus) error
 }
@@ -45,15 +58,65 @@ func NewRepository(provider StorageProvider) Repository {
 	}
 }
 
-// Insert creates a Bet in memory.
+// Insert creates a Bet in memory, returning ErrTableClosed if its Table closed after it was read.
 func (r Repository) Insert(ctx context.Context, bet domain.Bet) error {
+	ctx, span := tracing.Start(ctx, "bet.Repository.Insert", tracing.KeyBetID.String(bet.ID.String()), tracing.KeyTableID.String(bet.Table.String()))
+	defer span.End()
+
 	adaptedBet := storage.AdaptBetToStorage(bet)
 
-	return r.StorageProvider.Insert(ctx, adaptedBet)
+	logging.FromContext(ctx).WithField(logging.FieldBetID, bet.ID).Debug("storing bet")
+
+	err := r.StorageProvider.Insert(ctx, adaptedBet)
+	if errors.Is(err, storage.ErrTableClosed) {
+		return ErrTableClosed
+	}
+
+	return err
+}
+
+// InsertSlip creates every Bet of a Slip in memory, returning ErrTableClosed if their Table closed after it was read.
+func (r Repository) InsertSlip(ctx context.Context, slip domain.Slip) error {
+	ctx, span := tracing.Start(ctx, "bet.Repository.InsertSlip",
+		tracing.KeySlipID.String(slip.ID.String()),
+		tracing.KeyTableID.String(slip.Table.String()),
+	)
+	defer span.End()
+
+	bets := storage.AdaptBetsToStorage(slip.Bets)
+
+	logging.FromContext(ctx).WithField(logging.FieldSlipID, slip.ID).Debug("storing slip")
+
+	err := r.StorageProvider.InsertSlip(ctx, bets)
+	if errors.Is(err, storage.ErrTableClosed) {
+		return ErrTableClosed
+	}
+
+	return err
+}
+
+// GetSlip retrieves the Slip for a given ID with its Bets.
+func (r Repository) GetSlip(ctx context.Context, id uuid.UUID) (domain.Slip, error) {
+	ctx, span := tracing.Start(ctx, "bet.Repository.GetSlip", tracing.KeySlipID.String(id.String()))
+	defer span.End()
+
+	bets, err := r.StorageProvider.ListBySlip(ctx, id)
+	if err != nil {
+		return domain.Slip{}, err
+	}
+
+	return domain.Slip{
+		ID:    id,
+		Table: bets[0].Table,
+		Bets:  storage.AdaptBetsToDomain(bets),
+	}, nil
 }
 
 // Get retrieves a Bet for a given ID.
 func (r Repository) Get(ctx context.Context, id uuid.UUID) (domain.Bet, error) {
+	ctx, span := tracing.Start(ctx, "bet.Repository.Get", tracing.KeyBetID.String(id.String()))
+	defer span.End()
+
 	bet, err := r.StorageProvider.Get(ctx, id)
 	if err != nil {
 		return domain.Bet{}, err
@@ -62,7 +125,11 @@ func (r Repository) Get(ctx context.Context, id uuid.UUID) (domain.Bet, error) {
 	return storage.AdaptBetToDomain(bet), nil
 }
 
+// List retrieves all Bets for a given Table.
 func (r Repository) List(ctx context.Context, id uuid.UUID) ([]domain.Bet, error) {
+	ctx, span := tracing.Start(ctx, "bet.Repository.List", tracing.KeyTableID.String(id.String()))
+	defer span.End()
+
 	bets, err := r.StorageProvider.List(ctx, id)
 	if err != nil {
 		return nil, err
@@ -71,19 +138,63 @@ func (r Repository) List(ctx context.Context, id uuid.UUID) ([]domain.Bet, error
 	return storage.AdaptBetsToDomain(bets), nil
 }
 
+// ListByTables retrieves the Bets for each of the given Tables in a single call, keyed by Table ID.
+func (r Repository) ListByTables(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID][]domain.Bet, error) {
+	ctx, span := tracing.Start(ctx, "bet.Repository.ListByTables", tracing.KeyTableCount.Int(len(ids)))
+	defer span.End()
+
+	bets, err := r.StorageProvider.ListByTables(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	domainBets := make(map[uuid.UUID][]domain.Bet, len(bets))
+
+	for id := range bets {
+		domainBets[id] = storage.AdaptBetsToDomain(bets[id])
+	}
+
+	return domainBets, nil
+}
+
 // SetWinners adapts from domain to storage and sets the winners of a given Table if any.
 func (r Repository) SetWinners(ctx context.Context, bets []domain.Bet) error {
+	ctx, span := tracing.Start(ctx, "bet.Repository.SetWinners", tracing.KeyBetCount.Int(len(bets)))
+	defer span.End()
+
 	b := storage.AdaptBetsToStorage(bets)
 
+	logging.FromContext(ctx).WithField("bets", len(b)).Debug("storing winners")
+
 	return r.StorageProvider.SetWinners(ctx, b)
 }
 
 // Spin sets all Bets for a given Table to live.
 func (r Repository) Spin(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "bet.Repository.Spin", tracing.KeyTableID.String(id.String()))
+	defer span.End()
+
+	logging.FromContext(ctx).WithField(logging.FieldTableID, id).Debug("storing live bets")
+
 	return r.StorageProvider.UpdateStateByTableID(ctx, id, domain.Live)
 }
 
 // Settle sets all Bets for a given Table to settled.
 func (r Repository) Settle(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "bet.Repository.Settle", tracing.KeyTableID.String(id.String()))
+	defer span.End()
+
+	logging.FromContext(ctx).WithField(logging.FieldTableID, id).Debug("storing settled bets")
+
 	return r.StorageProvider.UpdateStateByTableID(ctx, id, domain.Settled)
 }
+
+// Carry moves the imprisoned Bets of the Table from onto the Table to, where they are resolved.
+func (r Repository) Carry(ctx context.Context, from, to uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "bet.Repository.Carry", tracing.KeyTableID.String(to.String()))
+	defer span.End()
+
+	logging.FromContext(ctx).WithField(logging.FieldTableID, to).WithField("previous", from).Debug("carrying imprisoned bets")
+
+	return r.StorageProvider.CarryImprisoned(ctx, from, to)
+}
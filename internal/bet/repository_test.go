@@ -63,6 +63,181 @@ func TestRepository_Insert_Success(t *testing.T) {
 	}
 }
 
+func TestRepository_Insert_Fail(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenBetStorage *mockBetStorage
+		expectedError   error
+	}{
+		{
+			name: "given the table closed before the write, expect ErrTableClosed",
+			givenBetStorage: &mockBetStorage{
+				GivenInsertError: storage.ErrTableClosed,
+			},
+			expectedError: ErrTableClosed,
+		},
+		{
+			name: "given a storage error, expect it to be returned",
+			givenBetStorage: &mockBetStorage{
+				GivenInsertError: memory.ErrDuplicateKey,
+			},
+			expectedError: memory.ErrDuplicateKey,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repo := NewRepository(test.givenBetStorage)
+
+			err := repo.Insert(context.Background(), domain.Bet{})
+
+			if !cmp.Equal(err, test.expectedError, cmpopts.EquateErrors()) {
+				t.Fatal(cmp.Diff(err, test.expectedError, cmpopts.EquateErrors()))
+			}
+		})
+	}
+}
+
+func TestRepository_InsertSlip_Success(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenSlip       domain.Slip
+		givenBetStorage *mockBetStorage
+		expectedBets    []storage.Bet
+	}{
+		{
+			name: "given a slip, expect each of its bets to be stored together",
+			givenSlip: domain.Slip{
+				ID:    uuid.MustParse("8f1f5f5c-7a43-4d0e-a4a8-1b8a2b8f4c11"),
+				Table: uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
+				Bets: []domain.Bet{
+					{
+						ID:     uuid.MustParse("c4b39dc0-2ff4-4405-b3cb-c4f87a9c82fb"),
+						Status: domain.Unsettled,
+						Table:  uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
+						Slip:   uuid.MustParse("8f1f5f5c-7a43-4d0e-a4a8-1b8a2b8f4c11"),
+					},
+				},
+			},
+			givenBetStorage: &mockBetStorage{},
+			expectedBets: []storage.Bet{
+				{
+					ID:     uuid.MustParse("c4b39dc0-2ff4-4405-b3cb-c4f87a9c82fb"),
+					Status: domain.Unsettled.String(),
+					Table:  uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
+					Slip:   uuid.MustParse("8f1f5f5c-7a43-4d0e-a4a8-1b8a2b8f4c11"),
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repo := NewRepository(test.givenBetStorage)
+
+			err := repo.InsertSlip(context.Background(), test.givenSlip)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(test.givenBetStorage.SpyInsertSlipBets, test.expectedBets) {
+				t.Fatal(cmp.Diff(test.givenBetStorage.SpyInsertSlipBets, test.expectedBets))
+			}
+		})
+	}
+}
+
+func TestRepository_InsertSlip_Fail(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenBetStorage *mockBetStorage
+		expectedError   error
+	}{
+		{
+			name: "given the table closed before the write, expect ErrTableClosed",
+			givenBetStorage: &mockBetStorage{
+				GivenInsertSlipError: storage.ErrTableClosed,
+			},
+			expectedError: ErrTableClosed,
+		},
+		{
+			name: "given a storage error, expect it to be returned",
+			givenBetStorage: &mockBetStorage{
+				GivenInsertSlipError: memory.ErrDuplicateKey,
+			},
+			expectedError: memory.ErrDuplicateKey,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repo := NewRepository(test.givenBetStorage)
+
+			err := repo.InsertSlip(context.Background(), domain.Slip{})
+
+			if !cmp.Equal(err, test.expectedError, cmpopts.EquateErrors()) {
+				t.Fatal(cmp.Diff(err, test.expectedError, cmpopts.EquateErrors()))
+			}
+		})
+	}
+}
+
+func TestRepository_GetSlip_Success(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenID         uuid.UUID
+		givenBetStorage *mockBetStorage
+		expectedSlip    domain.Slip
+	}{
+		{
+			name:    "given a slip, expect it to be adapted with its bets",
+			givenID: uuid.MustParse("8f1f5f5c-7a43-4d0e-a4a8-1b8a2b8f4c11"),
+			givenBetStorage: &mockBetStorage{
+				GivenListBySlipBets: []storage.Bet{
+					{
+						ID:     uuid.MustParse("c4b39dc0-2ff4-4405-b3cb-c4f87a9c82fb"),
+						Status: domain.Live.String(),
+						Table:  uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
+						Slip:   uuid.MustParse("8f1f5f5c-7a43-4d0e-a4a8-1b8a2b8f4c11"),
+					},
+				},
+			},
+			expectedSlip: domain.Slip{
+				ID:    uuid.MustParse("8f1f5f5c-7a43-4d0e-a4a8-1b8a2b8f4c11"),
+				Table: uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
+				Bets: []domain.Bet{
+					{
+						ID:     uuid.MustParse("c4b39dc0-2ff4-4405-b3cb-c4f87a9c82fb"),
+						Status: domain.Live,
+						Table:  uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
+						Slip:   uuid.MustParse("8f1f5f5c-7a43-4d0e-a4a8-1b8a2b8f4c11"),
+					},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repo := NewRepository(test.givenBetStorage)
+
+			actual, err := repo.GetSlip(context.Background(), test.givenID)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(actual, test.expectedSlip) {
+				t.Fatal(cmp.Diff(actual, test.expectedSlip))
+			}
+		})
+	}
+}
+
+func TestRepository_GetSlip_Fail(t *testing.T) {
+	repo := NewRepository(&mockBetStorage{GivenListBySlipError: memory.ErrInvalidKey})
+
+	_, err := repo.GetSlip(context.Background(), uuid.MustParse("8f1f5f5c-7a43-4d0e-a4a8-1b8a2b8f4c11"))
+	if !cmp.Equal(err, memory.ErrInvalidKey, cmpopts.EquateErrors()) {
+		t.Fatal(cmp.Diff(err, memory.ErrInvalidKey, cmpopts.EquateErrors()))
+	}
+}
+
 func TestRepository_Get_Success(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -231,6 +406,86 @@ func TestRepository_List_Fail(t *testing.T) {
 	}
 }
 
+func TestRepository_ListByTables_Success(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenTableIDs   []uuid.UUID
+		givenBetStorage *mockBetStorage
+		expectedBets    map[uuid.UUID][]domain.Bet
+	}{
+		{
+			name:          "given ids, expect the bets to be returned keyed by table",
+			givenTableIDs: []uuid.UUID{uuid.MustParse("c4b39dc0-2ff4-4405-b3cb-c4f87a9c82fb")},
+			givenBetStorage: &mockBetStorage{
+				GivenListByTablesBets: map[uuid.UUID][]storage.Bet{
+					uuid.MustParse("c4b39dc0-2ff4-4405-b3cb-c4f87a9c82fb"): {
+						{
+							ID:     uuid.MustParse("68c094d3-ae37-4e4d-a533-8701dc1d7e5c"),
+							Status: "live",
+							Table:  uuid.MustParse("c4b39dc0-2ff4-4405-b3cb-c4f87a9c82fb"),
+						},
+					},
+				},
+			},
+			expectedBets: map[uuid.UUID][]domain.Bet{
+				uuid.MustParse("c4b39dc0-2ff4-4405-b3cb-c4f87a9c82fb"): {
+					{
+						ID:     uuid.MustParse("68c094d3-ae37-4e4d-a533-8701dc1d7e5c"),
+						Status: domain.Live,
+						Table:  uuid.MustParse("c4b39dc0-2ff4-4405-b3cb-c4f87a9c82fb"),
+					},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repo := NewRepository(test.givenBetStorage)
+
+			actual, err := repo.ListByTables(context.Background(), test.givenTableIDs)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(actual, test.expectedBets) {
+				t.Fatal(cmp.Diff(actual, test.expectedBets))
+			}
+		})
+	}
+}
+
+func TestRepository_ListByTables_Fail(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenTableIDs   []uuid.UUID
+		givenBetStorage *mockBetStorage
+		expectedError   error
+	}{
+		{
+			name:          "given a storage error, expect it to be returned",
+			givenTableIDs: []uuid.UUID{uuid.MustParse("c4b39dc0-2ff4-4405-b3cb-c4f87a9c82fb")},
+			givenBetStorage: &mockBetStorage{
+				GivenListError: memory.ErrInvalidKey,
+			},
+			expectedError: memory.ErrInvalidKey,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repo := NewRepository(test.givenBetStorage)
+
+			_, err := repo.ListByTables(context.Background(), test.givenTableIDs)
+			if err == nil {
+				t.Fatalf("expected %v, got nil", test.expectedError)
+			}
+
+			if !cmp.Equal(err, test.expectedError, cmpopts.EquateErrors()) {
+				t.Fatal(cmp.Diff(err, test.expectedError, cmpopts.EquateErrors()))
+			}
+		})
+	}
+}
+
 func TestRepository_Spin_Success(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -279,6 +534,37 @@ func TestRepository_Settle_Success(t *testing.T) {
 	}
 }
 
+func TestRepository_Carry_Fail(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenBetStorage *mockBetStorage
+		expectedError   error
+	}{
+		{
+			name: "given the table carried to has closed, expect it to be returned",
+			givenBetStorage: &mockBetStorage{
+				GivenCarryError: storage.ErrTableClosed,
+			},
+			expectedError: storage.ErrTableClosed,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repo := NewRepository(test.givenBetStorage)
+
+			err := repo.Carry(
+				context.Background(),
+				uuid.MustParse("c4b39dc0-2ff4-4405-b3cb-c4f87a9c82fb"),
+				uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
+			)
+
+			if !cmp.Equal(err, test.expectedError, cmpopts.EquateErrors()) {
+				t.Fatal(cmp.Diff(err, test.expectedError, cmpopts.EquateErrors()))
+			}
+		})
+	}
+}
+
 func TestRepository_SetWinners_Success(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -308,10 +594,16 @@ type mockBetStorage struct {
 	GivenGetError         error
 	GivenListBets         []storage.Bet
 	GivenListError        error
+	GivenListByTablesBets map[uuid.UUID][]storage.Bet
+	GivenListBySlipBets   []storage.Bet
+	GivenListBySlipError  error
 	GivenInsertError      error
+	GivenInsertSlipError  error
 	GivenSetWinnersError  error
 	GivenUpdateStateError error
+	GivenCarryError       error
 	SpyInsertBet          storage.Bet
+	SpyInsertSlipBets     []storage.Bet
 }
 
 func (m *mockBetStorage) Get(_ context.Context, _ uuid.UUID) (storage.Bet, error) {
@@ -322,12 +614,30 @@ func (m *mockBetStorage) List(_ context.Context, _ uuid.UUID) ([]storage.Bet, er
 	return m.GivenListBets, m.GivenListError
 }
 
+func (m *mockBetStorage) ListByTables(_ context.Context, _ []uuid.UUID) (map[uuid.UUID][]storage.Bet, error) {
+	return m.GivenListByTablesBets, m.GivenListError
+}
+
+func (m *mockBetStorage) CarryImprisoned(_ context.Context, _, _ uuid.UUID) error {
+	return m.GivenCarryError
+}
+
 func (m *mockBetStorage) Insert(_ context.Context, bet storage.Bet) error {
 	m.SpyInsertBet = bet
 
 	return m.GivenInsertError
 }
 
+func (m *mockBetStorage) ListBySlip(_ context.Context, _ uuid.UUID) ([]storage.Bet, error) {
+	return m.GivenListBySlipBets, m.GivenListBySlipError
+}
+
+func (m *mockBetStorage) InsertSlip(_ context.Context, bets []storage.Bet) error {
+	m.SpyInsertSlipBets = bets
+
+	return m.GivenInsertSlipError
+}
+
 func (m *mockBetStorage) SetWinners(_ context.Context, _ []storage.Bet) error {
 	return m.GivenSetWinnersError
 }
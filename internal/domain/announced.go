@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Rhymond/go-money"
+)
+
+// ErrInvalidAnnouncement is returned when an announced Bet cannot be expanded into chips.
+var ErrInvalidAnnouncement = errors.New("announced bet is not valid")
+
+// Call names an announced Bet, one made on a section of the wheel rather than of the table layout.
+type Call string
+
+// String allows Call to have a string representation.
+func (c Call) String() string {
+	return string(c)
+}
+
+// Available Calls.
+var (
+	VoisinsDuZero   Call = "voisins"
+	TiersDuCylindre Call = "tiers"
+	Orphelins       Call = "orphelins"
+	NeighboursOf    Call = "neighbours"
+)
+
+// Announcement is the Call a player announces in place of selecting spaces. Number and Neighbours are only used by
+// NeighboursOf, which covers Number and the given count of positions either side of it on the wheel.
+type Announcement struct {
+	Call       Call
+	Number     int
+	Neighbours int
+}
+
+// The chips each section of the wheel is made of on the table layout, a chip repeated is staked twice.
+var (
+	voisinsDuZero = [][]int{
+		{0, 2, 3}, {0, 2, 3}, {4, 7}, {12, 15}, {18, 21}, {19, 22}, {25, 26, 28, 29}, {25, 26, 28, 29}, {32, 35},
+	}
+	tiersDuCylindre = [][]int{{5, 8}, {10, 11}, {13, 16}, {23, 24}, {27, 30}, {33, 36}}
+	orphelins       = [][]int{{1}, {6, 9}, {14, 17}, {17, 20}, {31, 34}}
+)
+
+// Placements expands the Announcement into its chips, each staked with unit.
+func (a Announcement) Placements(unit *money.Money) ([]Placement, error) {
+	var chips [][]int
+
+	switch a.Call {
+	case VoisinsDuZero:
+		chips = voisinsDuZero
+	case TiersDuCylindre:
+		chips = tiersDuCylindre
+	case Orphelins:
+		chips = orphelins
+	case NeighboursOf:
+		if a.Number < 0 || a.Number >= Pockets || a.Neighbours < 1 || 2*a.Neighbours+1 > Pockets {
+			return nil, fmt.Errorf("%v neighbours of %v: %w", a.Neighbours, a.Number, ErrInvalidAnnouncement)
+		}
+
+		for _, position := range Neighbours(a.Number, a.Neighbours) {
+			chips = append(chips, []int{position})
+		}
+	default:
+		return nil, fmt.Errorf("%v: %w", a.Call, ErrInvalidAnnouncement)
+	}
+
+	placements := make([]Placement, len(chips))
+
+	for i := range chips {
+		placements[i] = Placement{
+			SelectedSpaces: append([]int(nil), chips[i]...),
+			Stake:          unit,
+		}
+	}
+
+	return placements, nil
+}
@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Errors returned verifying a chain of AuditEntries.
+var (
+	ErrAuditTampered = errors.New("audit entry does not match its hash")
+	ErrAuditBroken   = errors.New("audit entry does not follow the one before it")
+)
+
+// AuditAction is the state-changing call an AuditEntry records.
+type AuditAction string
+
+// String allows AuditAction to have a string representation.
+func (a AuditAction) String() string {
+	return string(a)
+}
+
+// Available options for AuditAction.
+const (
+	CreateTable AuditAction = "table.create"
+	SpinTable   AuditAction = "table.spin"
+	SettleTable AuditAction = "table.settle"
+	PlaceBet    AuditAction = "bet.create"
+	PlaceSlip   AuditAction = "slip.create"
+)
+
+// Change is a field of the Subject of an AuditEntry as it was before and after the call, each JSON encoded. Before is
+// empty for a field of a Subject the call created.
+type Change struct {
+	Field  string
+	Before string
+	After  string
+}
+
+// AuditEntry records a single state-changing call: who made it and when, the route and the digest of the body it was
+// made with and the Changes it made to its Subject, the Table, Bet or Slip acted on. Entries are numbered in Sequence
+// from 1 and each holds the Hash of the one before it, so altering, removing or reordering an entry breaks the chain.
+type AuditEntry struct {
+	Sequence     int64
+	Action       AuditAction
+	Subject      uuid.UUID
+	Actor        string
+	RequestID    string
+	Route        string
+	BodyHash     string
+	RecordedAt   time.Time
+	Changes      []Change
+	PreviousHash string
+	Hash         string
+}
+
+// Digest returns the hex encoded SHA-256 digest of every field of the AuditEntry but its Hash, which it is to be set to.
+func (e AuditEntry) Digest() string {
+	h := sha256.New()
+
+	fmt.Fprintf(h, "%d\n%q\n%q\n%q\n%q\n%q\n%q\n%q\n%q\n",
+		e.Sequence,
+		e.PreviousHash,
+		e.Action,
+		e.Subject,
+		e.Actor,
+		e.RequestID,
+		e.Route,
+		e.BodyHash,
+		e.RecordedAt.UTC().Format(time.RFC3339Nano),
+	)
+
+	for i := range e.Changes {
+		fmt.Fprintf(h, "%q\n%q\n%q\n", e.Changes[i].Field, e.Changes[i].Before, e.Changes[i].After)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Follows reports whether the AuditEntry is the one recorded straight after previous.
+func (e AuditEntry) Follows(previous AuditEntry) bool {
+	return e.Sequence == previous.Sequence+1 && e.PreviousHash == previous.Hash
+}
+
+// VerifyAudit checks that each of the given consecutive AuditEntries matches its Hash and follows the one before it.
+// The first entry of the chain, at Sequence 1, must follow no entry at all, a later one starts the given entries on
+// trust. The Sequence of the first entry found wanting is returned with the error.
+func VerifyAudit(entries []AuditEntry) error {
+	var previous AuditEntry
+
+	for i := range entries {
+		if entries[i].Hash != entries[i].Digest() {
+			return fmt.Errorf("entry %d: %w", entries[i].Sequence, ErrAuditTampered)
+		}
+
+		if i > 0 || entries[i].Sequence == 1 {
+			if !entries[i].Follows(previous) {
+				return fmt.Errorf("entry %d: %w", entries[i].Sequence, ErrAuditBroken)
+			}
+		}
+
+		previous = entries[i]
+	}
+
+	return nil
+}
+
+// AuditQuery narrows the AuditEntries listed to those of a Subject or made by an Actor, when given, recorded after the
+// Sequence After. At most Limit entries are returned, in Sequence.
+type AuditQuery struct {
+	Subject uuid.UUID
+	Actor   string
+	After   int64
+	Limit   int
+}
+
+// AuditPage is a page of AuditEntries, Next is the Sequence the following page continues after, zero when there is none.
+type AuditPage struct {
+	Entries []AuditEntry
+	Next    int64
+}
@@ -1,22 +1,61 @@
 package domain
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/Rhymond/go-money"
 	"github.com/google/uuid"
 )
 
-// Bet represents an individuals single pot for a single Table.
+// Bet represents an individuals single pot for a single Table. Slip is the ID of the Slip the Bet was placed with, or
+// uuid.Nil when it was placed alone. An announced Bet is made of Placements, its SelectedSpaces are every position they
+// cover and its Stake their total. Refund is the part of the Stake returned to a losing Bet under the Rules of its Table
+// and ImprisonedOn the Table an even money Bet was imprisoned on, if any. A Bet placed with the Jackpot side bet paid
+// Contribution into its Pool and is paid JackpotWin should it win on the Table triggering the Jackpot.
 type Bet struct {
 	ID             uuid.UUID
 	Status         BetStatus
 	SelectedSpaces []int
 	Stake          *money.Money
+	Announcement   *Announcement
+	Placements     []Placement
 	PlacedAt       time.Time
 	SettledAt      *time.Time
 	Win            bool
+	Refund         *money.Money
+	ImprisonedOn   uuid.UUID
 	Table          uuid.UUID
+	Slip           uuid.UUID
+	Jackpot        bool
+	Contribution   *money.Money
+	JackpotWin     *money.Money
+	Version        int64
+}
+
+// ErrInvalidStake is returned when a Bet, or a chip of it, is not staked a positive amount.
+var ErrInvalidStake = errors.New("stake must be a positive amount")
+
+// ValidateStake returns ErrInvalidStake unless the Bet and each of its Placements are staked a positive amount.
+func (b Bet) ValidateStake() error {
+	stakes := []*money.Money{b.Stake}
+
+	for i := range b.Placements {
+		stakes = append(stakes, b.Placements[i].Stake)
+	}
+
+	for _, stake := range stakes {
+		if stake == nil {
+			return fmt.Errorf("no stake: %w", ErrInvalidStake)
+		}
+
+		if !stake.IsPositive() {
+			return fmt.Errorf("%v: %w", stake.Display(), ErrInvalidStake)
+		}
+	}
+
+	return nil
 }
 
 type BetStatus string
@@ -29,4 +68,6 @@ var (
 	Unsettled BetStatus = "unsettled"
 	Live      BetStatus = "live"
 	Settled   BetStatus = "settled"
+	// Imprisoned Bets have lost to zero under EnPrison, their Stake is held until they are resolved on the next Table.
+	Imprisoned BetStatus = "imprisoned"
 )
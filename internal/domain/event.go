@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventType is the fact an Event records about a Table or its Bets.
+type EventType string
+
+// String allows EventType to have a string representation.
+func (e EventType) String() string {
+	return string(e)
+}
+
+// Available options for EventType.
+const (
+	TableOpened  EventType = "TableOpened"
+	BetPlaced    EventType = "BetPlaced"
+	BetsCarried  EventType = "BetsCarried"
+	TableClosed  EventType = "TableClosed"
+	BetsMarked   EventType = "BetsMarked"
+	OutcomeDrawn EventType = "OutcomeDrawn"
+	TableSettled EventType = "TableSettled"
+	BetsSettled  EventType = "BetsSettled"
+)
+
+// Event is a single fact recorded against the Stream of a Table, numbered in Sequence from 1 in the order they were
+// recorded. Tables and their Bets are not written in place, each write appends an Event and the current state of both is
+// the projection of every Event in turn. Data holds the JSON encoded detail of the Event, which depends on its Type.
+type Event struct {
+	Stream     uuid.UUID
+	Sequence   int64
+	Type       EventType
+	RecordedAt time.Time
+	Data       json.RawMessage
+}
@@ -0,0 +1,26 @@
+package domain
+
+import "time"
+
+// ExportRecords is the kind of record an export is made of.
+type ExportRecords string
+
+// String allows ExportRecords to have a string representation.
+func (e ExportRecords) String() string {
+	return string(e)
+}
+
+// Available options for ExportRecords.
+const (
+	TableRecords   ExportRecords = "tables"
+	BetRecords     ExportRecords = "bets"
+	OutcomeRecords ExportRecords = "outcomes"
+)
+
+// ExportQuery selects the Tables exported, those created at or after CreatedFrom and before CreatedTo, and the Records
+// written for each of them.
+type ExportQuery struct {
+	Records     ExportRecords
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+}
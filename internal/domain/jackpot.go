@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"errors"
+
+	"github.com/Rhymond/go-money"
+	"github.com/google/uuid"
+)
+
+// ErrInvalidJackpotRules is returned when the Jackpot is configured with JackpotRules which cannot be played.
+var ErrInvalidJackpotRules = errors.New("jackpot rules are not valid")
+
+// The percentage of a side bet's Stake paid into the Jackpot and the number of rounds in a row its trigger looks at.
+const (
+	MaxJackpotPercent = 100
+	MinJackpotRepeats = 2
+)
+
+// Jackpot is the progressive pool shared by every Table, fed by the side bets placed alongside Bets. Pool holds the
+// total of each currency, in order of currency code, and Awards every payout made from it in the order they were made.
+type Jackpot struct {
+	Pool   []*money.Money
+	Awards []JackpotAward
+}
+
+// JackpotAward is the share of the Pool paid to a Bet on the Table which triggered the Jackpot.
+type JackpotAward struct {
+	Bet    uuid.UUID
+	Table  uuid.UUID
+	Amount *money.Money
+}
+
+// JackpotRules configure the Jackpot, Percent of the Stake of every side bet is paid into the Pool and it is triggered
+// when Repeats rounds in a row of a Table land on the same number.
+type JackpotRules struct {
+	Percent int64
+	Repeats int
+}
+
+// Validate returns ErrInvalidJackpotRules unless Percent is a percentage and the trigger spans at least
+// MinJackpotRepeats rounds.
+func (r JackpotRules) Validate() error {
+	if r.Percent < 0 || r.Percent > MaxJackpotPercent || r.Repeats < MinJackpotRepeats {
+		return ErrInvalidJackpotRules
+	}
+
+	return nil
+}
+
+// Contribute returns the part of the given Stake paid into the Pool, fractions of the minor unit are left out of it.
+func (r JackpotRules) Contribute(stake *money.Money) *money.Money {
+	return money.New(stake.Amount()*r.Percent/100, stake.Currency().Code)
+}
+
+// Triggered reports whether the given rounds of a Table, the latest first, trigger the Jackpot. The first Repeats of
+// them must each have been spun with a single ball and landed on the same number.
+func (r JackpotRules) Triggered(rounds []Table) bool {
+	if len(rounds) < r.Repeats {
+		return false
+	}
+
+	for i := 0; i < r.Repeats; i++ {
+		if len(rounds[i].Outcomes) != 1 || rounds[i].Outcomes[0].Value != rounds[0].Outcomes[0].Value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Shares divides the Pool between the winning Bets with a side bet, each currency is split in proportion to what the
+// Bets staked in it contributed. Fractions of the minor unit stay in the Pool, as does any currency no Bet is owed.
+func (j Jackpot) Shares(bets []Bet) []JackpotAward {
+	var awards []JackpotAward
+
+	for _, pool := range j.Pool {
+		var contributed int64
+
+		for i := range bets {
+			if bets[i].sharesIn(pool) {
+				contributed += bets[i].Contribution.Amount()
+			}
+		}
+
+		if contributed == 0 {
+			continue
+		}
+
+		for i := range bets {
+			if !bets[i].sharesIn(pool) {
+				continue
+			}
+
+			amount := pool.Amount() * bets[i].Contribution.Amount() / contributed
+			if amount == 0 {
+				continue
+			}
+
+			awards = append(awards, JackpotAward{
+				Bet:    bets[i].ID,
+				Table:  bets[i].Table,
+				Amount: money.New(amount, pool.Currency().Code),
+			})
+		}
+	}
+
+	return awards
+}
+
+// sharesIn reports whether the Bet won with a side bet contributing to the Pool of the given currency.
+func (b Bet) sharesIn(pool *money.Money) bool {
+	return b.Win && b.Contribution != nil && b.Contribution.SameCurrency(pool)
+}
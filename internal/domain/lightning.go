@@ -0,0 +1,60 @@
+package domain
+
+// The Multipliers struck on a Lightning Table before each spin, every one a multiple of MultiplierStep.
+const (
+	MinMultipliers = 1
+	MaxMultipliers = 5
+	MinMultiplier  = 50
+	MaxMultiplier  = 500
+	MultiplierStep = 50
+)
+
+// lightningStraightUp is the reduced odds a straight up is paid at on a Lightning Table when its number was not struck.
+const lightningStraightUp = 29
+
+// Multiplier is a number struck by Lightning, a straight up on Position is paid Factor to 1 when the ball lands there.
+type Multiplier struct {
+	Position int
+	Factor   int
+}
+
+// multiplier returns the Factor of the Multiplier striking the position, or zero. Until a Lightning Table is spun its
+// Multipliers are yet to be drawn so every position may be struck at MaxMultiplier.
+func (t Table) multiplier(position int) int64 {
+	if t.Rules.Variant != Lightning {
+		return 0
+	}
+
+	if !t.IsSpun() {
+		return MaxMultiplier
+	}
+
+	for i := range t.Multipliers {
+		if t.Multipliers[i].Position == position {
+			return int64(t.Multipliers[i].Factor)
+		}
+	}
+
+	return 0
+}
+
+// struck returns the winnings, in minor units, the Multiplier on the position adds to each straight up chip of the Bet
+// covering it, on top of what the paytable of the Rules pays.
+func (t Table) struck(bet Bet, position int) int64 {
+	factor := t.multiplier(position)
+	if factor == 0 {
+		return 0
+	}
+
+	var amount int64
+
+	for _, chip := range bet.Chips() {
+		if chip.Stake == nil || chip.Coverage() != 1 || !chip.Covers(position) {
+			continue
+		}
+
+		amount += chip.Stake.Amount() * (factor - lightningStraightUp)
+	}
+
+	return amount
+}
@@ -0,0 +1,117 @@
+package domain
+
+import "github.com/Rhymond/go-money"
+
+// Pockets is the number of positions on the wheel, 0 to 36.
+const Pockets = 37
+
+// payoutBase is the number of pockets payouts are calculated against, the zero is excluded which gives the house its
+// edge.
+const payoutBase = 36
+
+// Placement is a single chip of a Bet, a Stake on one or more positions paid as though it were placed alone. Win is set
+// when the chip is paid winnings by the Outcomes.
+type Placement struct {
+	SelectedSpaces []int
+	Stake          *money.Money
+	Win            bool
+}
+
+// Covers reports whether the Bet wins when the ball lands on the given position.
+func (b Bet) Covers(position int) bool {
+	return covers(b.SelectedSpaces, position)
+}
+
+// Chips returns the Placements the Bet is made of, a Bet without Placements is a single chip of its Stake on its
+// SelectedSpaces.
+func (b Bet) Chips() []Placement {
+	if len(b.Placements) > 0 {
+		return b.Placements
+	}
+
+	return []Placement{{SelectedSpaces: b.SelectedSpaces, Stake: b.Stake, Win: b.Win}}
+}
+
+// Liability returns what the house owes the Bet should the balls land on the given positions: what the paytable of the
+// Rules pays each chip for the balls it caught, less the stakes taken from chips catching none. A negative Liability is
+// a profit for the house.
+func (b Bet) Liability(rules Rules, positions ...int) *money.Money {
+	if b.Stake == nil {
+		return nil
+	}
+
+	var amount int64
+
+	for _, chip := range b.Chips() {
+		amount += chip.Liability(rules, positions...)
+	}
+
+	return money.New(amount, b.Stake.Currency().Code)
+}
+
+// Covers reports whether the Placement wins when the ball lands on the given position.
+func (p Placement) Covers(position int) bool {
+	return covers(p.SelectedSpaces, position)
+}
+
+// Coverage returns the number of distinct positions on the wheel the Placement covers.
+func (p Placement) Coverage() int {
+	seen := make(map[int]bool, len(p.SelectedSpaces))
+
+	for i := range p.SelectedSpaces {
+		space := p.SelectedSpaces[i]
+		if space < 0 || space >= Pockets {
+			continue
+		}
+
+		seen[space] = true
+	}
+
+	return len(seen)
+}
+
+// Liability returns what the house owes the Placement, in minor units, should the balls land on the given positions.
+func (p Placement) Liability(rules Rules, positions ...int) int64 {
+	n := int64(p.Coverage())
+	if p.Stake == nil || n == 0 {
+		return 0
+	}
+
+	return rules.pays(p.Stake.Amount(), n, p.hits(positions))
+}
+
+// Wins reports whether the paytable of the Rules pays the Placement winnings should the balls land on the given
+// positions, whatever its Stake.
+func (p Placement) Wins(rules Rules, positions ...int) bool {
+	n := int64(p.Coverage())
+	if n == 0 {
+		return false
+	}
+
+	numerator, _ := rules.odds(n, p.hits(positions))
+
+	return numerator > 0
+}
+
+// hits returns the number of the given positions the Placement covers.
+func (p Placement) hits(positions []int) int64 {
+	var hits int64
+
+	for i := range positions {
+		if p.Covers(positions[i]) {
+			hits++
+		}
+	}
+
+	return hits
+}
+
+func covers(spaces []int, position int) bool {
+	for i := range spaces {
+		if spaces[i] == position {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TableState is the stage of its lifecycle a Table is in.
+type TableState string
+
+// String allows TableState to have a string representation.
+func (t TableState) String() string {
+	return string(t)
+}
+
+// Available options for TableState.
+var (
+	Open   TableState = "open"
+	Closed TableState = "closed"
+)
+
+// SortOrder is the direction in which Tables are ordered by their creation time.
+type SortOrder string
+
+// String allows SortOrder to have a string representation.
+func (s SortOrder) String() string {
+	return string(s)
+}
+
+// Available options for SortOrder.
+var (
+	Ascending  SortOrder = "asc"
+	Descending SortOrder = "desc"
+)
+
+// TableCursor identifies the position of a Table within an ordered listing.
+type TableCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+// TableQuery narrows, orders and pages the Tables returned from a listing. Zero values apply no restriction.
+type TableQuery struct {
+	State       TableState
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	Colour      Colour
+	Order       SortOrder
+	After       *TableCursor
+	Limit       int
+	OmitBets    bool
+}
+
+// TablePage is a single page of a Table listing, Next is set when more Tables follow.
+type TablePage struct {
+	Tables []Table
+	Next   *TableCursor
+}
@@ -0,0 +1,218 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Rhymond/go-money"
+	"github.com/google/uuid"
+)
+
+// ErrInvalidRules is returned when a Table is given Rules it does not know.
+var ErrInvalidRules = errors.New("table rules are not valid")
+
+// evenMoneyCoverage is the number of positions covered by an even money Bet, such as red, odd or 1 to 18.
+const evenMoneyCoverage = 18
+
+// The number of wheels a MultiWheel Table may spin.
+const (
+	MinWheels = 2
+	MaxWheels = 8
+)
+
+// ZeroRule decides what becomes of an even money Bet when the ball lands on zero.
+type ZeroRule string
+
+// String allows ZeroRule to have a string representation.
+func (z ZeroRule) String() string {
+	return string(z)
+}
+
+// Available ZeroRules. With NoZeroRule an even money Bet is lost to zero like any other, with LaPartage half its Stake is
+// refunded and with EnPrison it is imprisoned, to be carried into the next round and resolved there.
+var (
+	NoZeroRule ZeroRule = "none"
+	LaPartage  ZeroRule = "la_partage"
+	EnPrison   ZeroRule = "en_prison"
+)
+
+// Variant is the game played at a Table, it decides how many balls are spun and the paytable they are paid by.
+type Variant string
+
+// String allows Variant to have a string representation.
+func (v Variant) String() string {
+	return string(v)
+}
+
+// Available Variants. SingleBall spins one ball. DoubleBall spins two balls on the same wheel, a chip caught by one of
+// them is paid as though half its Stake were on each ball and a chip caught by both is paid the square of its single
+// ball odds. MultiWheel spins a ball on each of several wheels, spreading every Stake evenly across them. Lightning
+// spins one ball after striking numbers with Multipliers, a straight up is paid less unless its number was struck.
+var (
+	SingleBall Variant = "single"
+	DoubleBall Variant = "double_ball"
+	MultiWheel Variant = "multi_wheel"
+	Lightning  Variant = "lightning"
+)
+
+// Rules configure how the Bets of a Table are settled. Wheels is only given for MultiWheel.
+type Rules struct {
+	Zero    ZeroRule
+	Variant Variant
+	Wheels  int
+}
+
+// Validate returns ErrInvalidRules, wrapped with the rule at fault, unless every rule is known and they can be played
+// together, a Zero left empty is NoZeroRule and a Variant left empty is SingleBall. Zero rules other than NoZeroRule are
+// only played with a single ball.
+func (r Rules) Validate() error {
+	switch r.Zero {
+	case "", NoZeroRule, LaPartage, EnPrison:
+	default:
+		return fmt.Errorf("zero rule %v: %w", r.Zero, ErrInvalidRules)
+	}
+
+	switch r.Variant {
+	case "", SingleBall, DoubleBall, Lightning:
+		if r.Wheels != 0 {
+			return fmt.Errorf("%v wheels with variant %v: %w", r.Wheels, r.Variant, ErrInvalidRules)
+		}
+	case MultiWheel:
+		if r.Wheels < MinWheels || r.Wheels > MaxWheels {
+			return fmt.Errorf("%v wheels outside %v to %v: %w", r.Wheels, MinWheels, MaxWheels, ErrInvalidRules)
+		}
+	default:
+		return fmt.Errorf("variant %v: %w", r.Variant, ErrInvalidRules)
+	}
+
+	if r.Balls() > 1 && r.Zero != "" && r.Zero != NoZeroRule {
+		return fmt.Errorf("zero rule %v with %v balls: %w", r.Zero, r.Balls(), ErrInvalidRules)
+	}
+
+	return nil
+}
+
+// Balls returns the number of Outcomes each spin of the Table draws.
+func (r Rules) Balls() int {
+	switch r.Variant {
+	case DoubleBall:
+		return 2
+	case MultiWheel:
+		return r.Wheels
+	default:
+		return 1
+	}
+}
+
+// AllOn returns the positions of every ball should they all land on the given position.
+func (r Rules) AllOn(position int) []int {
+	positions := make([]int, r.Balls())
+
+	for i := range positions {
+		positions[i] = position
+	}
+
+	return positions
+}
+
+// pays returns what the house owes, in minor units, a chip of the given stake covering coverage positions when hits of
+// the balls land on them. Fractions of the minor unit are kept by the house.
+func (r Rules) pays(stake, coverage, hits int64) int64 {
+	numerator, denominator := r.odds(coverage, hits)
+
+	return floorDiv(stake*numerator, denominator)
+}
+
+// odds returns the fraction of its stake the house owes a chip covering coverage positions when hits of the balls land
+// on them, negative when the house takes the stake.
+func (r Rules) odds(coverage, hits int64) (numerator, denominator int64) {
+	if r.Variant == Lightning && coverage == 1 && hits == 1 {
+		return lightningStraightUp, 1
+	}
+
+	if r.Variant == DoubleBall {
+		switch hits {
+		case 0:
+			return -1, 1
+		case 1:
+			return payoutBase - 2*coverage, 2 * coverage
+		default:
+			return payoutBase*payoutBase - coverage*coverage, coverage * coverage
+		}
+	}
+
+	balls := int64(r.Balls())
+
+	return hits*(payoutBase-coverage) - (balls-hits)*coverage, balls * coverage
+}
+
+// floorDiv divides rounding towards negative infinity, so a fraction is never owed by the house.
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+
+	return q
+}
+
+// IsEvenMoney reports whether the Bet is a single chip paid at 1 to 1, covering half of the positions besides zero.
+func (b Bet) IsEvenMoney() bool {
+	if b.Stake == nil || len(b.Placements) > 0 || b.Covers(0) {
+		return false
+	}
+
+	chip := Placement{SelectedSpaces: b.SelectedSpaces, Stake: b.Stake}
+
+	return chip.Coverage() == evenMoneyCoverage
+}
+
+// Carried reports whether the Bet was imprisoned on an earlier Table than the given one and carried into it. A carried
+// Bet is only ever released, its Stake returned, or lost.
+func (b Bet) Carried(table uuid.UUID) bool {
+	return b.ImprisonedOn != uuid.Nil && b.ImprisonedOn != table
+}
+
+// Liability returns what the house owes the Bet should the balls land on the given positions under the Rules of the
+// Table, a straight up on a number struck by Lightning is paid at its Multiplier. A Bet carried into the Table owes
+// nothing when released and its Stake when lost, an even money Bet losing to zero keeps half its Stake under LaPartage
+// and all of it, held for the next round, under EnPrison.
+func (t Table) Liability(bet Bet, positions ...int) *money.Money {
+	liability := bet.Liability(t.Rules, positions...)
+	if liability == nil || len(positions) != 1 {
+		return liability
+	}
+
+	code := bet.Stake.Currency().Code
+	position := positions[0]
+	liability = money.New(liability.Amount()+t.struck(bet, position), code)
+
+	switch {
+	case bet.Carried(t.ID):
+		if bet.Covers(position) {
+			return money.New(0, code)
+		}
+
+		return money.New(-bet.Stake.Amount(), code)
+	case position == 0 && bet.IsEvenMoney() && t.Rules.Zero == LaPartage:
+		return money.New(bet.HalfStake().Amount()-bet.Stake.Amount(), code)
+	case position == 0 && bet.IsEvenMoney() && t.Rules.Zero == EnPrison:
+		return money.New(0, code)
+	}
+
+	return liability
+}
+
+// Imprisons reports whether the spun Table imprisons the Bet, an even money Bet placed on it losing to zero under
+// EnPrison.
+func (t Table) Imprisons(bet Bet) bool {
+	positions := t.Positions()
+
+	return len(positions) == 1 && positions[0] == 0 && t.Rules.Zero == EnPrison && bet.IsEvenMoney() && !bet.Carried(t.ID)
+}
+
+// HalfStake returns the half of the Bet's Stake refunded under LaPartage, fractions of the minor unit are kept by the
+// house.
+func (b Bet) HalfStake() *money.Money {
+	return money.New(b.Stake.Amount()/2, b.Stake.Currency().Code)
+}
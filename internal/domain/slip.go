@@ -0,0 +1,20 @@
+package domain
+
+import "github.com/google/uuid"
+
+// Slip is a group of Bets placed together on a single Table, they are accepted or refused as one and, sharing a Table,
+// are settled together.
+type Slip struct {
+	ID    uuid.UUID
+	Table uuid.UUID
+	Bets  []Bet
+}
+
+// Status returns the status shared by the Bets of the Slip, which move through each status together.
+func (s Slip) Status() BetStatus {
+	if len(s.Bets) == 0 {
+		return Unsettled
+	}
+
+	return s.Bets[0].Status
+}
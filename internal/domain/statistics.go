@@ -0,0 +1,195 @@
+package domain
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RecentResults is the number of Results kept on the board of the latest numbers.
+const RecentResults = 20
+
+// HotColdNumbers is the number of positions reported as hot, and as cold.
+const HotColdNumbers = 5
+
+// Result is the landing position of a single ball on the Table it was spun for.
+type Result struct {
+	Table  uuid.UUID
+	Value  int
+	Colour Colour
+	SpunAt time.Time
+}
+
+// StreakKind is the property shared by each Result of a Streak.
+type StreakKind string
+
+// String allows StreakKind to have a string representation.
+func (s StreakKind) String() string {
+	return string(s)
+}
+
+// Available options for StreakKind.
+const (
+	ColourStreak StreakKind = "colour"
+	DozenStreak  StreakKind = "dozen"
+	ColumnStreak StreakKind = "column"
+)
+
+// Values of a dozen or column Streak, the zero belongs to neither.
+const (
+	first  = "first"
+	second = "second"
+	third  = "third"
+	zero   = "zero"
+)
+
+// Streak is the number of consecutive latest Results sharing the same Value of the given kind.
+type Streak struct {
+	Kind   StreakKind
+	Value  string
+	Length int
+}
+
+// Statistics summarise the Results of a series of Tables, or of every Table when Series is uuid.Nil. Spins counts the
+// Results recorded, Recent holds the latest of them first, Hits the number of times each position came up and LastHit
+// the count of Results at which each position last came up, zero when it never has. Statistics are kept up to date by
+// recording each Result as it is spun rather than by reading back the Tables.
+type Statistics struct {
+	Series  uuid.UUID
+	Spins   int
+	Recent  []Result
+	Hits    []int
+	LastHit []int
+	Streaks []Streak
+}
+
+// Record returns the Statistics updated with the given Result, leaving the receiver as it was.
+func (s Statistics) Record(result Result) Statistics {
+	hits := make([]int, Pockets)
+	copy(hits, s.Hits)
+
+	lastHit := make([]int, Pockets)
+	copy(lastHit, s.LastHit)
+
+	s.Spins++
+
+	hits[result.Value]++
+	lastHit[result.Value] = s.Spins
+
+	recent := make([]Result, 0, RecentResults)
+	recent = append(recent, result)
+
+	for i := 0; i < len(s.Recent) && len(recent) < RecentResults; i++ {
+		recent = append(recent, s.Recent[i])
+	}
+
+	streaks := []Streak{
+		{Kind: ColourStreak, Value: result.Colour.String()},
+		{Kind: DozenStreak, Value: Dozen(result.Value)},
+		{Kind: ColumnStreak, Value: Column(result.Value)},
+	}
+
+	for i := range streaks {
+		streaks[i].Length = 1
+
+		for _, streak := range s.Streaks {
+			if streak.Kind == streaks[i].Kind && streak.Value == streaks[i].Value {
+				streaks[i].Length += streak.Length
+			}
+		}
+	}
+
+	s.Recent = recent
+	s.Hits = hits
+	s.LastHit = lastHit
+	s.Streaks = streaks
+
+	return s
+}
+
+// HitsOf returns the number of times the given position has come up.
+func (s Statistics) HitsOf(position int) int {
+	if position >= len(s.Hits) {
+		return 0
+	}
+
+	return s.Hits[position]
+}
+
+// Gap returns the number of Results since the given position last came up, or every Result when it never has.
+func (s Statistics) Gap(position int) int {
+	if position >= len(s.LastHit) {
+		return s.Spins
+	}
+
+	return s.Spins - s.LastHit[position]
+}
+
+// Hot returns the positions which have come up the most, most first, leaving out those which never have. Ties are broken
+// by the lower position.
+func (s Statistics) Hot() []int {
+	hot := s.rank(func(a, b int) bool {
+		return s.HitsOf(a) > s.HitsOf(b)
+	})
+
+	for len(hot) > 0 && s.HitsOf(hot[len(hot)-1]) == 0 {
+		hot = hot[:len(hot)-1]
+	}
+
+	return hot
+}
+
+// Cold returns the positions which have come up the least, least first. Ties are broken by the longer Gap.
+func (s Statistics) Cold() []int {
+	return s.rank(func(a, b int) bool {
+		if s.HitsOf(a) != s.HitsOf(b) {
+			return s.HitsOf(a) < s.HitsOf(b)
+		}
+
+		return s.Gap(a) > s.Gap(b)
+	})
+}
+
+// rank orders every position by less and returns the first HotColdNumbers of them.
+func (s Statistics) rank(less func(a, b int) bool) []int {
+	positions := make([]int, Pockets)
+
+	for i := range positions {
+		positions[i] = i
+	}
+
+	sort.SliceStable(positions, func(a, b int) bool {
+		return less(positions[a], positions[b])
+	})
+
+	return positions[:HotColdNumbers]
+}
+
+// Dozen returns which dozen the given position is in, or "zero".
+func Dozen(position int) string {
+	switch {
+	case position == 0:
+		return zero
+	case position <= 12:
+		return first
+	case position <= 24:
+		return second
+	default:
+		return third
+	}
+}
+
+// Column returns which column of the layout the given position is in, or "zero".
+func Column(position int) string {
+	switch {
+	case position == 0:
+		return zero
+	case position%3 == 1:
+		return first
+	case position%3 == 2:
+		return second
+	default:
+		return third
+	}
+}
@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"github.com/Rhymond/go-money"
+	"github.com/google/uuid"
+)
+
+// TableSummary aggregates the Bets placed on a Table. Amounts are given per currency as Bets in different currencies
+// cannot be combined.
+type TableSummary struct {
+	TableID     uuid.UUID
+	BetCount    int
+	TotalStaked []*money.Money
+	Exposure    []PocketExposure
+	HouseResult []*money.Money
+}
+
+// PocketExposure is what the house stands to lose should the ball land on Position. Liability is the winnings owed to
+// Bets covering the position less the stakes taken from every other Bet under the Rules of the Table, a negative
+// Liability is a house profit.
+type PocketExposure struct {
+	Position  int
+	Colour    Colour
+	Liability []*money.Money
+}
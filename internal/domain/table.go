@@ -1,19 +1,65 @@
 package domain
 
-import "github.com/google/uuid"
+import (
+	"time"
 
-// Table represents a single play of roulette.
+	"github.com/google/uuid"
+)
+
+// Table represents a single play of roulette. Version changes whenever the Table itself is written, not when Bets are
+// placed on it. Outcomes holds a result per ball once the Table has been spun, as many as its Rules play, and
+// Multipliers the numbers struck before the spin of a Lightning Table. Previous is the Table of the round before, from
+// which imprisoned Bets were carried, or uuid.Nil. Series is shared by every round of a game, it is the ID of the first
+// Table in the chain of Previous Tables. Each step of the Table's lifecycle records when it was taken and by whom, the
+// times of the steps not yet taken are nil.
 type Table struct {
-	ID       uuid.UUID
-	Bets     []Bet
-	IsClosed bool
-	Outcome  *Outcome
+	ID          uuid.UUID
+	Bets        []Bet
+	IsClosed    bool
+	Outcomes    []Outcome
+	Multipliers []Multiplier
+	Rules       Rules
+	Previous    uuid.UUID
+	Series      uuid.UUID
+	CreatedAt   time.Time
+	CreatedBy   string
+	ClosedAt    *time.Time
+	ClosedBy    string
+	SpunAt      *time.Time
+	SpunBy      string
+	SettledAt   *time.Time
+	SettledBy   string
+	Version     int64
+}
+
+// AnyVersion given as the expected version of a Table matches whichever version is current.
+const AnyVersion int64 = 0
+
+// FirstVersion is the version a Table is stored at when it is created.
+const FirstVersion int64 = 1
+
+// IsSpun reports whether the Table has its Outcomes.
+func (t Table) IsSpun() bool {
+	return len(t.Outcomes) > 0
+}
+
+// Positions returns the value of each of the Table's Outcomes.
+func (t Table) Positions() []int {
+	positions := make([]int, len(t.Outcomes))
+
+	for i := range t.Outcomes {
+		positions[i] = t.Outcomes[i].Value
+	}
+
+	return positions
 }
 
-// Outcome is the result of roulette wheel, it has a value and a Colour.
+// Outcome is the result of roulette wheel, it has a value and a Colour. Seed is set when the Outcome was drawn by a
+// deterministic generator so the spin can be reproduced.
 type Outcome struct {
 	Value  int
 	Colour Colour
+	Seed   int64
 }
 
 // Colour represents the colour of the Outcome.
@@ -71,3 +117,29 @@ var NumbersToColours = map[int]Colour{
 	3:  Red,
 	26: Black,
 }
+
+// WheelOrder lists the positions in the order they sit around a single zero wheel, clockwise from the zero.
+var WheelOrder = []int{
+	0, 32, 15, 19, 4, 21, 2, 25, 17, 34, 6, 27, 13, 36, 11, 30, 8, 23, 10,
+	5, 24, 16, 33, 1, 20, 14, 31, 9, 22, 18, 29, 7, 28, 12, 35, 3, 26,
+}
+
+// Neighbours returns the given position with the n positions either side of it on the wheel, in wheel order.
+func Neighbours(position, n int) []int {
+	centre := 0
+
+	for i := range WheelOrder {
+		if WheelOrder[i] == position {
+			centre = i
+			break
+		}
+	}
+
+	positions := make([]int, 0, 2*n+1)
+
+	for offset := -n; offset <= n; offset++ {
+		positions = append(positions, WheelOrder[(centre+offset+len(WheelOrder))%len(WheelOrder)])
+	}
+
+	return positions
+}
@@ -0,0 +1,41 @@
+package event
+
+import (
+	"betting/internal/domain"
+	"betting/internal/pkg/tracing"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// RepositoryProvider provides read operations for Events.
+type RepositoryProvider interface {
+	List(ctx context.Context, stream uuid.UUID) ([]domain.Event, error)
+}
+
+// Controller is responsible for reading the stream of Events each Table and its Bets are projected from.
+type Controller struct {
+	RepositoryProvider RepositoryProvider
+}
+
+// NewController instantiates Controller.
+func NewController(provider RepositoryProvider) Controller {
+	return Controller{
+		RepositoryProvider: provider,
+	}
+}
+
+// List returns every Event recorded against the Table with the given ID, in the order they were recorded.
+func (c Controller) List(ctx context.Context, table uuid.UUID) ([]domain.Event, error) {
+	ctx, span := tracing.Start(ctx, "event.Controller.List", tracing.KeyTableID.String(table.String()))
+	defer span.End()
+
+	events, err := c.RepositoryProvider.List(ctx, table)
+	if err != nil {
+		return nil, tracing.Fail(span, err)
+	}
+
+	span.SetAttributes(tracing.KeyEventCount.Int(len(events)))
+
+	return events, nil
+}
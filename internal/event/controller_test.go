@@ -0,0 +1,60 @@
+package event
+
+import (
+	"betting/internal/domain"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+)
+
+var recordedAt = time.Date(2021, 10, 11, 12, 0, 0, 0, time.UTC)
+
+func TestController_List_Success(t *testing.T) {
+	tableID := uuid.New()
+
+	events := []domain.Event{
+		{Stream: tableID, Sequence: 1, Type: domain.TableOpened, RecordedAt: recordedAt, Data: []byte(`{}`)},
+		{Stream: tableID, Sequence: 2, Type: domain.TableClosed, RecordedAt: recordedAt, Data: []byte(`{}`)},
+	}
+
+	repository := &mockRepository{GivenEvents: events}
+
+	actual, err := NewController(repository).List(context.Background(), tableID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if repository.ListedStream != tableID {
+		t.Fatalf("expected the stream of table %v to be listed, got %v", tableID, repository.ListedStream)
+	}
+
+	if !cmp.Equal(actual, events) {
+		t.Fatal(cmp.Diff(actual, events))
+	}
+}
+
+func TestController_List_Fail(t *testing.T) {
+	notFound := errors.New("could not locate events")
+
+	_, err := NewController(&mockRepository{GivenError: notFound}).List(context.Background(), uuid.New())
+	if !cmp.Equal(err, notFound, cmpopts.EquateErrors()) {
+		t.Fatal(cmp.Diff(err, notFound, cmpopts.EquateErrors()))
+	}
+}
+
+type mockRepository struct {
+	GivenEvents  []domain.Event
+	GivenError   error
+	ListedStream uuid.UUID
+}
+
+func (m *mockRepository) List(_ context.Context, stream uuid.UUID) ([]domain.Event, error) {
+	m.ListedStream = stream
+
+	return m.GivenEvents, m.GivenError
+}
@@ -0,0 +1,40 @@
+package event
+
+import (
+	"betting/internal/domain"
+	"betting/internal/pkg/tracing"
+	"betting/storage"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// StorageProvider provides read operations for the Events recorded against each Table.
+type StorageProvider interface {
+	List(ctx context.Context, stream uuid.UUID) ([]storage.Event, error)
+}
+
+// Repository allows for the Events of a Table to be read.
+type Repository struct {
+	StorageProvider StorageProvider
+}
+
+// NewRepository instantiates a Repository.
+func NewRepository(provider StorageProvider) Repository {
+	return Repository{
+		StorageProvider: provider,
+	}
+}
+
+// List returns the Events of the given stream in Sequence.
+func (r Repository) List(ctx context.Context, stream uuid.UUID) ([]domain.Event, error) {
+	ctx, span := tracing.Start(ctx, "event.Repository.List", tracing.KeyTableID.String(stream.String()))
+	defer span.End()
+
+	events, err := r.StorageProvider.List(ctx, stream)
+	if err != nil {
+		return nil, tracing.Fail(span, err)
+	}
+
+	return storage.AdaptEventsToDomain(events), nil
+}
@@ -0,0 +1,121 @@
+package export
+
+import (
+	"betting/internal/domain"
+	"betting/internal/pkg/logging"
+	"betting/internal/pkg/tracing"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// PageSize is the number of Tables read from storage at a time.
+const PageSize = 100
+
+// Domain errors.
+var (
+	ErrFailedToListTables = errors.New("failed to list tables")
+	ErrFailedToFetchBets  = errors.New("failed to locate bets")
+	ErrFailedToWrite      = errors.New("failed to write export")
+)
+
+// TableReader provides the paged listing of Tables.
+type TableReader interface {
+	List(ctx context.Context, query domain.TableQuery) (domain.TablePage, error)
+}
+
+// BetReader provides the Bets of several Tables at once.
+type BetReader interface {
+	ListByTables(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID][]domain.Bet, error)
+}
+
+// Controller is responsible for exporting the Tables created within a range of time along with their Bets and Outcomes.
+type Controller struct {
+	TableReader TableReader
+	BetReader   BetReader
+	PageSize    int
+}
+
+// NewController instantiates Controller, reading PageSize Tables at a time.
+func NewController(tables TableReader, bets BetReader) Controller {
+	return Controller{
+		TableReader: tables,
+		BetReader:   bets,
+		PageSize:    PageSize,
+	}
+}
+
+// Export passes every Table created within the range of the query to write, oldest first, each with its Bets when Bets
+// are exported. Tables are read a page at a time along with their Bets, so no more than a page is held at once and the
+// export is written as it is read. Should write fail the export stops there.
+func (c Controller) Export(ctx context.Context, query domain.ExportQuery, write func(table domain.Table) error) error {
+	ctx, span := tracing.Start(ctx, "export.Controller.Export")
+	defer span.End()
+
+	tableQuery := domain.TableQuery{
+		CreatedFrom: &query.CreatedFrom,
+		CreatedTo:   &query.CreatedTo,
+		Order:       domain.Ascending,
+		Limit:       c.PageSize,
+		OmitBets:    query.Records != domain.BetRecords,
+	}
+
+	var count int
+
+	for {
+		page, err := c.TableReader.List(ctx, tableQuery)
+		if err != nil {
+			return tracing.Fail(span, fmt.Errorf("%v: %w", err, ErrFailedToListTables))
+		}
+
+		if !tableQuery.OmitBets {
+			err = c.attachBets(ctx, page.Tables)
+			if err != nil {
+				return tracing.Fail(span, err)
+			}
+		}
+
+		for i := range page.Tables {
+			err = write(page.Tables[i])
+			if err != nil {
+				return tracing.Fail(span, fmt.Errorf("%v: %w", err, ErrFailedToWrite))
+			}
+		}
+
+		count += len(page.Tables)
+
+		if page.Next == nil {
+			break
+		}
+
+		tableQuery.After = page.Next
+	}
+
+	span.SetAttributes(tracing.KeyTableCount.Int(count))
+
+	logging.FromContext(ctx).WithField("tables", count).WithField("records", query.Records).Debug("exported tables")
+
+	return nil
+}
+
+// attachBets sets the Bets of each of the given Tables.
+func (c Controller) attachBets(ctx context.Context, tables []domain.Table) error {
+	ids := make([]uuid.UUID, len(tables))
+
+	for i := range tables {
+		ids[i] = tables[i].ID
+	}
+
+	bets, err := c.BetReader.ListByTables(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("%v: %w", err, ErrFailedToFetchBets)
+	}
+
+	for i := range tables {
+		tables[i].Bets = bets[tables[i].ID]
+	}
+
+	return nil
+}
@@ -0,0 +1,192 @@
+package export
+
+import (
+	"betting/internal/domain"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+)
+
+var (
+	createdFrom = time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+	createdTo   = time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	first       = uuid.MustParse("1c3d5e7f-9a1b-4c3d-8e5f-7a9b1c3d5e7f")
+	second      = uuid.MustParse("2d4e6f8a-0b2c-4d4e-9f6a-8b0c2d4e6f8a")
+	third       = uuid.MustParse("3e5f7a9b-1c3d-4e5f-8a7b-9c1d3e5f7a9b")
+)
+
+func TestController_Export_Success(t *testing.T) {
+	tables := []domain.Table{{ID: first}, {ID: second}, {ID: third}}
+	bets := map[uuid.UUID][]domain.Bet{
+		first: {{ID: uuid.MustParse("4f6a8b0c-2d4e-4f6a-9b8c-0d2e4f6a8b0c"), Table: first}},
+		third: {{ID: uuid.MustParse("5a7b9c1d-3e5f-4a7b-8c9d-1e3f5a7b9c1d"), Table: third}},
+	}
+
+	tests := []struct {
+		name            string
+		givenRecords    domain.ExportRecords
+		expectedTables  []domain.Table
+		expectedQueries int
+		expectedBetRead int
+	}{
+		{
+			name:         "given tables are exported, expect every table a page at a time without bets",
+			givenRecords: domain.TableRecords,
+			expectedTables: []domain.Table{
+				{ID: first},
+				{ID: second},
+				{ID: third},
+			},
+			expectedQueries: 2,
+		},
+		{
+			name:         "given bets are exported, expect every table with its bets read a page at a time",
+			givenRecords: domain.BetRecords,
+			expectedTables: []domain.Table{
+				{ID: first, Bets: bets[first]},
+				{ID: second},
+				{ID: third, Bets: bets[third]},
+			},
+			expectedQueries: 2,
+			expectedBetRead: 2,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tableReader := &mockTableReader{GivenTables: tables}
+			betReader := &mockBetReader{GivenBets: bets}
+
+			c := NewController(tableReader, betReader)
+			c.PageSize = 2
+
+			var written []domain.Table
+
+			err := c.Export(context.Background(), domain.ExportQuery{
+				Records:     test.givenRecords,
+				CreatedFrom: createdFrom,
+				CreatedTo:   createdTo,
+			}, func(table domain.Table) error {
+				written = append(written, table)
+
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(written, test.expectedTables) {
+				t.Fatal(cmp.Diff(written, test.expectedTables))
+			}
+
+			if !cmp.Equal(len(tableReader.Queries), test.expectedQueries) {
+				t.Fatal(cmp.Diff(len(tableReader.Queries), test.expectedQueries))
+			}
+
+			if !cmp.Equal(betReader.Reads, test.expectedBetRead) {
+				t.Fatal(cmp.Diff(betReader.Reads, test.expectedBetRead))
+			}
+
+			query := tableReader.Queries[0]
+
+			if !query.CreatedFrom.Equal(createdFrom) || !query.CreatedTo.Equal(createdTo) || query.Order != domain.Ascending {
+				t.Fatalf("expected the tables created within the range, oldest first, got %+v", query)
+			}
+		})
+	}
+}
+
+func TestController_Export_Fail(t *testing.T) {
+	tests := []struct {
+		name             string
+		givenTableReader *mockTableReader
+		givenBetReader   *mockBetReader
+		givenWriteError  error
+		expectedError    error
+	}{
+		{
+			name:             "given the tables cannot be listed, expect ErrFailedToListTables",
+			givenTableReader: &mockTableReader{GivenError: errors.New("storage unavailable")},
+			givenBetReader:   &mockBetReader{},
+			expectedError:    ErrFailedToListTables,
+		},
+		{
+			name:             "given the bets cannot be read, expect ErrFailedToFetchBets",
+			givenTableReader: &mockTableReader{GivenTables: []domain.Table{{ID: first}}},
+			givenBetReader:   &mockBetReader{GivenError: errors.New("storage unavailable")},
+			expectedError:    ErrFailedToFetchBets,
+		},
+		{
+			name:             "given the export cannot be written, expect ErrFailedToWrite",
+			givenTableReader: &mockTableReader{GivenTables: []domain.Table{{ID: first}}},
+			givenBetReader:   &mockBetReader{},
+			givenWriteError:  errors.New("broken pipe"),
+			expectedError:    ErrFailedToWrite,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := NewController(test.givenTableReader, test.givenBetReader)
+
+			err := c.Export(context.Background(), domain.ExportQuery{Records: domain.BetRecords}, func(domain.Table) error {
+				return test.givenWriteError
+			})
+			if !cmp.Equal(err, test.expectedError, cmpopts.EquateErrors()) {
+				t.Fatal(cmp.Diff(err, test.expectedError, cmpopts.EquateErrors()))
+			}
+		})
+	}
+}
+
+// mockTableReader pages through GivenTables by the Limit of each query, continuing after the ID of its cursor.
+type mockTableReader struct {
+	GivenTables []domain.Table
+	GivenError  error
+	Queries     []domain.TableQuery
+}
+
+func (m *mockTableReader) List(_ context.Context, query domain.TableQuery) (domain.TablePage, error) {
+	m.Queries = append(m.Queries, query)
+
+	if m.GivenError != nil {
+		return domain.TablePage{}, m.GivenError
+	}
+
+	start := 0
+
+	if query.After != nil {
+		for i := range m.GivenTables {
+			if m.GivenTables[i].ID == query.After.ID {
+				start = i + 1
+			}
+		}
+	}
+
+	end := start + query.Limit
+	if end >= len(m.GivenTables) {
+		return domain.TablePage{Tables: append([]domain.Table(nil), m.GivenTables[start:]...)}, nil
+	}
+
+	page := domain.TablePage{
+		Tables: append([]domain.Table(nil), m.GivenTables[start:end]...),
+		Next:   &domain.TableCursor{ID: m.GivenTables[end-1].ID},
+	}
+
+	return page, nil
+}
+
+type mockBetReader struct {
+	GivenBets  map[uuid.UUID][]domain.Bet
+	GivenError error
+	Reads      int
+}
+
+func (m *mockBetReader) ListByTables(_ context.Context, _ []uuid.UUID) (map[uuid.UUID][]domain.Bet, error) {
+	m.Reads++
+
+	return m.GivenBets, m.GivenError
+}
@@ -0,0 +1,161 @@
+package jackpot
+
+import (
+	"betting/internal/domain"
+	"betting/internal/pkg/logging"
+	"betting/internal/pkg/tracing"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Rhymond/go-money"
+	"github.com/google/uuid"
+)
+
+// Domain errors.
+var (
+	ErrFailedToFetchJackpot = errors.New("failed to locate jackpot")
+	ErrFailedToContribute   = errors.New("failed to contribute to jackpot")
+	ErrFailedToFetchRound   = errors.New("failed to locate previous round")
+	ErrFailedToAward        = errors.New("failed to award jackpot")
+)
+
+// RepositoryProvider provides both read and write operations for the Jackpot.
+type RepositoryProvider interface {
+	Get(ctx context.Context) (domain.Jackpot, error)
+	Contribute(ctx context.Context, amount *money.Money) error
+	Award(ctx context.Context, awards []domain.JackpotAward) error
+}
+
+// TableRepoReader provides read operations for Tables.
+type TableRepoReader interface {
+	Get(ctx context.Context, id uuid.UUID) (domain.Table, error)
+}
+
+// Controller is responsible for the Jackpot shared by every Table, taking contributions from side bets and paying out
+// the Pool when a Table triggers it.
+type Controller struct {
+	RepositoryProvider RepositoryProvider
+	TableRepoReader    TableRepoReader
+	Rules              domain.JackpotRules
+}
+
+// NewController instantiates Controller, the Jackpot is played by the given JackpotRules.
+func NewController(provider RepositoryProvider, tables TableRepoReader, rules domain.JackpotRules) Controller {
+	return Controller{
+		RepositoryProvider: provider,
+		TableRepoReader:    tables,
+		Rules:              rules,
+	}
+}
+
+// Get returns the Jackpot with its current Pool and the Awards paid from it.
+func (c Controller) Get(ctx context.Context) (domain.Jackpot, error) {
+	ctx, span := tracing.Start(ctx, "jackpot.Controller.Get")
+	defer span.End()
+
+	jackpot, err := c.RepositoryProvider.Get(ctx)
+	if err != nil {
+		return domain.Jackpot{}, tracing.Fail(span, fmt.Errorf("%v: %w", err, ErrFailedToFetchJackpot))
+	}
+
+	return jackpot, nil
+}
+
+// Contribute pays the share of the Bet's Stake set by the JackpotRules into the Pool and returns the Bet with its
+// Contribution. Bets placed without the side bet are returned as is.
+func (c Controller) Contribute(ctx context.Context, bet domain.Bet) (domain.Bet, error) {
+	ctx, span := tracing.Start(ctx, "jackpot.Controller.Contribute", tracing.KeyBetID.String(bet.ID.String()))
+	defer span.End()
+
+	if !bet.Jackpot {
+		return bet, nil
+	}
+
+	bet.Contribution = c.Rules.Contribute(bet.Stake)
+
+	logger := logging.FromContext(ctx).WithField(logging.FieldBetID, bet.ID)
+
+	logger.WithField("contribution", bet.Contribution.Amount()).Debug("contributing to jackpot")
+
+	err := c.RepositoryProvider.Contribute(ctx, bet.Contribution)
+	if err != nil {
+		return domain.Bet{}, tracing.Fail(span, fmt.Errorf("%v: %w", err, ErrFailedToContribute))
+	}
+
+	return bet, nil
+}
+
+// Award pays the Pool out to the winning side bets of the settled Table when it and the rounds before it trigger the
+// Jackpot, returning the Table with each Bet's JackpotWin. The rounds are only looked up when there is a winning side
+// bet to pay.
+func (c Controller) Award(ctx context.Context, table domain.Table) (domain.Table, error) {
+	ctx, span := tracing.Start(ctx, "jackpot.Controller.Award", tracing.KeyTableID.String(table.ID.String()))
+	defer span.End()
+
+	if !contending(table.Bets) {
+		return table, nil
+	}
+
+	rounds := []domain.Table{table}
+
+	for previous := table.Previous; len(rounds) < c.Rules.Repeats && previous != uuid.Nil; {
+		round, err := c.TableRepoReader.Get(ctx, previous)
+		if err != nil {
+			return domain.Table{}, tracing.Fail(span, fmt.Errorf("%v: %w", err, ErrFailedToFetchRound))
+		}
+
+		rounds = append(rounds, round)
+		previous = round.Previous
+	}
+
+	if !c.Rules.Triggered(rounds) {
+		return table, nil
+	}
+
+	jackpot, err := c.RepositoryProvider.Get(ctx)
+	if err != nil {
+		return domain.Table{}, tracing.Fail(span, fmt.Errorf("%v: %w", err, ErrFailedToFetchJackpot))
+	}
+
+	awards := jackpot.Shares(table.Bets)
+
+	logging.FromContext(ctx).WithField(logging.FieldTableID, table.ID).WithField("awards", len(awards)).Debug("jackpot triggered")
+
+	if len(awards) == 0 {
+		return table, nil
+	}
+
+	err = c.RepositoryProvider.Award(ctx, awards)
+	if err != nil {
+		return domain.Table{}, tracing.Fail(span, fmt.Errorf("%v: %w", err, ErrFailedToAward))
+	}
+
+	won := make(map[uuid.UUID]*money.Money, len(awards))
+
+	for i := range awards {
+		won[awards[i].Bet] = awards[i].Amount
+	}
+
+	bets := make([]domain.Bet, len(table.Bets))
+
+	for i := range table.Bets {
+		bets[i] = table.Bets[i]
+		bets[i].JackpotWin = won[bets[i].ID]
+	}
+
+	table.Bets = bets
+
+	return table, nil
+}
+
+// contending reports whether any of the Bets won with a side bet, and so could be paid from the Pool.
+func contending(bets []domain.Bet) bool {
+	for i := range bets {
+		if bets[i].Win && bets[i].Contribution != nil {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,303 @@
+package jackpot
+
+import (
+	"betting/internal/domain"
+	"betting/storage/memory"
+	"betting/testing/opts"
+	"context"
+	"testing"
+
+	"github.com/Rhymond/go-money"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+)
+
+var (
+	firstRound  = uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce")
+	secondRound = uuid.MustParse("70ee9bba-87ac-4155-8ec7-f83c8663315e")
+	thirdRound  = uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d")
+)
+
+func TestController_Contribute_Success(t *testing.T) {
+	tests := []struct {
+		name        string
+		givenRules  domain.JackpotRules
+		givenBet    domain.Bet
+		expectedBet domain.Bet
+	}{
+		{
+			name:       "given a bet without the side bet, expect it to be returned as is",
+			givenRules: domain.JackpotRules{Percent: 10, Repeats: 3},
+			givenBet: domain.Bet{
+				ID:    uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+				Stake: money.New(1000, "GBP"),
+			},
+			expectedBet: domain.Bet{
+				ID:    uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+				Stake: money.New(1000, "GBP"),
+			},
+		},
+		{
+			name:       "given a bet with the side bet, expect the percentage of its stake to be contributed",
+			givenRules: domain.JackpotRules{Percent: 10, Repeats: 3},
+			givenBet: domain.Bet{
+				ID:      uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+				Stake:   money.New(1005, "GBP"),
+				Jackpot: true,
+			},
+			expectedBet: domain.Bet{
+				ID:           uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+				Stake:        money.New(1005, "GBP"),
+				Jackpot:      true,
+				Contribution: money.New(100, "GBP"),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := NewController(mockRepository{}, mockTableReader{}, test.givenRules)
+
+			actual, err := c.Contribute(context.Background(), test.givenBet)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(actual, test.expectedBet, opts.MoneyComparer) {
+				t.Fatal(cmp.Diff(actual, test.expectedBet, opts.MoneyComparer))
+			}
+		})
+	}
+}
+
+func TestController_Contribute_Fail(t *testing.T) {
+	c := NewController(mockRepository{GivenContributeError: memory.ErrPoolExhausted}, mockTableReader{}, domain.JackpotRules{
+		Percent: 10,
+		Repeats: 3,
+	})
+
+	_, err := c.Contribute(context.Background(), domain.Bet{Stake: money.New(1000, "GBP"), Jackpot: true})
+	if !cmp.Equal(err, ErrFailedToContribute, cmpopts.EquateErrors()) {
+		t.Fatal(cmp.Diff(err, ErrFailedToContribute, cmpopts.EquateErrors()))
+	}
+}
+
+func TestController_Award_Success(t *testing.T) {
+	tests := []struct {
+		name          string
+		givenJackpot  domain.Jackpot
+		givenTables   map[uuid.UUID]domain.Table
+		givenTable    domain.Table
+		expectedTable domain.Table
+	}{
+		{
+			name:         "given no winning side bet, expect the table to be returned as is",
+			givenJackpot: domain.Jackpot{Pool: []*money.Money{money.New(1000, "GBP")}},
+			givenTable: domain.Table{
+				ID:       thirdRound,
+				Outcomes: []domain.Outcome{{Value: 14, Colour: domain.Red}},
+				Previous: secondRound,
+				Bets: []domain.Bet{
+					{ID: uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"), Win: true},
+					{ID: uuid.MustParse("49cffe67-9798-4327-9760-c4b81562f928"), Contribution: money.New(10, "GBP")},
+				},
+			},
+			expectedTable: domain.Table{
+				ID:       thirdRound,
+				Outcomes: []domain.Outcome{{Value: 14, Colour: domain.Red}},
+				Previous: secondRound,
+				Bets: []domain.Bet{
+					{ID: uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"), Win: true},
+					{ID: uuid.MustParse("49cffe67-9798-4327-9760-c4b81562f928"), Contribution: money.New(10, "GBP")},
+				},
+			},
+		},
+		{
+			name:         "given the round before landed on another number, expect the jackpot not to be triggered",
+			givenJackpot: domain.Jackpot{Pool: []*money.Money{money.New(1000, "GBP")}},
+			givenTables: map[uuid.UUID]domain.Table{
+				secondRound: {ID: secondRound, Outcomes: []domain.Outcome{{Value: 14}}, Previous: firstRound},
+				firstRound:  {ID: firstRound, Outcomes: []domain.Outcome{{Value: 15}}},
+			},
+			givenTable: domain.Table{
+				ID:       thirdRound,
+				Outcomes: []domain.Outcome{{Value: 14, Colour: domain.Red}},
+				Previous: secondRound,
+				Bets: []domain.Bet{
+					{ID: uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"), Win: true, Contribution: money.New(10, "GBP")},
+				},
+			},
+			expectedTable: domain.Table{
+				ID:       thirdRound,
+				Outcomes: []domain.Outcome{{Value: 14, Colour: domain.Red}},
+				Previous: secondRound,
+				Bets: []domain.Bet{
+					{ID: uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"), Win: true, Contribution: money.New(10, "GBP")},
+				},
+			},
+		},
+		{
+			name:         "given too few rounds before it, expect the jackpot not to be triggered",
+			givenJackpot: domain.Jackpot{Pool: []*money.Money{money.New(1000, "GBP")}},
+			givenTables: map[uuid.UUID]domain.Table{
+				secondRound: {ID: secondRound, Outcomes: []domain.Outcome{{Value: 14}}},
+			},
+			givenTable: domain.Table{
+				ID:       thirdRound,
+				Outcomes: []domain.Outcome{{Value: 14, Colour: domain.Red}},
+				Previous: secondRound,
+				Bets: []domain.Bet{
+					{ID: uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"), Win: true, Contribution: money.New(10, "GBP")},
+				},
+			},
+			expectedTable: domain.Table{
+				ID:       thirdRound,
+				Outcomes: []domain.Outcome{{Value: 14, Colour: domain.Red}},
+				Previous: secondRound,
+				Bets: []domain.Bet{
+					{ID: uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"), Win: true, Contribution: money.New(10, "GBP")},
+				},
+			},
+		},
+		{
+			name: "given three rounds in a row on the same number, expect each pool to be shared by what was contributed",
+			givenJackpot: domain.Jackpot{
+				Pool: []*money.Money{money.New(999, "EUR"), money.New(1001, "GBP")},
+			},
+			givenTables: map[uuid.UUID]domain.Table{
+				secondRound: {ID: secondRound, Outcomes: []domain.Outcome{{Value: 14}}, Previous: firstRound},
+				firstRound:  {ID: firstRound, Outcomes: []domain.Outcome{{Value: 14}}},
+			},
+			givenTable: domain.Table{
+				ID:       thirdRound,
+				Outcomes: []domain.Outcome{{Value: 14, Colour: domain.Red}},
+				Previous: secondRound,
+				Bets: []domain.Bet{
+					{ID: uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"), Win: true, Contribution: money.New(10, "GBP")},
+					{ID: uuid.MustParse("49cffe67-9798-4327-9760-c4b81562f928"), Win: true, Contribution: money.New(30, "GBP")},
+					{ID: uuid.MustParse("c4b39dc0-2ff4-4405-b3cb-c4f87a9c82fb"), Contribution: money.New(50, "GBP")},
+					{ID: uuid.MustParse("8f1f5f5c-7a43-4d0e-a4a8-1b8a2b8f4c11"), Win: true},
+				},
+			},
+			expectedTable: domain.Table{
+				ID:       thirdRound,
+				Outcomes: []domain.Outcome{{Value: 14, Colour: domain.Red}},
+				Previous: secondRound,
+				Bets: []domain.Bet{
+					{
+						ID:           uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+						Win:          true,
+						Contribution: money.New(10, "GBP"),
+						JackpotWin:   money.New(250, "GBP"),
+					},
+					{
+						ID:           uuid.MustParse("49cffe67-9798-4327-9760-c4b81562f928"),
+						Win:          true,
+						Contribution: money.New(30, "GBP"),
+						JackpotWin:   money.New(750, "GBP"),
+					},
+					{ID: uuid.MustParse("c4b39dc0-2ff4-4405-b3cb-c4f87a9c82fb"), Contribution: money.New(50, "GBP")},
+					{ID: uuid.MustParse("8f1f5f5c-7a43-4d0e-a4a8-1b8a2b8f4c11"), Win: true},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := NewController(
+				mockRepository{GivenJackpot: test.givenJackpot},
+				mockTableReader{GivenTables: test.givenTables},
+				domain.JackpotRules{Percent: 1, Repeats: 3},
+			)
+
+			actual, err := c.Award(context.Background(), test.givenTable)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(actual, test.expectedTable, opts.MoneyComparer) {
+				t.Fatal(cmp.Diff(actual, test.expectedTable, opts.MoneyComparer))
+			}
+		})
+	}
+}
+
+func TestController_Award_Fail(t *testing.T) {
+	tests := []struct {
+		name          string
+		givenRepo     RepositoryProvider
+		givenTables   map[uuid.UUID]domain.Table
+		expectedError error
+	}{
+		{
+			name:          "given the round before cannot be found, expect ErrFailedToFetchRound",
+			givenRepo:     mockRepository{},
+			expectedError: ErrFailedToFetchRound,
+		},
+		{
+			name: "given the pool holds too little, expect ErrFailedToAward",
+			givenRepo: mockRepository{
+				GivenJackpot:    domain.Jackpot{Pool: []*money.Money{money.New(1000, "GBP")}},
+				GivenAwardError: memory.ErrPoolExhausted,
+			},
+			givenTables: map[uuid.UUID]domain.Table{
+				secondRound: {ID: secondRound, Outcomes: []domain.Outcome{{Value: 14}}, Previous: firstRound},
+				firstRound:  {ID: firstRound, Outcomes: []domain.Outcome{{Value: 14}}},
+			},
+			expectedError: ErrFailedToAward,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := NewController(test.givenRepo, mockTableReader{GivenTables: test.givenTables}, domain.JackpotRules{Percent: 1, Repeats: 3})
+
+			_, err := c.Award(context.Background(), domain.Table{
+				ID:       thirdRound,
+				Outcomes: []domain.Outcome{{Value: 14, Colour: domain.Red}},
+				Previous: secondRound,
+				Bets: []domain.Bet{
+					{ID: uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"), Win: true, Contribution: money.New(10, "GBP")},
+				},
+			})
+			if err == nil {
+				t.Fatalf("expected %v, got nil", test.expectedError)
+			}
+
+			if !cmp.Equal(err, test.expectedError, cmpopts.EquateErrors()) {
+				t.Fatal(cmp.Diff(err, test.expectedError, cmpopts.EquateErrors()))
+			}
+		})
+	}
+}
+
+type mockRepository struct {
+	GivenJackpot         domain.Jackpot
+	GivenGetError        error
+	GivenContributeError error
+	GivenAwardError      error
+}
+
+func (m mockRepository) Get(_ context.Context) (domain.Jackpot, error) {
+	return m.GivenJackpot, m.GivenGetError
+}
+
+func (m mockRepository) Contribute(_ context.Context, _ *money.Money) error {
+	return m.GivenContributeError
+}
+
+func (m mockRepository) Award(_ context.Context, _ []domain.JackpotAward) error {
+	return m.GivenAwardError
+}
+
+type mockTableReader struct {
+	GivenTables map[uuid.UUID]domain.Table
+}
+
+func (m mockTableReader) Get(_ context.Context, id uuid.UUID) (domain.Table, error) {
+	table, ok := m.GivenTables[id]
+	if !ok {
+		return domain.Table{}, memory.ErrNoTables
+	}
+
+	return table, nil
+}
@@ -0,0 +1,79 @@
+package jackpot
+
+import (
+	"betting/internal/domain"
+	"betting/internal/pkg/tracing"
+	"betting/storage"
+	"context"
+
+	"github.com/Rhymond/go-money"
+)
+
+// StorageProvider provides both read and write operations for the Jackpot.
+type StorageProvider interface {
+	StorageReader
+	StorageWriter
+}
+
+// StorageReader provides read operations for the Jackpot.
+type StorageReader interface {
+	Get(ctx context.Context) (storage.Jackpot, error)
+}
+
+// StorageWriter provides write operations for the Jackpot. Award pays all of the given Awards out of the Pool or none
+// of them.
+type StorageWriter interface {
+	Contribute(ctx context.Context, amount *money.Money) error
+	Award(ctx context.Context, awards []storage.JackpotAward) error
+}
+
+// Repository allows for the Jackpot to be stored.
+type Repository struct {
+	StorageProvider StorageProvider
+}
+
+// NewRepository instantiates a Repository.
+func NewRepository(provider StorageProvider) Repository {
+	return Repository{
+		StorageProvider: provider,
+	}
+}
+
+// Get returns the Jackpot with its Pool and the Awards paid from it.
+func (r Repository) Get(ctx context.Context) (domain.Jackpot, error) {
+	ctx, span := tracing.Start(ctx, "jackpot.Repository.Get")
+	defer span.End()
+
+	jackpot, err := r.StorageProvider.Get(ctx)
+	if err != nil {
+		return domain.Jackpot{}, tracing.Fail(span, err)
+	}
+
+	return storage.AdaptJackpotToDomain(jackpot), nil
+}
+
+// Contribute adds the given amount to the Pool.
+func (r Repository) Contribute(ctx context.Context, amount *money.Money) error {
+	ctx, span := tracing.Start(ctx, "jackpot.Repository.Contribute")
+	defer span.End()
+
+	err := r.StorageProvider.Contribute(ctx, amount)
+	if err != nil {
+		return tracing.Fail(span, err)
+	}
+
+	return nil
+}
+
+// Award pays the given Awards out of the Pool.
+func (r Repository) Award(ctx context.Context, awards []domain.JackpotAward) error {
+	ctx, span := tracing.Start(ctx, "jackpot.Repository.Award", tracing.KeyBetCount.Int(len(awards)))
+	defer span.End()
+
+	err := r.StorageProvider.Award(ctx, storage.AdaptJackpotAwardsToStorage(awards))
+	if err != nil {
+		return tracing.Fail(span, err)
+	}
+
+	return nil
+}
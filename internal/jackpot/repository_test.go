@@ -0,0 +1,93 @@
+package jackpot
+
+import (
+	"betting/internal/domain"
+	"betting/storage"
+	"betting/storage/memory"
+	"betting/testing/opts"
+	"context"
+	"testing"
+
+	"github.com/Rhymond/go-money"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+)
+
+func TestRepository_Award_Success(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenAwards     []domain.JackpotAward
+		expectedJackpot domain.Jackpot
+	}{
+		{
+			name: "given an award, expect it to be paid from the pool and recorded",
+			givenAwards: []domain.JackpotAward{
+				{
+					Bet:    uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+					Table:  uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+					Amount: money.New(400, "GBP"),
+				},
+			},
+			expectedJackpot: domain.Jackpot{
+				Pool: []*money.Money{money.New(600, "GBP")},
+				Awards: []domain.JackpotAward{
+					{
+						Bet:    uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+						Table:  uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+						Amount: money.New(400, "GBP"),
+					},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repo := NewRepository(memory.NewJackpotStorage())
+
+			err := repo.Contribute(context.Background(), money.New(1000, "GBP"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = repo.Award(context.Background(), test.givenAwards)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			actual, err := repo.Get(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(actual, test.expectedJackpot, opts.MoneyComparer) {
+				t.Fatal(cmp.Diff(actual, test.expectedJackpot, opts.MoneyComparer))
+			}
+		})
+	}
+}
+
+func TestRepository_Get_Fail(t *testing.T) {
+	repo := NewRepository(mockStorage{GivenGetError: memory.ErrInvalidKey})
+
+	_, err := repo.Get(context.Background())
+	if !cmp.Equal(err, memory.ErrInvalidKey, cmpopts.EquateErrors()) {
+		t.Fatal(cmp.Diff(err, memory.ErrInvalidKey, cmpopts.EquateErrors()))
+	}
+}
+
+type mockStorage struct {
+	GivenGetError error
+}
+
+func (m mockStorage) Get(_ context.Context) (storage.Jackpot, error) {
+	return storage.Jackpot{}, m.GivenGetError
+}
+
+func (m mockStorage) Contribute(_ context.Context, _ *money.Money) error {
+	return nil
+}
+
+func (m mockStorage) Award(_ context.Context, _ []storage.JackpotAward) error {
+	return nil
+}
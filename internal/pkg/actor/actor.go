@@ -0,0 +1,50 @@
+package actor
+
+import (
+	"context"
+	"net/http"
+)
+
+// Header carries the user making a request, who is recorded as the actor of every change the request makes. The header
+// is supplied by the client and is not authenticated, so the actor attributes a change to whoever the client claims to
+// be; a deployment relying on it must set it from an authenticating proxy in front of the server.
+const Header = "X-User-ID"
+
+// Anonymous is the actor of a request made without a user.
+const Anonymous = "anonymous"
+
+// maxLength bounds the user accepted from a client.
+const maxLength = 128
+
+type contextKey struct{}
+
+// Middleware associates every request with the user given by the client, or Anonymous. The user is taken on trust.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), r.Header.Get(Header))))
+	})
+}
+
+// Ensure returns the given user when it is usable, otherwise Anonymous.
+func Ensure(user string) string {
+	if user == "" || len(user) > maxLength {
+		return Anonymous
+	}
+
+	return user
+}
+
+// NewContext returns a copy of ctx carrying the given user, or Anonymous when it is not usable.
+func NewContext(ctx context.Context, user string) context.Context {
+	return context.WithValue(ctx, contextKey{}, Ensure(user))
+}
+
+// FromContext returns the user carried by ctx, or Anonymous.
+func FromContext(ctx context.Context) string {
+	user, ok := ctx.Value(contextKey{}).(string)
+	if !ok {
+		return Anonymous
+	}
+
+	return user
+}
@@ -0,0 +1,59 @@
+package actor
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMiddleware(t *testing.T) {
+	tests := []struct {
+		name          string
+		givenHeader   string
+		expectedActor string
+	}{
+		{
+			name:          "given a user, expect them to be the actor",
+			givenHeader:   "croupier-7",
+			expectedActor: "croupier-7",
+		},
+		{
+			name:          "given no user, expect the actor to be anonymous",
+			givenHeader:   "",
+			expectedActor: Anonymous,
+		},
+		{
+			name:          "given a user that is too long, expect the actor to be anonymous",
+			givenHeader:   strings.Repeat("a", maxLength+1),
+			expectedActor: Anonymous,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var actual string
+
+			handler := Middleware(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				actual = FromContext(r.Context())
+			}))
+
+			req := httptest.NewRequest(http.MethodPost, "/v1/tables", nil)
+			req.Header.Set(Header, test.givenHeader)
+
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+
+			if !cmp.Equal(actual, test.expectedActor) {
+				t.Fatal(cmp.Diff(actual, test.expectedActor))
+			}
+		})
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	if actual := FromContext(context.Background()); !cmp.Equal(actual, Anonymous) {
+		t.Fatal(cmp.Diff(actual, Anonymous))
+	}
+}
@@ -0,0 +1,102 @@
+package aggregator
+
+import (
+	"betting/internal/domain"
+	"context"
+	"sort"
+
+	"github.com/Rhymond/go-money"
+)
+
+// Aggregator summarises the Bets placed on a Table.
+type Aggregator struct {
+}
+
+// New instantiates an Aggregator.
+func New() Aggregator {
+	return Aggregator{}
+}
+
+// Summarise returns the total staked, the house's liability for every position on the wheel should every ball land on
+// it and, once every Bet has been settled against the Outcomes, the house's realised profit or loss.
+func (a Aggregator) Summarise(_ context.Context, table domain.Table) domain.TableSummary {
+	staked := make(totals)
+	result := make(totals)
+	settled := table.IsSpun()
+
+	exposure := make([]totals, domain.Pockets)
+	for i := range exposure {
+		exposure[i] = make(totals)
+	}
+
+	for i := range table.Bets {
+		bet := table.Bets[i]
+		if bet.Stake == nil {
+			continue
+		}
+
+		staked.add(bet.Stake)
+
+		for position := range exposure {
+			exposure[position].add(table.Liability(bet, table.Rules.AllOn(position)...))
+		}
+
+		switch {
+		case bet.Status != domain.Settled && bet.Status != domain.Imprisoned:
+			settled = false
+		case table.IsSpun():
+			result.subtract(table.Liability(bet, table.Positions()...))
+		}
+	}
+
+	summary := domain.TableSummary{
+		TableID:     table.ID,
+		BetCount:    len(table.Bets),
+		TotalStaked: staked.money(),
+		Exposure:    make([]domain.PocketExposure, domain.Pockets),
+	}
+
+	for position := range exposure {
+		summary.Exposure[position] = domain.PocketExposure{
+			Position:  position,
+			Colour:    domain.NumbersToColours[position],
+			Liability: exposure[position].money(),
+		}
+	}
+
+	if settled {
+		summary.HouseResult = result.money()
+	}
+
+	return summary
+}
+
+// totals accumulates minor units per currency code.
+type totals map[string]int64
+
+func (t totals) add(m *money.Money) {
+	t[m.Currency().Code] += m.Amount()
+}
+
+func (t totals) subtract(m *money.Money) {
+	t[m.Currency().Code] -= m.Amount()
+}
+
+// money returns the totals ordered by currency code.
+func (t totals) money() []*money.Money {
+	codes := make([]string, 0, len(t))
+
+	for code := range t {
+		codes = append(codes, code)
+	}
+
+	sort.Strings(codes)
+
+	amounts := make([]*money.Money, len(codes))
+
+	for i := range codes {
+		amounts[i] = money.New(t[codes[i]], codes[i])
+	}
+
+	return amounts
+}
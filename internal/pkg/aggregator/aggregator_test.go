@@ -0,0 +1,322 @@
+package aggregator
+
+import (
+	"betting/internal/domain"
+	"betting/testing/opts"
+	"context"
+	"testing"
+
+	"github.com/Rhymond/go-money"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+func TestAggregator_Summarise(t *testing.T) {
+	red := []int{1, 3, 5, 7, 9, 12, 14, 16, 18, 19, 21, 23, 25, 27, 30, 32, 34, 36}
+
+	tests := []struct {
+		name          string
+		givenTable    domain.Table
+		expectedStake []*money.Money
+		expectedCount int
+		expectedPnL   []*money.Money
+		expectedPer   map[int][]*money.Money
+	}{
+		{
+			name: "given open bets in two currencies, expect totals and liability per currency",
+			givenTable: domain.Table{
+				ID: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				Bets: []domain.Bet{
+					{
+						ID:             uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+						Status:         domain.Unsettled,
+						SelectedSpaces: []int{16},
+						Stake:          money.New(100, "GBP"),
+					},
+					{
+						ID:             uuid.MustParse("28f23fc1-4a9b-4b4f-a6c2-43ad1bde83a1"),
+						Status:         domain.Unsettled,
+						SelectedSpaces: []int{16, 17},
+						Stake:          money.New(200, "GBP"),
+					},
+					{
+						ID:             uuid.MustParse("c4b39dc0-2ff4-4405-b3cb-c4f87a9c82fb"),
+						Status:         domain.Unsettled,
+						SelectedSpaces: []int{0},
+						Stake:          money.New(50, "EUR"),
+					},
+				},
+			},
+			expectedCount: 3,
+			expectedStake: []*money.Money{money.New(50, "EUR"), money.New(300, "GBP")},
+			expectedPer: map[int][]*money.Money{
+				0:  {money.New(1750, "EUR"), money.New(-300, "GBP")},
+				16: {money.New(-50, "EUR"), money.New(3500+3400, "GBP")},
+				17: {money.New(-50, "EUR"), money.New(3400-100, "GBP")},
+				1:  {money.New(-50, "EUR"), money.New(-300, "GBP")},
+			},
+		},
+		{
+			name: "given settled bets, expect the realised house result",
+			givenTable: domain.Table{
+				ID:       uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				Outcomes: []domain.Outcome{{Value: 16, Colour: domain.Red}},
+				Bets: []domain.Bet{
+					{
+						ID:             uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+						Status:         domain.Settled,
+						SelectedSpaces: []int{16, 17},
+						Stake:          money.New(100, "GBP"),
+						Win:            true,
+					},
+					{
+						ID:             uuid.MustParse("28f23fc1-4a9b-4b4f-a6c2-43ad1bde83a1"),
+						Status:         domain.Settled,
+						SelectedSpaces: []int{1},
+						Stake:          money.New(1000, "GBP"),
+					},
+				},
+			},
+			expectedCount: 2,
+			expectedStake: []*money.Money{money.New(1100, "GBP")},
+			expectedPnL:   []*money.Money{money.New(1000-1700, "GBP")},
+			expectedPer: map[int][]*money.Money{
+				16: {money.New(1700-1000, "GBP")},
+				1:  {money.New(35000-100, "GBP")},
+			},
+		},
+		{
+			name: "given a settled announced bet, expect each chip to be paid at its own odds",
+			givenTable: domain.Table{
+				ID:       uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				Outcomes: []domain.Outcome{{Value: 17, Colour: domain.Black}},
+				Bets: []domain.Bet{
+					{
+						ID:             uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+						Status:         domain.Settled,
+						SelectedSpaces: []int{1, 6, 9, 14, 17, 20, 31, 34},
+						Stake:          money.New(500, "GBP"),
+						Placements: []domain.Placement{
+							{SelectedSpaces: []int{1}, Stake: money.New(100, "GBP")},
+							{SelectedSpaces: []int{6, 9}, Stake: money.New(100, "GBP")},
+							{SelectedSpaces: []int{14, 17}, Stake: money.New(100, "GBP"), Win: true},
+							{SelectedSpaces: []int{17, 20}, Stake: money.New(100, "GBP"), Win: true},
+							{SelectedSpaces: []int{31, 34}, Stake: money.New(100, "GBP")},
+						},
+						Win: true,
+					},
+				},
+			},
+			expectedCount: 1,
+			expectedStake: []*money.Money{money.New(500, "GBP")},
+			expectedPnL:   []*money.Money{money.New(300-2*1700, "GBP")},
+			expectedPer: map[int][]*money.Money{
+				1:  {money.New(3500-400, "GBP")},
+				14: {money.New(1700-400, "GBP")},
+				17: {money.New(2*1700-300, "GBP")},
+				0:  {money.New(-500, "GBP")},
+			},
+		},
+		{
+			name: "given la partage and zero, expect the house to keep half the stake of an even money bet",
+			givenTable: domain.Table{
+				ID:       uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				Rules:    domain.Rules{Zero: domain.LaPartage},
+				Outcomes: []domain.Outcome{{Value: 0, Colour: domain.Green}},
+				Bets: []domain.Bet{
+					{
+						Status:         domain.Settled,
+						SelectedSpaces: red,
+						Stake:          money.New(100, "GBP"),
+						Refund:         money.New(50, "GBP"),
+					},
+				},
+			},
+			expectedCount: 1,
+			expectedStake: []*money.Money{money.New(100, "GBP")},
+			expectedPnL:   []*money.Money{money.New(50, "GBP")},
+			expectedPer: map[int][]*money.Money{
+				0: {money.New(-50, "GBP")},
+				1: {money.New(100, "GBP")},
+				2: {money.New(-100, "GBP")},
+			},
+		},
+		{
+			name: "given en prison and zero, expect the imprisoned stake to be held out of the house result",
+			givenTable: domain.Table{
+				ID:       uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				Rules:    domain.Rules{Zero: domain.EnPrison},
+				Outcomes: []domain.Outcome{{Value: 0, Colour: domain.Green}},
+				Bets: []domain.Bet{
+					{
+						Status:         domain.Imprisoned,
+						SelectedSpaces: red,
+						Stake:          money.New(100, "GBP"),
+						ImprisonedOn:   uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+					},
+					{
+						Status:         domain.Settled,
+						SelectedSpaces: []int{14},
+						Stake:          money.New(100, "GBP"),
+					},
+				},
+			},
+			expectedCount: 2,
+			expectedStake: []*money.Money{money.New(200, "GBP")},
+			expectedPnL:   []*money.Money{money.New(100, "GBP")},
+			expectedPer: map[int][]*money.Money{
+				0: {money.New(-100, "GBP")},
+			},
+		},
+		{
+			name: "given a bet carried from prison, expect it to owe nothing when released and its stake when lost",
+			givenTable: domain.Table{
+				ID:       uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				Rules:    domain.Rules{Zero: domain.EnPrison},
+				Outcomes: []domain.Outcome{{Value: 1, Colour: domain.Red}},
+				Bets: []domain.Bet{
+					{
+						Status:         domain.Settled,
+						SelectedSpaces: red,
+						Stake:          money.New(100, "GBP"),
+						Refund:         money.New(100, "GBP"),
+						ImprisonedOn:   uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+					},
+				},
+			},
+			expectedCount: 1,
+			expectedStake: []*money.Money{money.New(100, "GBP")},
+			expectedPnL:   []*money.Money{money.New(0, "GBP")},
+			expectedPer: map[int][]*money.Money{
+				0: {money.New(-100, "GBP")},
+				1: {money.New(0, "GBP")},
+				2: {money.New(-100, "GBP")},
+			},
+		},
+		{
+			name: "given double ball, expect a chip caught by one ball paid on half its stake and by both at squared odds",
+			givenTable: domain.Table{
+				ID:       uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				Rules:    domain.Rules{Zero: domain.NoZeroRule, Variant: domain.DoubleBall},
+				Outcomes: []domain.Outcome{{Value: 16, Colour: domain.Red}, {Value: 0, Colour: domain.Green}},
+				Bets: []domain.Bet{
+					{
+						Status:         domain.Settled,
+						SelectedSpaces: []int{16},
+						Stake:          money.New(100, "GBP"),
+						Win:            true,
+					},
+					{
+						Status:         domain.Settled,
+						SelectedSpaces: red,
+						Stake:          money.New(100, "GBP"),
+						Refund:         money.New(100, "GBP"),
+					},
+				},
+			},
+			expectedCount: 2,
+			expectedStake: []*money.Money{money.New(200, "GBP")},
+			expectedPnL:   []*money.Money{money.New(-1700, "GBP")},
+			expectedPer: map[int][]*money.Money{
+				16: {money.New(129500+300, "GBP")},
+				1:  {money.New(-100+300, "GBP")},
+				0:  {money.New(-200, "GBP")},
+			},
+		},
+		{
+			name: "given multi wheel, expect each stake spread across the wheels and paid for every wheel it catches",
+			givenTable: domain.Table{
+				ID:       uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				Rules:    domain.Rules{Zero: domain.NoZeroRule, Variant: domain.MultiWheel, Wheels: 3},
+				Outcomes: []domain.Outcome{{Value: 16, Colour: domain.Red}, {Value: 4, Colour: domain.Black}, {Value: 16, Colour: domain.Red}},
+				Bets: []domain.Bet{
+					{
+						Status:         domain.Settled,
+						SelectedSpaces: []int{16},
+						Stake:          money.New(300, "GBP"),
+						Win:            true,
+					},
+					{
+						Status:         domain.Settled,
+						SelectedSpaces: red,
+						Stake:          money.New(100, "GBP"),
+						Win:            true,
+					},
+				},
+			},
+			expectedCount: 2,
+			expectedStake: []*money.Money{money.New(400, "GBP")},
+			expectedPnL:   []*money.Money{money.New(-6900-33, "GBP")},
+			expectedPer: map[int][]*money.Money{
+				16: {money.New(10500+100, "GBP")},
+				1:  {money.New(-300+100, "GBP")},
+				0:  {money.New(-400, "GBP")},
+			},
+		},
+		{
+			name: "given an unspun lightning table, expect every straight up exposed at the greatest multiplier",
+			givenTable: domain.Table{
+				ID:    uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				Rules: domain.Rules{Zero: domain.NoZeroRule, Variant: domain.Lightning},
+				Bets: []domain.Bet{
+					{Status: domain.Unsettled, SelectedSpaces: []int{16}, Stake: money.New(100, "GBP")},
+				},
+			},
+			expectedCount: 1,
+			expectedStake: []*money.Money{money.New(100, "GBP")},
+			expectedPer: map[int][]*money.Money{
+				16: {money.New(100*domain.MaxMultiplier, "GBP")},
+				0:  {money.New(-100, "GBP")},
+			},
+		},
+		{
+			name: "given a settled lightning table, expect a struck straight up paid its multiplier and the rest reduced odds",
+			givenTable: domain.Table{
+				ID:          uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				Rules:       domain.Rules{Zero: domain.NoZeroRule, Variant: domain.Lightning},
+				Outcomes:    []domain.Outcome{{Value: 16, Colour: domain.Red}},
+				Multipliers: []domain.Multiplier{{Position: 16, Factor: 200}},
+				Bets: []domain.Bet{
+					{Status: domain.Settled, SelectedSpaces: []int{16}, Stake: money.New(100, "GBP"), Win: true},
+					{Status: domain.Settled, SelectedSpaces: []int{3}, Stake: money.New(100, "GBP")},
+					{Status: domain.Settled, SelectedSpaces: red, Stake: money.New(100, "GBP"), Win: true},
+				},
+			},
+			expectedCount: 3,
+			expectedStake: []*money.Money{money.New(300, "GBP")},
+			expectedPnL:   []*money.Money{money.New(-20000+100-100, "GBP")},
+			expectedPer: map[int][]*money.Money{
+				16: {money.New(20000-100+100, "GBP")},
+				3:  {money.New(-100+2900+100, "GBP")},
+				0:  {money.New(-300, "GBP")},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := New().Summarise(context.Background(), test.givenTable)
+
+			if !cmp.Equal(actual.BetCount, test.expectedCount) {
+				t.Fatal(cmp.Diff(actual.BetCount, test.expectedCount))
+			}
+
+			if !cmp.Equal(actual.TotalStaked, test.expectedStake, opts.MoneyComparer) {
+				t.Fatal(cmp.Diff(actual.TotalStaked, test.expectedStake, opts.MoneyComparer))
+			}
+
+			if !cmp.Equal(actual.HouseResult, test.expectedPnL, opts.MoneyComparer) {
+				t.Fatal(cmp.Diff(actual.HouseResult, test.expectedPnL, opts.MoneyComparer))
+			}
+
+			if len(actual.Exposure) != domain.Pockets {
+				t.Fatalf("expected exposure for %v pockets, got %v", domain.Pockets, len(actual.Exposure))
+			}
+
+			for position, expected := range test.expectedPer {
+				if !cmp.Equal(actual.Exposure[position].Liability, expected, opts.MoneyComparer) {
+					t.Fatal(cmp.Diff(actual.Exposure[position].Liability, expected, opts.MoneyComparer))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package ballplacer
+
+import (
+	"betting/internal/domain"
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Errors returned when selecting a placer.
+var (
+	ErrUnknownMode      = errors.New("unknown ball placer mode")
+	ErrModeNotPermitted = errors.New("ball placer mode is not permitted in production")
+	ErrInvalidPosition  = errors.New("scripted position is not on the wheel")
+)
+
+// Available modes for selecting a placer.
+const (
+	ModeRandom   = "random"
+	ModeSeeded   = "seeded"
+	ModeScripted = "scripted"
+)
+
+// ProductionEnvironment is the only environment in which ModeRandom must be used.
+const ProductionEnvironment = "production"
+
+// Provider generates the landing position of each of the given number of balls and the numbers struck on a Lightning
+// Table.
+type Provider interface {
+	GetPositions(ctx context.Context, balls int) []domain.Outcome
+	GetMultipliers(ctx context.Context) []domain.Multiplier
+}
+
+// Config holds the settings used to select a Provider.
+type Config struct {
+	Environment string
+	Mode        string
+	Seed        int64
+	Sequence    []int
+}
+
+// NewFromConfig selects the Provider described by the Config. Deterministic placers are refused in production.
+func NewFromConfig(c Config) (Provider, error) {
+	if c.Mode == "" || c.Mode == ModeRandom {
+		return New(), nil
+	}
+
+	if c.Environment == ProductionEnvironment {
+		return nil, fmt.Errorf("%v: %w", c.Mode, ErrModeNotPermitted)
+	}
+
+	switch c.Mode {
+	case ModeSeeded:
+		return NewSeeded(c.Seed), nil
+	case ModeScripted:
+		for i := range c.Sequence {
+			if _, ok := domain.NumbersToColours[c.Sequence[i]]; !ok {
+				return nil, fmt.Errorf("%v: %w", c.Sequence[i], ErrInvalidPosition)
+			}
+		}
+
+		return NewScripted(c.Sequence...), nil
+	default:
+		return nil, fmt.Errorf("%v: %w", c.Mode, ErrUnknownMode)
+	}
+}
@@ -0,0 +1,80 @@
+package ballplacer
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestNewFromConfig_Success(t *testing.T) {
+	tests := []struct {
+		name             string
+		givenConfig      Config
+		expectedProvider Provider
+	}{
+		{
+			name:             "given no mode in production, expect the random placer",
+			givenConfig:      Config{Environment: ProductionEnvironment},
+			expectedProvider: Placer{},
+		},
+		{
+			name:             "given seeded mode outside production, expect a seeded placer",
+			givenConfig:      Config{Environment: "development", Mode: ModeSeeded, Seed: 42},
+			expectedProvider: NewSeeded(42),
+		},
+		{
+			name:             "given scripted mode outside production, expect a scripted placer",
+			givenConfig:      Config{Environment: "test", Mode: ModeScripted, Sequence: []int{1, 2}},
+			expectedProvider: NewScripted(1, 2),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := NewFromConfig(test.givenConfig)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(actual, test.expectedProvider, cmpopts.IgnoreUnexported(Seeded{}, Scripted{})) {
+				t.Fatal(cmp.Diff(actual, test.expectedProvider, cmpopts.IgnoreUnexported(Seeded{}, Scripted{})))
+			}
+		})
+	}
+}
+
+func TestNewFromConfig_Fail(t *testing.T) {
+	tests := []struct {
+		name          string
+		givenConfig   Config
+		expectedError error
+	}{
+		{
+			name:          "given seeded mode in production, expect ErrModeNotPermitted",
+			givenConfig:   Config{Environment: ProductionEnvironment, Mode: ModeSeeded},
+			expectedError: ErrModeNotPermitted,
+		},
+		{
+			name:          "given an unknown mode, expect ErrUnknownMode",
+			givenConfig:   Config{Environment: "development", Mode: "loaded"},
+			expectedError: ErrUnknownMode,
+		},
+		{
+			name:          "given a scripted position off the wheel, expect ErrInvalidPosition",
+			givenConfig:   Config{Environment: "development", Mode: ModeScripted, Sequence: []int{37}},
+			expectedError: ErrInvalidPosition,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewFromConfig(test.givenConfig)
+			if err == nil {
+				t.Fatalf("expected %v, got nil", test.expectedError)
+			}
+
+			if !cmp.Equal(err, test.expectedError, cmpopts.EquateErrors()) {
+				t.Fatal(cmp.Diff(err, test.expectedError, cmpopts.EquateErrors()))
+			}
+		})
+	}
+}
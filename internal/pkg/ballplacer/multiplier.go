@@ -0,0 +1,30 @@
+package ballplacer
+
+import "betting/internal/domain"
+
+// drawMultipliers strikes between domain.MinMultipliers and domain.MaxMultipliers distinct numbers, each with a Factor
+// that is a multiple of domain.MultiplierStep from domain.MinMultiplier to domain.MaxMultiplier. intn returns a number
+// in [0, n).
+func drawMultipliers(intn func(n int) int) []domain.Multiplier {
+	count := domain.MinMultipliers + intn(domain.MaxMultipliers-domain.MinMultipliers+1)
+	steps := (domain.MaxMultiplier-domain.MinMultiplier)/domain.MultiplierStep + 1
+
+	struck := make(map[int]bool, count)
+	multipliers := make([]domain.Multiplier, 0, count)
+
+	for len(multipliers) < count {
+		position := intn(pockets)
+		if struck[position] {
+			continue
+		}
+
+		struck[position] = true
+
+		multipliers = append(multipliers, domain.Multiplier{
+			Position: position,
+			Factor:   domain.MinMultiplier + intn(steps)*domain.MultiplierStep,
+		})
+	}
+
+	return multipliers
+}
@@ -6,8 +6,6 @@ import (
 	"crypto/rand"
 	"log"
 	"math/big"
-	"strings"
-	"time"
 )
 
 // Placer generates the result of the roulette table.
@@ -19,20 +17,34 @@ func New() Placer {
 	return Placer{}
 }
 
-// GetPosition returns the domain.Outcome which is a number between 0 and 36 along with the assigned colour.
-func (p Placer) GetPosition(_ context.Context) domain.Outcome {
-	rnd, err := rand.Int(strings.NewReader(time.Now().UTC().String()), big.NewInt(36))
-	if err != nil {
-		log.Fatal("random number failed to generate")
-		return domain.Outcome{}
+// GetPositions returns a domain.Outcome for each ball, which is a number between 0 and 36 along with the assigned colour.
+func (p Placer) GetPositions(_ context.Context, balls int) []domain.Outcome {
+	outcomes := make([]domain.Outcome, balls)
+
+	for i := range outcomes {
+		position := secureIntn(pockets)
+
+		outcomes[i] = domain.Outcome{
+			Value:  position,
+			Colour: domain.NumbersToColours[position],
+		}
 	}
 
-	position := int(rnd.Int64())
+	return outcomes
+}
 
-	colour := domain.NumbersToColours[position]
+// GetMultipliers returns the numbers struck on a Lightning Table before the spin.
+func (p Placer) GetMultipliers(_ context.Context) []domain.Multiplier {
+	return drawMultipliers(secureIntn)
+}
 
-	return domain.Outcome{
-		Value:  position,
-		Colour: colour,
+// secureIntn returns a number in [0, n) drawn from the cryptographically secure source of randomness.
+func secureIntn(n int) int {
+	rnd, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		log.Fatal("random number failed to generate")
+		return 0
 	}
+
+	return int(rnd.Int64())
 }
@@ -0,0 +1,57 @@
+package ballplacer
+
+import (
+	"betting/internal/domain"
+	"context"
+	"testing"
+)
+
+func TestPlacer_GetPositions(t *testing.T) {
+	tests := []struct {
+		name       string
+		givenSpins int
+		givenBalls int
+	}{
+		{
+			name:       "given many spins, expect every pocket on the wheel to be reached",
+			givenSpins: 2000,
+			givenBalls: 1,
+		},
+		{
+			name:       "given many spins of several balls, expect every pocket on the wheel to be reached",
+			givenSpins: 1000,
+			givenBalls: 2,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			placer := New()
+			reached := make(map[int]bool, pockets)
+
+			for i := 0; i < test.givenSpins; i++ {
+				actual := placer.GetPositions(context.Background(), test.givenBalls)
+
+				if len(actual) != test.givenBalls {
+					t.Fatalf("expected %v outcomes, got %v", test.givenBalls, len(actual))
+				}
+
+				for j := range actual {
+					if actual[j].Value < 0 || actual[j].Value >= pockets {
+						t.Fatalf("expected a position on the wheel, got %v", actual[j].Value)
+					}
+
+					if actual[j].Colour != domain.NumbersToColours[actual[j].Value] {
+						t.Fatalf("expected %v to be %v, got %v", actual[j].Value, domain.NumbersToColours[actual[j].Value],
+							actual[j].Colour)
+					}
+
+					reached[actual[j].Value] = true
+				}
+			}
+
+			if len(reached) != pockets {
+				t.Fatalf("expected all %v pockets to be reached, got %v", pockets, len(reached))
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package ballplacer
+
+import (
+	"betting/internal/domain"
+	"context"
+	"sync"
+)
+
+// Scripted replays a given sequence of positions, starting again from the beginning once it has been exhausted.
+type Scripted struct {
+	positions []int
+	next      int
+	mu        sync.Mutex
+}
+
+// NewScripted instantiates a Scripted placer for the given positions.
+func NewScripted(positions ...int) *Scripted {
+	return &Scripted{
+		positions: positions,
+	}
+}
+
+// GetPositions returns the domain.Outcome for each of the next positions in the sequence, one per ball.
+func (s *Scripted) GetPositions(_ context.Context, balls int) []domain.Outcome {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	outcomes := make([]domain.Outcome, balls)
+
+	for i := range outcomes {
+		outcomes[i] = s.position()
+	}
+
+	return outcomes
+}
+
+// GetMultipliers strikes no numbers, a scripted Lightning Table pays every straight up at its reduced odds.
+func (s *Scripted) GetMultipliers(_ context.Context) []domain.Multiplier {
+	return nil
+}
+
+// position returns the domain.Outcome for the next position in the sequence, the lock must already be held.
+func (s *Scripted) position() domain.Outcome {
+	if len(s.positions) == 0 {
+		return domain.Outcome{
+			Value:  0,
+			Colour: domain.NumbersToColours[0],
+		}
+	}
+
+	position := s.positions[s.next%len(s.positions)]
+	s.next++
+
+	return domain.Outcome{
+		Value:  position,
+		Colour: domain.NumbersToColours[position],
+	}
+}
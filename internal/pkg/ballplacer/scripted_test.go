@@ -0,0 +1,48 @@
+package ballplacer
+
+import (
+	"betting/internal/domain"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestScripted_GetPositions(t *testing.T) {
+	tests := []struct {
+		name             string
+		givenPositions   []int
+		givenBalls       int
+		expectedOutcomes []domain.Outcome
+	}{
+		{
+			name:           "given a sequence, expect a ball on each position and then the sequence repeated",
+			givenPositions: []int{16, 0, 15},
+			givenBalls:     4,
+			expectedOutcomes: []domain.Outcome{
+				{Value: 16, Colour: domain.Red},
+				{Value: 0, Colour: domain.Green},
+				{Value: 15, Colour: domain.Black},
+				{Value: 16, Colour: domain.Red},
+			},
+		},
+		{
+			name:       "given no sequence, expect zero",
+			givenBalls: 1,
+			expectedOutcomes: []domain.Outcome{
+				{Value: 0, Colour: domain.Green},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := NewScripted(test.givenPositions...)
+
+			actual := s.GetPositions(context.Background(), test.givenBalls)
+
+			if !cmp.Equal(actual, test.expectedOutcomes) {
+				t.Fatal(cmp.Diff(actual, test.expectedOutcomes))
+			}
+		})
+	}
+}
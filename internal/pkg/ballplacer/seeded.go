@@ -0,0 +1,89 @@
+package ballplacer
+
+import (
+	"betting/internal/domain"
+	"context"
+	"math/rand"
+	"sync"
+)
+
+// pockets is the number of positions on a single zero wheel.
+const pockets = 37
+
+// Seeded generates reproducible results of the roulette table. Every ball spun draws its own seed from the master seed,
+// the spin seed is recorded on the domain.Outcome so a single spin can be replayed with Fixed.
+type Seeded struct {
+	rnd *rand.Rand
+	mu  sync.Mutex
+}
+
+// NewSeeded instantiates a Seeded placer for the given master seed.
+func NewSeeded(seed int64) *Seeded {
+	return &Seeded{
+		rnd: rand.New(rand.NewSource(seed)), //nolint:gosec // determinism is the point of this placer
+	}
+}
+
+// GetPositions returns the next domain.Outcome in the sequence derived from the master seed for each ball.
+func (s *Seeded) GetPositions(_ context.Context, balls int) []domain.Outcome {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	outcomes := make([]domain.Outcome, balls)
+
+	for i := range outcomes {
+		outcomes[i] = OutcomeForSeed(s.rnd.Int63())
+	}
+
+	return outcomes
+}
+
+// GetMultipliers returns the numbers struck on a Lightning Table, drawn from the master seed before the spin.
+func (s *Seeded) GetMultipliers(_ context.Context) []domain.Multiplier {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return drawMultipliers(s.rnd.Intn)
+}
+
+// Fixed always generates the domain.Outcomes of the spin seeds of a single spin, one per ball, along with the
+// Multipliers struck for it, it is used to replay a recorded spin.
+type Fixed struct {
+	multipliers []domain.Multiplier
+	seeds       []int64
+}
+
+// NewFixed instantiates a Fixed placer for the given Multipliers and spin seeds.
+func NewFixed(multipliers []domain.Multiplier, seeds ...int64) Fixed {
+	return Fixed{
+		multipliers: multipliers,
+		seeds:       seeds,
+	}
+}
+
+// GetMultipliers returns the Multipliers struck for the spin.
+func (f Fixed) GetMultipliers(_ context.Context) []domain.Multiplier {
+	return f.multipliers
+}
+
+// GetPositions returns the domain.Outcome for each spin seed, whatever the number of balls asked for.
+func (f Fixed) GetPositions(_ context.Context, _ int) []domain.Outcome {
+	outcomes := make([]domain.Outcome, len(f.seeds))
+
+	for i := range f.seeds {
+		outcomes[i] = OutcomeForSeed(f.seeds[i])
+	}
+
+	return outcomes
+}
+
+// OutcomeForSeed deterministically maps a spin seed to a domain.Outcome.
+func OutcomeForSeed(seed int64) domain.Outcome {
+	position := rand.New(rand.NewSource(seed)).Intn(pockets) //nolint:gosec // determinism is the point of this placer
+
+	return domain.Outcome{
+		Value:  position,
+		Colour: domain.NumbersToColours[position],
+		Seed:   seed,
+	}
+}
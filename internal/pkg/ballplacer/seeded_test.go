@@ -0,0 +1,160 @@
+package ballplacer
+
+import (
+	"betting/internal/domain"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSeeded_GetPositions(t *testing.T) {
+	tests := []struct {
+		name       string
+		givenSeed  int64
+		givenSpins int
+		givenBalls int
+	}{
+		{
+			name:       "given the same seed, expect the same sequence of outcomes",
+			givenSeed:  42,
+			givenSpins: 100,
+			givenBalls: 1,
+		},
+		{
+			name:       "given the same seed and several balls, expect the same sequence of outcomes for each ball",
+			givenSeed:  42,
+			givenSpins: 50,
+			givenBalls: 3,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			first := NewSeeded(test.givenSeed)
+			second := NewSeeded(test.givenSeed)
+
+			for i := 0; i < test.givenSpins; i++ {
+				expected := first.GetPositions(context.Background(), test.givenBalls)
+				actual := second.GetPositions(context.Background(), test.givenBalls)
+
+				if !cmp.Equal(actual, expected) {
+					t.Fatal(cmp.Diff(actual, expected))
+				}
+
+				if len(actual) != test.givenBalls {
+					t.Fatalf("expected %v outcomes, got %v", test.givenBalls, len(actual))
+				}
+
+				for j := range actual {
+					if actual[j].Value < 0 || actual[j].Value >= pockets {
+						t.Fatalf("expected a position on the wheel, got %v", actual[j].Value)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestFixed_GetPositions(t *testing.T) {
+	tests := []struct {
+		name       string
+		givenSeed  int64
+		givenBalls int
+	}{
+		{
+			name:       "given a spin seed from a seeded placer, expect the same outcome to be replayed",
+			givenSeed:  7,
+			givenBalls: 1,
+		},
+		{
+			name:       "given the spin seeds of several balls from a seeded placer, expect the same outcomes to be replayed",
+			givenSeed:  7,
+			givenBalls: 2,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			expected := NewSeeded(test.givenSeed).GetPositions(context.Background(), test.givenBalls)
+
+			seeds := make([]int64, len(expected))
+			for i := range expected {
+				seeds[i] = expected[i].Seed
+			}
+
+			actual := NewFixed(nil, seeds...).GetPositions(context.Background(), test.givenBalls)
+
+			if !cmp.Equal(actual, expected) {
+				t.Fatal(cmp.Diff(actual, expected))
+			}
+		})
+	}
+}
+
+func TestSeeded_GetMultipliers(t *testing.T) {
+	tests := []struct {
+		name       string
+		givenSeed  int64
+		givenSpins int
+	}{
+		{
+			name:       "given the same seed, expect the same numbers struck within the bounds of lightning",
+			givenSeed:  42,
+			givenSpins: 100,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			first := NewSeeded(test.givenSeed)
+			second := NewSeeded(test.givenSeed)
+
+			for i := 0; i < test.givenSpins; i++ {
+				expected := first.GetMultipliers(context.Background())
+				actual := second.GetMultipliers(context.Background())
+
+				if !cmp.Equal(actual, expected) {
+					t.Fatal(cmp.Diff(actual, expected))
+				}
+
+				if len(actual) < domain.MinMultipliers || len(actual) > domain.MaxMultipliers {
+					t.Fatalf("expected between %v and %v multipliers, got %v",
+						domain.MinMultipliers, domain.MaxMultipliers, len(actual))
+				}
+
+				struck := make(map[int]bool, len(actual))
+
+				for _, m := range actual {
+					if struck[m.Position] || m.Position < 0 || m.Position >= pockets {
+						t.Fatalf("expected distinct positions on the wheel, got %+v", actual)
+					}
+
+					struck[m.Position] = true
+
+					if m.Factor < domain.MinMultiplier || m.Factor > domain.MaxMultiplier || m.Factor%domain.MultiplierStep != 0 {
+						t.Fatalf("expected a factor in steps of %v, got %v", domain.MultiplierStep, m.Factor)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestFixed_GetMultipliers(t *testing.T) {
+	tests := []struct {
+		name             string
+		givenMultipliers []domain.Multiplier
+	}{
+		{
+			name:             "given the multipliers of a recorded spin, expect them to be replayed",
+			givenMultipliers: []domain.Multiplier{{Position: 17, Factor: 350}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := NewFixed(test.givenMultipliers, 7).GetMultipliers(context.Background())
+
+			if !cmp.Equal(actual, test.givenMultipliers) {
+				t.Fatal(cmp.Diff(actual, test.givenMultipliers))
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package broadcaster
+
+import (
+	"betting/internal/domain"
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// bufferSize is the number of updates held for a subscriber before further updates to it are dropped.
+const bufferSize = 16
+
+// Broadcaster fans out updates to a Table to every subscriber of that Table.
+type Broadcaster struct {
+	subscribers map[uuid.UUID]map[chan domain.Table]struct{}
+	sync.RWMutex
+}
+
+// New instantiates a Broadcaster.
+func New() *Broadcaster {
+	return &Broadcaster{
+		subscribers: make(map[uuid.UUID]map[chan domain.Table]struct{}),
+	}
+}
+
+// Subscribe returns a channel receiving every update published for the given Table. The returned function must be
+// called once the subscriber is no longer interested, it closes the channel.
+func (b *Broadcaster) Subscribe(id uuid.UUID) (<-chan domain.Table, func()) {
+	b.Lock()
+	defer b.Unlock()
+
+	updates := make(chan domain.Table, bufferSize)
+
+	if _, ok := b.subscribers[id]; !ok {
+		b.subscribers[id] = make(map[chan domain.Table]struct{})
+	}
+
+	b.subscribers[id][updates] = struct{}{}
+
+	var once sync.Once
+
+	return updates, func() {
+		once.Do(func() {
+			b.Lock()
+			defer b.Unlock()
+
+			delete(b.subscribers[id], updates)
+
+			if len(b.subscribers[id]) == 0 {
+				delete(b.subscribers, id)
+			}
+
+			close(updates)
+		})
+	}
+}
+
+// Publish sends the Table to each of its subscribers. Subscribers that have fallen behind miss the update rather than
+// hold up the publisher.
+func (b *Broadcaster) Publish(_ context.Context, table domain.Table) {
+	b.RLock()
+	defer b.RUnlock()
+
+	for updates := range b.subscribers[table.ID] {
+		select {
+		case updates <- table:
+		default:
+		}
+	}
+}
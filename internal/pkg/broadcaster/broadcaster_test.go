@@ -0,0 +1,72 @@
+package broadcaster
+
+import (
+	"betting/internal/domain"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+func TestBroadcaster_Publish(t *testing.T) {
+	tests := []struct {
+		name          string
+		givenTable    domain.Table
+		givenWatching uuid.UUID
+		expectedCount int
+	}{
+		{
+			name:          "given a subscriber to the table, expect the update to be received",
+			givenTable:    domain.Table{ID: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d")},
+			givenWatching: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+			expectedCount: 1,
+		},
+		{
+			name:          "given a subscriber to another table, expect no update",
+			givenTable:    domain.Table{ID: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d")},
+			givenWatching: uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+			expectedCount: 0,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b := New()
+
+			updates, cancel := b.Subscribe(test.givenWatching)
+
+			b.Publish(context.Background(), test.givenTable)
+
+			cancel()
+
+			var actual []domain.Table
+			for update := range updates {
+				actual = append(actual, update)
+			}
+
+			if !cmp.Equal(len(actual), test.expectedCount) {
+				t.Fatal(cmp.Diff(len(actual), test.expectedCount))
+			}
+
+			if _, ok := b.subscribers[test.givenWatching]; ok {
+				t.Fatal("expected subscriber to be removed")
+			}
+		})
+	}
+}
+
+func TestBroadcaster_Publish_SlowSubscriber(t *testing.T) {
+	b := New()
+	id := uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d")
+
+	updates, cancel := b.Subscribe(id)
+	defer cancel()
+
+	for i := 0; i < bufferSize+1; i++ {
+		b.Publish(context.Background(), domain.Table{ID: id})
+	}
+
+	if !cmp.Equal(len(updates), bufferSize) {
+		t.Fatal(cmp.Diff(len(updates), bufferSize))
+	}
+}
@@ -0,0 +1,62 @@
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock tells the time.
+type Clock interface {
+	Now() time.Time
+}
+
+// System tells the time from the system clock, in UTC.
+type System struct{}
+
+// New instantiates a System clock.
+func New() System {
+	return System{}
+}
+
+// Now returns the current time in UTC.
+func (System) Now() time.Time {
+	return time.Now().UTC()
+}
+
+// Fake tells a time of its own which only moves when it is Set or Advanced, so the times stamped by the code under test
+// can be asserted on and time-based behaviour exercised without waiting.
+type Fake struct {
+	now time.Time
+	sync.RWMutex
+}
+
+// NewFake instantiates a Fake clock at the given time.
+func NewFake(now time.Time) *Fake {
+	return &Fake{
+		now: now.UTC(),
+	}
+}
+
+// Now returns the time the Fake is at.
+func (f *Fake) Now() time.Time {
+	f.RLock()
+	defer f.RUnlock()
+
+	return f.now
+}
+
+// Set moves the Fake to the given time, which may be before the time it is at.
+func (f *Fake) Set(now time.Time) {
+	f.Lock()
+	defer f.Unlock()
+
+	f.now = now.UTC()
+}
+
+// Advance moves the Fake on by the given duration.
+func (f *Fake) Advance(d time.Duration) {
+	f.Lock()
+	defer f.Unlock()
+
+	f.now = f.now.Add(d)
+}
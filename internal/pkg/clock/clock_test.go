@@ -0,0 +1,49 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFake(t *testing.T) {
+	start := time.Date(2021, 10, 11, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		givenMove    func(f *Fake)
+		expectedTime time.Time
+	}{
+		{
+			name:         "given no move, expect the time it was created at",
+			givenMove:    func(f *Fake) {},
+			expectedTime: start,
+		},
+		{
+			name: "given an advance, expect the time moved on",
+			givenMove: func(f *Fake) {
+				f.Advance(90 * time.Second)
+			},
+			expectedTime: start.Add(90 * time.Second),
+		},
+		{
+			name: "given a set, expect the time set in UTC",
+			givenMove: func(f *Fake) {
+				f.Set(time.Date(2021, 10, 11, 9, 0, 0, 0, time.FixedZone("EDT", -4*60*60)))
+			},
+			expectedTime: time.Date(2021, 10, 11, 13, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fake := NewFake(start)
+
+			test.givenMove(fake)
+
+			if actual := fake.Now(); !cmp.Equal(actual, test.expectedTime) {
+				t.Fatal(cmp.Diff(actual, test.expectedTime))
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package correlation
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// Header carries the ID of a request and its response, the ID correlates every log entry made serving the request.
+const Header = "X-Request-ID"
+
+// maxLength bounds the correlation ID accepted from a client.
+const maxLength = 128
+
+type contextKey struct{}
+
+// Middleware associates every request with an ID, either the one given by the client or a new one, and returns it on
+// the response.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := Ensure(r.Header.Get(Header))
+
+		w.Header().Set(Header, id)
+
+		next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), id)))
+	})
+}
+
+// Ensure returns the given ID when it is usable, otherwise a new one.
+func Ensure(id string) string {
+	if id == "" || len(id) > maxLength {
+		return uuid.New().String()
+	}
+
+	return id
+}
+
+// NewContext returns a copy of ctx carrying the given correlation ID.
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the correlation ID carried by ctx, if any.
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+
+	return id
+}
@@ -0,0 +1,62 @@
+package correlation
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMiddleware(t *testing.T) {
+	tests := []struct {
+		name        string
+		givenHeader string
+		expectKept  bool
+	}{
+		{
+			name:        "given a correlation id, expect it to be kept",
+			givenHeader: "a1b2c3",
+			expectKept:  true,
+		},
+		{
+			name:        "given no correlation id, expect one to be generated",
+			givenHeader: "",
+			expectKept:  false,
+		},
+		{
+			name:        "given a correlation id that is too long, expect it to be replaced",
+			givenHeader: strings.Repeat("a", maxLength+1),
+			expectKept:  false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var actual string
+
+			handler := Middleware(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				actual = FromContext(r.Context())
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/tables", nil)
+			req.Header.Set(Header, test.givenHeader)
+
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			if actual == "" {
+				t.Fatal("expected a correlation id, got none")
+			}
+
+			if !cmp.Equal(rr.Header().Get(Header), actual) {
+				t.Fatal(cmp.Diff(rr.Header().Get(Header), actual))
+			}
+
+			if kept := actual == test.givenHeader; kept != test.expectKept {
+				t.Fatalf("expected kept to be %v, got %v", test.expectKept, kept)
+			}
+		})
+	}
+}
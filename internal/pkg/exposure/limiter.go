@@ -0,0 +1,105 @@
+package exposure
+
+import (
+	"betting/internal/domain"
+	"betting/internal/pkg/aggregator"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Rhymond/go-money"
+)
+
+// ErrLimitExceeded is returned when accepting a Bet would leave the house liable for more than the Table allows.
+var ErrLimitExceeded = errors.New("bet would exceed the table's liability limit")
+
+// Limits are the maximum liability in minor units the house accepts on a single Table, keyed by currency code.
+// Currencies without a limit are unrestricted.
+type Limits map[string]int64
+
+// Limiter rejects Bets that would raise the house's worst-case liability on a Table above its Limits.
+type Limiter struct {
+	Global     Limits
+	Variants   map[domain.Variant]Limits
+	Aggregator aggregator.Aggregator
+}
+
+// New instantiates a Limiter. The Limits of a Variant apply to every Table playing it, the global Limits to every Table
+// playing a Variant without limits of its own.
+func New(global Limits, variants map[domain.Variant]Limits) Limiter {
+	return Limiter{
+		Global:     global,
+		Variants:   variants,
+		Aggregator: aggregator.New(),
+	}
+}
+
+// Check returns ErrLimitExceeded when the house's liability on the pocket worst for it, with the given Bet added to
+// those already on the Table, exceeds the Table's limit in the Bet's currency. A Bet not staked a positive amount is
+// refused with domain.ErrInvalidStake, and any such Bet already on the Table is left out so it cannot offset the
+// liability of the others.
+func (l Limiter) Check(ctx context.Context, table domain.Table, bet domain.Bet) error {
+	err := bet.ValidateStake()
+	if err != nil {
+		return err
+	}
+
+	limit, ok := l.limitsFor(table.Rules.Variant)[bet.Stake.Currency().Code]
+	if !ok {
+		return nil
+	}
+
+	bets := make([]domain.Bet, 0, len(table.Bets)+1)
+
+	for i := range table.Bets {
+		if table.Bets[i].ValidateStake() == nil {
+			bets = append(bets, table.Bets[i])
+		}
+	}
+
+	bets = append(bets, bet)
+
+	table.Bets = bets
+
+	summary := l.Aggregator.Summarise(ctx, table)
+
+	worst := WorstCase(summary, bet.Stake.Currency().Code)
+	if worst.Amount() <= limit {
+		return nil
+	}
+
+	return fmt.Errorf("liability of %v exceeds %v: %w",
+		worst.Display(), money.New(limit, bet.Stake.Currency().Code).Display(), ErrLimitExceeded)
+}
+
+// WorstCase returns the largest liability across every pocket in the given currency.
+func WorstCase(summary domain.TableSummary, currency string) *money.Money {
+	worst := money.New(0, currency)
+
+	for i := range summary.Exposure {
+		for _, liability := range summary.Exposure[i].Liability {
+			if liability.Currency().Code != currency {
+				continue
+			}
+
+			if greater, _ := liability.GreaterThan(worst); greater {
+				worst = liability
+			}
+		}
+	}
+
+	return worst
+}
+
+// limitsFor returns the Limits of the given Variant, a Variant left empty is domain.SingleBall.
+func (l Limiter) limitsFor(variant domain.Variant) Limits {
+	if variant == "" {
+		variant = domain.SingleBall
+	}
+
+	if limits, ok := l.Variants[variant]; ok {
+		return limits
+	}
+
+	return l.Global
+}
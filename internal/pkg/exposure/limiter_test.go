@@ -0,0 +1,216 @@
+package exposure
+
+import (
+	"betting/internal/domain"
+	"context"
+	"testing"
+
+	"github.com/Rhymond/go-money"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+)
+
+func TestLimiter_Check_Success(t *testing.T) {
+	tests := []struct {
+		name          string
+		givenGlobal   Limits
+		givenVariants map[domain.Variant]Limits
+		givenTable    domain.Table
+		givenBet      domain.Bet
+	}{
+		{
+			name:        "given a bet within the global limit, expect it to be accepted",
+			givenGlobal: Limits{"GBP": 3500},
+			givenTable: domain.Table{
+				ID: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+			},
+			givenBet: domain.Bet{
+				SelectedSpaces: []int{16},
+				Stake:          money.New(100, "GBP"),
+			},
+		},
+		{
+			name:        "given a bet in a currency without a limit, expect it to be accepted",
+			givenGlobal: Limits{"GBP": 1},
+			givenTable: domain.Table{
+				ID: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+			},
+			givenBet: domain.Bet{
+				SelectedSpaces: []int{16},
+				Stake:          money.New(100000, "EUR"),
+			},
+		},
+		{
+			name:        "given a bet hedged by those on the table, expect it to be accepted",
+			givenGlobal: Limits{"GBP": 3500},
+			givenTable: domain.Table{
+				ID: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				Bets: []domain.Bet{
+					{
+						SelectedSpaces: []int{17},
+						Stake:          money.New(100, "GBP"),
+					},
+				},
+			},
+			givenBet: domain.Bet{
+				SelectedSpaces: []int{16},
+				Stake:          money.New(100, "GBP"),
+			},
+		},
+		{
+			name:        "given a variant limit above the global limit, expect the variant limit to apply",
+			givenGlobal: Limits{"GBP": 1},
+			givenVariants: map[domain.Variant]Limits{
+				domain.DoubleBall: {"GBP": 129500},
+			},
+			givenTable: domain.Table{
+				ID:    uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				Rules: domain.Rules{Variant: domain.DoubleBall},
+			},
+			givenBet: domain.Bet{
+				SelectedSpaces: []int{16},
+				Stake:          money.New(100, "GBP"),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			l := New(test.givenGlobal, test.givenVariants)
+
+			err := l.Check(context.Background(), test.givenTable, test.givenBet)
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestLimiter_Check_Fail(t *testing.T) {
+	tests := []struct {
+		name          string
+		givenGlobal   Limits
+		givenVariants map[domain.Variant]Limits
+		givenTable    domain.Table
+		givenBet      domain.Bet
+		expectedError error
+	}{
+		{
+			name:        "given a bet beyond the global limit, expect ErrLimitExceeded",
+			givenGlobal: Limits{"GBP": 3499},
+			givenTable: domain.Table{
+				ID: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+			},
+			givenBet: domain.Bet{
+				SelectedSpaces: []int{16},
+				Stake:          money.New(100, "GBP"),
+			},
+			expectedError: ErrLimitExceeded,
+		},
+		{
+			name:        "given a bet adding to exposure already on the table, expect ErrLimitExceeded",
+			givenGlobal: Limits{"GBP": 5000},
+			givenTable: domain.Table{
+				ID: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				Bets: []domain.Bet{
+					{
+						SelectedSpaces: []int{16},
+						Stake:          money.New(100, "GBP"),
+					},
+				},
+			},
+			givenBet: domain.Bet{
+				SelectedSpaces: []int{16},
+				Stake:          money.New(100, "GBP"),
+			},
+			expectedError: ErrLimitExceeded,
+		},
+		{
+			name:        "given a bet beyond the limit offset by a negative stake on the table, expect ErrLimitExceeded",
+			givenGlobal: Limits{"GBP": 1000000},
+			givenTable: domain.Table{
+				ID: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				Bets: []domain.Bet{
+					{
+						SelectedSpaces: []int{17},
+						Stake:          money.New(-10000000, "GBP"),
+					},
+				},
+			},
+			givenBet: domain.Bet{
+				SelectedSpaces: []int{17},
+				Stake:          money.New(10000000, "GBP"),
+			},
+			expectedError: ErrLimitExceeded,
+		},
+		{
+			name:        "given a negative stake, expect ErrInvalidStake",
+			givenGlobal: Limits{"GBP": 1000000},
+			givenTable: domain.Table{
+				ID: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+			},
+			givenBet: domain.Bet{
+				SelectedSpaces: []int{17},
+				Stake:          money.New(-10000000, "GBP"),
+			},
+			expectedError: domain.ErrInvalidStake,
+		},
+		{
+			name:        "given a zero stake, expect ErrInvalidStake",
+			givenGlobal: Limits{"GBP": 1000000},
+			givenTable: domain.Table{
+				ID: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+			},
+			givenBet: domain.Bet{
+				SelectedSpaces: []int{17},
+				Stake:          money.New(0, "GBP"),
+			},
+			expectedError: domain.ErrInvalidStake,
+		},
+		{
+			name:        "given a variant limit below the global limit, expect the variant limit to apply",
+			givenGlobal: Limits{"GBP": 100000},
+			givenVariants: map[domain.Variant]Limits{
+				domain.Lightning: {"GBP": 100},
+			},
+			givenTable: domain.Table{
+				ID:    uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				Rules: domain.Rules{Variant: domain.Lightning},
+			},
+			givenBet: domain.Bet{
+				SelectedSpaces: []int{16},
+				Stake:          money.New(100, "GBP"),
+			},
+			expectedError: ErrLimitExceeded,
+		},
+		{
+			name:        "given a table without a variant, expect the limit of a single ball",
+			givenGlobal: Limits{"GBP": 100000},
+			givenVariants: map[domain.Variant]Limits{
+				domain.SingleBall: {"GBP": 100},
+			},
+			givenTable: domain.Table{
+				ID: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+			},
+			givenBet: domain.Bet{
+				SelectedSpaces: []int{16},
+				Stake:          money.New(100, "GBP"),
+			},
+			expectedError: ErrLimitExceeded,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			l := New(test.givenGlobal, test.givenVariants)
+
+			err := l.Check(context.Background(), test.givenTable, test.givenBet)
+			if err == nil {
+				t.Fatalf("expected %v, got nil", test.expectedError)
+			}
+
+			if !cmp.Equal(err, test.expectedError, cmpopts.EquateErrors()) {
+				t.Fatal(cmp.Diff(err, test.expectedError, cmpopts.EquateErrors()))
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package logging
+
+import (
+	"betting/internal/pkg/correlation"
+	"context"
+	"net/http"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Fields shared by every layer so that the entries of one request can be followed through them.
+const (
+	FieldRequestID = "request_id"
+	FieldTableID   = "table_id"
+	FieldBetID     = "bet_id"
+	FieldSlipID    = "slip_id"
+	FieldID        = "id"
+)
+
+type contextKey struct{}
+
+// DefaultLevel is used when no level has been configured.
+const DefaultLevel = "info"
+
+// Configure emits JSON structured logs at the given level.
+func Configure(level string) error {
+	if level == "" {
+		level = DefaultLevel
+	}
+
+	l, err := log.ParseLevel(level)
+	if err != nil {
+		return err
+	}
+
+	log.SetFormatter(&log.JSONFormatter{})
+	log.SetLevel(l)
+
+	return nil
+}
+
+// NewContext returns a copy of ctx carrying the given logger.
+func NewContext(ctx context.Context, logger *log.Entry) context.Context {
+	return context.WithValue(ctx, contextKey{}, logger)
+}
+
+// FromContext returns the logger carried by ctx, or the standard logger when there is none.
+func FromContext(ctx context.Context) *log.Entry {
+	if logger, ok := ctx.Value(contextKey{}).(*log.Entry); ok {
+		return logger
+	}
+
+	return log.NewEntry(log.StandardLogger())
+}
+
+// Middleware carries a logger tagged with the request ID through the context of every request and logs each
+// request once it has been served.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		logger := FromContext(r.Context()).WithField(FieldRequestID, correlation.FromContext(r.Context()))
+
+		sw := &statusWriter{
+			ResponseWriter: w,
+			status:         http.StatusOK,
+		}
+
+		next.ServeHTTP(sw, r.WithContext(NewContext(r.Context(), logger)))
+
+		logger.WithFields(log.Fields{
+			"method":      r.Method,
+			"path":        r.URL.Path,
+			"status":      sw.status,
+			"duration_ms": time.Since(start).Milliseconds(),
+		}).Info("served request")
+	})
+}
+
+// statusWriter records the status written to a response.
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (s *statusWriter) WriteHeader(status int) {
+	s.status = status
+	s.ResponseWriter.WriteHeader(status)
+}
@@ -0,0 +1,97 @@
+package logging
+
+import (
+	"betting/internal/pkg/correlation"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	log "github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestConfigure(t *testing.T) {
+	tests := []struct {
+		name          string
+		givenLevel    string
+		expectedLevel log.Level
+		expectedError bool
+	}{
+		{
+			name:          "given a level, expect it to be used",
+			givenLevel:    "debug",
+			expectedLevel: log.DebugLevel,
+		},
+		{
+			name:          "given no level, expect the default to be used",
+			givenLevel:    "",
+			expectedLevel: log.InfoLevel,
+		},
+		{
+			name:          "given an unknown level, expect an error",
+			givenLevel:    "loud",
+			expectedLevel: log.InfoLevel,
+			expectedError: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			log.SetLevel(log.InfoLevel)
+			t.Cleanup(func() {
+				log.SetLevel(log.InfoLevel)
+				log.SetFormatter(&log.TextFormatter{})
+			})
+
+			err := Configure(test.givenLevel)
+			if (err != nil) != test.expectedError {
+				t.Fatalf("expected error to be %v, got %v", test.expectedError, err)
+			}
+
+			if !cmp.Equal(log.GetLevel(), test.expectedLevel) {
+				t.Fatal(cmp.Diff(log.GetLevel(), test.expectedLevel))
+			}
+		})
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	hook := test.NewGlobal()
+	t.Cleanup(hook.Reset)
+
+	handler := correlation.Middleware(Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		FromContext(r.Context()).Info("handling request")
+
+		w.WriteHeader(http.StatusTeapot)
+	})))
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/tables", nil)
+	req.Header.Set(correlation.Header, "a1b2c3")
+
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	entries := hook.AllEntries()
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %v", len(entries))
+	}
+
+	for _, entry := range entries {
+		if !cmp.Equal(entry.Data[FieldRequestID], "a1b2c3") {
+			t.Fatal(cmp.Diff(entry.Data[FieldRequestID], "a1b2c3"))
+		}
+	}
+
+	served := hook.LastEntry()
+
+	expected := log.Fields{
+		FieldRequestID: "a1b2c3",
+		"method":       http.MethodGet,
+		"path":         "/v1/tables",
+		"status":       http.StatusTeapot,
+		"duration_ms":  served.Data["duration_ms"],
+	}
+
+	if !cmp.Equal(served.Data, expected) {
+		t.Fatal(cmp.Diff(served.Data, expected))
+	}
+}
@@ -0,0 +1,85 @@
+package origin
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// Origin is the route a request was made on and the digest of its body, so a change made serving the request can be
+// traced back to it.
+type Origin struct {
+	Route    string
+	BodyHash string
+}
+
+// MaxBodySize is the largest body, in bytes, read from a request.
+const MaxBodySize = 1 << 20
+
+type contextKey struct{}
+
+// Middleware associates every request with its Origin. The route is the method and path template of the matched route,
+// or the path when none matched. The body is read to be hashed and replaced, so it can still be read by the handler, a
+// body larger than MaxBodySize is refused with http.StatusRequestEntityTooLarge.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body []byte
+
+		if r.Body != nil {
+			var err error
+
+			body, err = io.ReadAll(http.MaxBytesReader(w, r.Body, MaxBodySize))
+			if err != nil {
+				status := http.StatusBadRequest
+				if len(body) == MaxBodySize {
+					status = http.StatusRequestEntityTooLarge
+				}
+
+				http.Error(w, err.Error(), status)
+
+				return
+			}
+
+			r.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		route := r.URL.Path
+
+		if current := mux.CurrentRoute(r); current != nil {
+			if template, err := current.GetPathTemplate(); err == nil {
+				route = template
+			}
+		}
+
+		o := Origin{
+			Route:    r.Method + " " + route,
+			BodyHash: Hash(body),
+		}
+
+		next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), o)))
+	})
+}
+
+// Hash returns the hex encoded SHA-256 digest of the given body.
+func Hash(body []byte) string {
+	sum := sha256.Sum256(body)
+
+	return hex.EncodeToString(sum[:])
+}
+
+// NewContext returns a copy of ctx carrying the given Origin.
+func NewContext(ctx context.Context, o Origin) context.Context {
+	return context.WithValue(ctx, contextKey{}, o)
+}
+
+// FromContext returns the Origin carried by ctx, empty when there is none.
+func FromContext(ctx context.Context) Origin {
+	o, _ := ctx.Value(contextKey{}).(Origin)
+
+	return o
+}
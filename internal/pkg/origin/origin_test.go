@@ -0,0 +1,110 @@
+package origin
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/gorilla/mux"
+)
+
+func TestMiddleware(t *testing.T) {
+	tests := []struct {
+		name           string
+		givenMethod    string
+		givenURL       string
+		givenBody      string
+		expectedOrigin Origin
+	}{
+		{
+			name:        "given a body, expect the route template and the digest of the body",
+			givenMethod: http.MethodPost,
+			givenURL:    "/v1/tables/0173b64f-e07e-4fa0-bcb3-231856390dce/bet",
+			givenBody:   `{"selectedSpaces":[14]}`,
+			expectedOrigin: Origin{
+				Route:    "POST /v1/tables/{id}/bet",
+				BodyHash: Hash([]byte(`{"selectedSpaces":[14]}`)),
+			},
+		},
+		{
+			name:        "given no body, expect the digest of an empty body",
+			givenMethod: http.MethodPut,
+			givenURL:    "/v1/tables/0173b64f-e07e-4fa0-bcb3-231856390dce/spin",
+			expectedOrigin: Origin{
+				Route:    "PUT /v1/tables/{id}/spin",
+				BodyHash: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var actual Origin
+			var read string
+
+			handler := func(_ http.ResponseWriter, r *http.Request) {
+				actual = FromContext(r.Context())
+
+				body, _ := io.ReadAll(r.Body)
+				read = string(body)
+			}
+
+			r := mux.NewRouter()
+			r.Use(Middleware)
+			r.HandleFunc("/v1/tables/{id}/bet", handler).Methods(http.MethodPost)
+			r.HandleFunc("/v1/tables/{id}/spin", handler).Methods(http.MethodPut)
+
+			r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(test.givenMethod, test.givenURL, strings.NewReader(test.givenBody)))
+
+			if !cmp.Equal(actual, test.expectedOrigin) {
+				t.Fatal(cmp.Diff(actual, test.expectedOrigin))
+			}
+
+			if !cmp.Equal(read, test.givenBody) {
+				t.Fatal(cmp.Diff(read, test.givenBody))
+			}
+		})
+	}
+}
+
+func TestMiddleware_Fail(t *testing.T) {
+	tests := []struct {
+		name           string
+		givenBody      string
+		expectedStatus int
+	}{
+		{
+			name:           "given a body of the largest size, expect it to be served",
+			givenBody:      strings.Repeat("a", MaxBodySize),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "given a body larger than the largest size, expect it to be refused",
+			givenBody:      strings.Repeat("a", MaxBodySize+1),
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler := Middleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/v1/tables", strings.NewReader(test.givenBody)))
+
+			if !cmp.Equal(w.Code, test.expectedStatus) {
+				t.Fatal(cmp.Diff(w.Code, test.expectedStatus))
+			}
+		})
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	if actual := FromContext(context.Background()); !cmp.Equal(actual, Origin{}) {
+		t.Fatal(cmp.Diff(actual, Origin{}))
+	}
+}
@@ -2,6 +2,13 @@ package responses
 
 // Error is a JSON representation defined by https://datatracker.ietf.org/doc/html/rfc7807#section-3.1
 type Error struct {
-	Status int    `json:"status"`
-	Detail string `json:"detail"`
+	Type          string `json:"type"`
+	Title         string `json:"title"`
+	Status        int    `json:"status"`
+	Detail        string `json:"detail"`
+	Instance      string `json:"instance,omitempty"`
+	CorrelationID string `json:"correlationId,omitempty"`
 }
+
+// BlankType is the problem type of an Error described by its status alone.
+const BlankType = "about:blank"
@@ -3,8 +3,13 @@ package responses
 import (
 	"encoding/json"
 	"net/http"
+
+	log "github.com/sirupsen/logrus"
 )
 
+// ProblemContentType is the media type of an Error.
+const ProblemContentType = "application/problem+json"
+
 // JSONResponse provides setting JSON-encoded Responses.
 type JSONResponse struct {
 	w http.ResponseWriter
@@ -12,47 +17,50 @@ type JSONResponse struct {
 
 // NewJSON instantiates a JSONResponse.
 func NewJSON(w http.ResponseWriter) JSONResponse {
-	w.Header().Add("Content-Type", "application/json")
 	return JSONResponse{
 		w: w,
 	}
 }
 
-// Success sets the JSONResponse to include a http.Status and a marshalled body.
+// Success sets the JSONResponse to include a http.Status and a marshalled body. The body is marshalled before the
+// status is written so a body that cannot be marshalled is reported as a http.StatusInternalServerError instead.
 func (j JSONResponse) Success(status int, body interface{}) JSONResponse {
-	j.w.WriteHeader(status)
-
 	resBytes, err := json.Marshal(body)
 	if err != nil {
-		return j.Fail(http.StatusBadRequest, err)
-	}
+		log.Errorf("failed to marshal response: %v", err)
 
-	_, err = j.w.Write(resBytes)
-	if err != nil {
-		return j.Fail(http.StatusBadRequest, err)
+		return j.Problem(Error{
+			Type:   BlankType,
+			Title:  http.StatusText(http.StatusInternalServerError),
+			Status: http.StatusInternalServerError,
+			Detail: http.StatusText(http.StatusInternalServerError),
+		})
 	}
 
+	j.w.Header().Set("Content-Type", "application/json")
+	j.w.WriteHeader(status)
+
+	j.write(resBytes)
+
 	return j
 }
 
-// Fail sets the JSONResponse to include a http.Status and the error that was raised.
-func (j JSONResponse) Fail(status int, err error) JSONResponse {
-	j.w.WriteHeader(status)
+// Problem sets the JSONResponse to the given problem details.
+func (j JSONResponse) Problem(problem Error) JSONResponse {
+	// an Error holds only strings and an int so always marshals
+	resBytes, _ := json.Marshal(problem)
 
-	encodedErr := Error{
-		Status: status,
-		Detail: err.Error(),
-	}
+	j.w.Header().Set("Content-Type", ProblemContentType)
+	j.w.WriteHeader(problem.Status)
 
-	resBytes, er := json.Marshal(encodedErr)
-	if er != nil {
-		return j.Fail(status, er)
-	}
-
-	_, er = j.w.Write(resBytes)
-	if er != nil {
-		return j.Fail(status, er)
-	}
+	j.write(resBytes)
 
 	return j
 }
+
+func (j JSONResponse) write(b []byte) {
+	_, err := j.w.Write(b)
+	if err != nil {
+		log.Errorf("failed to write response: %v", err)
+	}
+}
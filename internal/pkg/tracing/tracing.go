@@ -0,0 +1,115 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ErrUnknownExporter is returned when the configured exporter is not one of the available exporters.
+var ErrUnknownExporter = errors.New("unknown trace exporter")
+
+// Available exporters for spans, ExporterNone discards them.
+const (
+	ExporterNone   = "none"
+	ExporterStdout = "stdout"
+	ExporterOTLP   = "otlp"
+)
+
+// InstrumentationName identifies the spans started by this service.
+const InstrumentationName = "betting"
+
+// Attribute keys shared by every layer.
+const (
+	KeyTableID       = attribute.Key("table.id")
+	KeyBetID         = attribute.Key("bet.id")
+	KeySlipID        = attribute.Key("slip.id")
+	KeyBetCount      = attribute.Key("bet.count")
+	KeyTableCount    = attribute.Key("table.count")
+	KeyOutcomeValue  = attribute.Key("outcome.value")
+	KeyOutcomeColour = attribute.Key("outcome.colour")
+	KeyEventCount    = attribute.Key("event.count")
+)
+
+// Config holds the settings used to select an exporter.
+type Config struct {
+	ServiceName string
+	Exporter    string
+	// Endpoint is the host and port of the OTLP collector, the exporter's default is used when empty.
+	Endpoint string
+	Insecure bool
+}
+
+// Shutdown flushes any spans yet to be exported and stops the exporter.
+type Shutdown func(ctx context.Context) error
+
+// Configure registers a global tracer provider exporting through the exporter described by the Config, along with
+// W3C trace context propagation.
+func Configure(ctx context.Context, c Config) (Shutdown, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var exporter sdktrace.SpanExporter
+	var err error
+
+	switch c.Exporter {
+	case "", ExporterNone:
+		return func(context.Context) error { return nil }, nil
+	case ExporterStdout:
+		exporter, err = stdouttrace.New()
+	case ExporterOTLP:
+		exporter, err = otlptracegrpc.New(ctx, otlpOptions(c)...)
+	default:
+		return nil, fmt.Errorf("%v: %w", c.Exporter, ErrUnknownExporter)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(c.ServiceName))),
+	)
+
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+func otlpOptions(c Config) []otlptracegrpc.Option {
+	var opts []otlptracegrpc.Option
+
+	if c.Endpoint != "" {
+		opts = append(opts, otlptracegrpc.WithEndpoint(c.Endpoint))
+	}
+
+	if c.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+
+	return opts
+}
+
+// Start begins a span with the given name and attributes as a child of any span carried by ctx.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(InstrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// Fail records err against the span and marks it as failed, err is returned so the caller can return it in turn.
+func Fail(span trace.Span, err error) error {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+
+	return err
+}
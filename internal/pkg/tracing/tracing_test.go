@@ -0,0 +1,109 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestConfigure_Success(t *testing.T) {
+	tests := []struct {
+		name        string
+		givenConfig Config
+	}{
+		{
+			name:        "given no exporter, expect spans to be discarded",
+			givenConfig: Config{},
+		},
+		{
+			name:        "given the none exporter, expect spans to be discarded",
+			givenConfig: Config{Exporter: ExporterNone},
+		},
+		{
+			name:        "given the stdout exporter, expect it to be configured",
+			givenConfig: Config{ServiceName: InstrumentationName, Exporter: ExporterStdout},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Cleanup(func() {
+				otel.SetTracerProvider(trace.NewNoopTracerProvider())
+			})
+
+			shutdown, err := Configure(context.Background(), test.givenConfig)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = shutdown(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestConfigure_Fail(t *testing.T) {
+	tests := []struct {
+		name          string
+		givenConfig   Config
+		expectedError error
+	}{
+		{
+			name:          "given an unknown exporter, expect ErrUnknownExporter",
+			givenConfig:   Config{Exporter: "jaeger"},
+			expectedError: ErrUnknownExporter,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Configure(context.Background(), test.givenConfig)
+
+			if !cmp.Equal(err, test.expectedError, cmpopts.EquateErrors()) {
+				t.Fatal(cmp.Diff(err, test.expectedError, cmpopts.EquateErrors()))
+			}
+		})
+	}
+}
+
+func TestFail(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() {
+		otel.SetTracerProvider(trace.NewNoopTracerProvider())
+	})
+
+	givenError := errors.New("could not locate table")
+
+	_, span := Start(context.Background(), "memory.TableStorage.Get")
+
+	err := Fail(span, givenError)
+
+	span.End()
+
+	if !cmp.Equal(err, givenError, cmpopts.EquateErrors()) {
+		t.Fatal(cmp.Diff(err, givenError, cmpopts.EquateErrors()))
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span, got %v", len(spans))
+	}
+
+	if !cmp.Equal(spans[0].Status(), sdktrace.Status{Code: codes.Error, Description: givenError.Error()}) {
+		t.Fatal(cmp.Diff(spans[0].Status(), sdktrace.Status{Code: codes.Error, Description: givenError.Error()}))
+	}
+
+	if len(spans[0].Events()) != 1 {
+		t.Fatalf("expected the error to be recorded as an event, got %v events", len(spans[0].Events()))
+	}
+}
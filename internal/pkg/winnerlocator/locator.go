@@ -3,6 +3,8 @@ package winnerlocator
 import (
 	"betting/internal/domain"
 	"context"
+
+	"github.com/Rhymond/go-money"
 )
 
 type Locator struct {
@@ -12,15 +14,44 @@ func New() Locator {
 	return Locator{}
 }
 
+// Locate marks the Bets paid winnings by the Outcomes as won, along with each chip of an announced Bet which is. Bets
+// carried into the Table are released, their Stake refunded, when they cover the Outcome and otherwise lost. An even
+// money Bet losing to zero is refunded half its Stake or imprisoned according to the Rules of the Table. A losing Bet
+// the paytable still owes part of its Stake, such as an even money Bet caught by one of two balls, is refunded it.
 func (l Locator) Locate(_ context.Context, table domain.Table) domain.Table {
+	positions := table.Positions()
+
 	for i := range table.Bets {
 		bet := table.Bets[i]
 
-		if !hasBetWon(bet.SelectedSpaces, table.Outcome) {
+		if len(bet.Placements) > 0 {
+			placements := make([]domain.Placement, len(bet.Placements))
+
+			for j := range bet.Placements {
+				placements[j] = bet.Placements[j]
+				placements[j].Win = placements[j].Wins(table.Rules, positions...)
+			}
+
+			bet.Placements = placements
+		}
+
+		if table.Imprisons(bet) {
+			bet.Status = domain.Imprisoned
+			bet.SettledAt = nil
+			bet.ImprisonedOn = table.ID
+			table.Bets[i] = bet
+
 			continue
 		}
 
-		bet.Win = true
+		if !bet.Carried(table.ID) {
+			bet.Win = wins(table.Rules, bet, positions)
+		}
+
+		liability := table.Liability(bet, positions...)
+		if !bet.Win && liability != nil && liability.Amount() > -bet.Stake.Amount() {
+			bet.Refund = money.New(bet.Stake.Amount()+liability.Amount(), bet.Stake.Currency().Code)
+		}
 
 		table.Bets[i] = bet
 	}
@@ -28,13 +59,12 @@ func (l Locator) Locate(_ context.Context, table domain.Table) domain.Table {
 	return table
 }
 
-func hasBetWon(bets []int, outcome *domain.Outcome) bool {
-	for i := range bets {
-		if bets[i] != outcome.Value {
-			continue
+// wins reports whether any chip of the Bet is paid winnings should the balls land on the given positions.
+func wins(rules domain.Rules, bet domain.Bet, positions []int) bool {
+	for _, chip := range bet.Chips() {
+		if chip.Wins(rules, positions...) {
+			return true
 		}
-
-		return true
 	}
 
 	return false
@@ -2,15 +2,21 @@ package winnerlocator
 
 import (
 	"betting/internal/domain"
+	"betting/testing/opts"
 	"context"
 	"testing"
+	"time"
 
+	"github.com/Rhymond/go-money"
 	"github.com/google/go-cmp/cmp"
 
 	"github.com/google/uuid"
 )
 
 func TestLocator_Locate(t *testing.T) {
+	red := []int{1, 3, 5, 7, 9, 12, 14, 16, 18, 19, 21, 23, 25, 27, 30, 32, 34, 36}
+	settledAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
 	tests := []struct {
 		name          string
 		givenTable    domain.Table
@@ -33,10 +39,10 @@ func TestLocator_Locate(t *testing.T) {
 					},
 				},
 				IsClosed: false,
-				Outcome: &domain.Outcome{
+				Outcomes: []domain.Outcome{{
 					Value:  16,
 					Colour: domain.Red,
-				},
+				}},
 			},
 			expectedTable: domain.Table{
 				ID: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
@@ -54,10 +60,203 @@ func TestLocator_Locate(t *testing.T) {
 					},
 				},
 				IsClosed: false,
-				Outcome: &domain.Outcome{
+				Outcomes: []domain.Outcome{{
 					Value:  16,
 					Colour: domain.Red,
+				}},
+			},
+		},
+		{
+			name: "given an announced bet, mark it and each chip covering the outcome as won",
+			givenTable: domain.Table{
+				ID: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				Bets: []domain.Bet{
+					{
+						ID:             uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+						SelectedSpaces: []int{1, 6, 9, 14, 17, 20},
+						Placements: []domain.Placement{
+							{SelectedSpaces: []int{1}},
+							{SelectedSpaces: []int{6, 9}},
+							{SelectedSpaces: []int{14, 17}},
+							{SelectedSpaces: []int{17, 20}},
+						},
+					},
+				},
+				Outcomes: []domain.Outcome{{Value: 17, Colour: domain.Black}},
+			},
+			expectedTable: domain.Table{
+				ID: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				Bets: []domain.Bet{
+					{
+						ID:             uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+						SelectedSpaces: []int{1, 6, 9, 14, 17, 20},
+						Placements: []domain.Placement{
+							{SelectedSpaces: []int{1}},
+							{SelectedSpaces: []int{6, 9}},
+							{SelectedSpaces: []int{14, 17}, Win: true},
+							{SelectedSpaces: []int{17, 20}, Win: true},
+						},
+						Win: true,
+					},
+				},
+				Outcomes: []domain.Outcome{{Value: 17, Colour: domain.Black}},
+			},
+		},
+		{
+			name: "given la partage and zero, refund half the stake of even money bets only",
+			givenTable: domain.Table{
+				ID:    uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				Rules: domain.Rules{Zero: domain.LaPartage},
+				Bets: []domain.Bet{
+					{SelectedSpaces: red, Stake: money.New(101, "GBP")},
+					{SelectedSpaces: []int{14}, Stake: money.New(100, "GBP")},
+				},
+				Outcomes: []domain.Outcome{{Value: 0, Colour: domain.Green}},
+			},
+			expectedTable: domain.Table{
+				ID:    uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				Rules: domain.Rules{Zero: domain.LaPartage},
+				Bets: []domain.Bet{
+					{SelectedSpaces: red, Stake: money.New(101, "GBP"), Refund: money.New(50, "GBP")},
+					{SelectedSpaces: []int{14}, Stake: money.New(100, "GBP")},
+				},
+				Outcomes: []domain.Outcome{{Value: 0, Colour: domain.Green}},
+			},
+		},
+		{
+			name: "given en prison and zero, imprison even money bets",
+			givenTable: domain.Table{
+				ID:    uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				Rules: domain.Rules{Zero: domain.EnPrison},
+				Bets: []domain.Bet{
+					{Status: domain.Settled, SelectedSpaces: red, Stake: money.New(100, "GBP"), SettledAt: &settledAt},
+				},
+				Outcomes: []domain.Outcome{{Value: 0, Colour: domain.Green}},
+			},
+			expectedTable: domain.Table{
+				ID:    uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				Rules: domain.Rules{Zero: domain.EnPrison},
+				Bets: []domain.Bet{
+					{
+						Status:         domain.Imprisoned,
+						SelectedSpaces: red,
+						Stake:          money.New(100, "GBP"),
+						ImprisonedOn:   uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+					},
+				},
+				Outcomes: []domain.Outcome{{Value: 0, Colour: domain.Green}},
+			},
+		},
+		{
+			name: "given no zero rule and zero, expect even money bets to lose",
+			givenTable: domain.Table{
+				Rules:    domain.Rules{Zero: domain.NoZeroRule},
+				Bets:     []domain.Bet{{SelectedSpaces: red, Stake: money.New(100, "GBP")}},
+				Outcomes: []domain.Outcome{{Value: 0, Colour: domain.Green}},
+			},
+			expectedTable: domain.Table{
+				Rules:    domain.Rules{Zero: domain.NoZeroRule},
+				Bets:     []domain.Bet{{SelectedSpaces: red, Stake: money.New(100, "GBP")}},
+				Outcomes: []domain.Outcome{{Value: 0, Colour: domain.Green}},
+			},
+		},
+		{
+			name: "given bets carried from prison, release those covering the outcome and lose the rest",
+			givenTable: domain.Table{
+				ID:    uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				Rules: domain.Rules{Zero: domain.EnPrison},
+				Bets: []domain.Bet{
+					{
+						SelectedSpaces: red,
+						Stake:          money.New(100, "GBP"),
+						ImprisonedOn:   uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+					},
+					{
+						SelectedSpaces: []int{19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36},
+						Stake:          money.New(100, "GBP"),
+						ImprisonedOn:   uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+					},
+				},
+				Outcomes: []domain.Outcome{{Value: 1, Colour: domain.Red}},
+			},
+			expectedTable: domain.Table{
+				ID:    uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				Rules: domain.Rules{Zero: domain.EnPrison},
+				Bets: []domain.Bet{
+					{
+						SelectedSpaces: red,
+						Stake:          money.New(100, "GBP"),
+						Refund:         money.New(100, "GBP"),
+						ImprisonedOn:   uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+					},
+					{
+						SelectedSpaces: []int{19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36},
+						Stake:          money.New(100, "GBP"),
+						ImprisonedOn:   uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+					},
+				},
+				Outcomes: []domain.Outcome{{Value: 1, Colour: domain.Red}},
+			},
+		},
+		{
+			name: "given double ball, mark bets paid winnings as won and refund even money bets caught by one ball",
+			givenTable: domain.Table{
+				Rules: domain.Rules{Zero: domain.NoZeroRule, Variant: domain.DoubleBall},
+				Bets: []domain.Bet{
+					{SelectedSpaces: []int{16}, Stake: money.New(100, "GBP")},
+					{SelectedSpaces: red, Stake: money.New(100, "GBP")},
+					{SelectedSpaces: []int{14}, Stake: money.New(100, "GBP")},
+				},
+				Outcomes: []domain.Outcome{{Value: 16, Colour: domain.Red}, {Value: 0, Colour: domain.Green}},
+			},
+			expectedTable: domain.Table{
+				Rules: domain.Rules{Zero: domain.NoZeroRule, Variant: domain.DoubleBall},
+				Bets: []domain.Bet{
+					{SelectedSpaces: []int{16}, Stake: money.New(100, "GBP"), Win: true},
+					{SelectedSpaces: red, Stake: money.New(100, "GBP"), Refund: money.New(100, "GBP")},
+					{SelectedSpaces: []int{14}, Stake: money.New(100, "GBP")},
+				},
+				Outcomes: []domain.Outcome{{Value: 16, Colour: domain.Red}, {Value: 0, Colour: domain.Green}},
+			},
+		},
+		{
+			name: "given multi wheel, mark bets paid winnings as won and refund what is owed to bets winning on fewer wheels",
+			givenTable: domain.Table{
+				Rules: domain.Rules{Zero: domain.NoZeroRule, Variant: domain.MultiWheel, Wheels: 3},
+				Bets: []domain.Bet{
+					{SelectedSpaces: []int{16}, Stake: money.New(300, "GBP")},
+					{SelectedSpaces: red, Stake: money.New(300, "GBP")},
+				},
+				Outcomes: []domain.Outcome{{Value: 16, Colour: domain.Red}, {Value: 4, Colour: domain.Black}, {Value: 0, Colour: domain.Green}},
+			},
+			expectedTable: domain.Table{
+				Rules: domain.Rules{Zero: domain.NoZeroRule, Variant: domain.MultiWheel, Wheels: 3},
+				Bets: []domain.Bet{
+					{SelectedSpaces: []int{16}, Stake: money.New(300, "GBP"), Win: true},
+					{SelectedSpaces: red, Stake: money.New(300, "GBP"), Refund: money.New(200, "GBP")},
+				},
+				Outcomes: []domain.Outcome{{Value: 16, Colour: domain.Red}, {Value: 4, Colour: domain.Black}, {Value: 0, Colour: domain.Green}},
+			},
+		},
+		{
+			name: "given lightning, mark straight ups covering the outcome as won whether or not it was struck",
+			givenTable: domain.Table{
+				Rules: domain.Rules{Zero: domain.NoZeroRule, Variant: domain.Lightning},
+				Bets: []domain.Bet{
+					{SelectedSpaces: []int{16}, Stake: money.New(100, "GBP")},
+					{SelectedSpaces: []int{3}, Stake: money.New(100, "GBP")},
+				},
+				Outcomes:    []domain.Outcome{{Value: 16, Colour: domain.Red}},
+				Multipliers: []domain.Multiplier{{Position: 3, Factor: 500}},
+			},
+			expectedTable: domain.Table{
+				Rules: domain.Rules{Zero: domain.NoZeroRule, Variant: domain.Lightning},
+				Bets: []domain.Bet{
+					{SelectedSpaces: []int{16}, Stake: money.New(100, "GBP"), Win: true},
+					{SelectedSpaces: []int{3}, Stake: money.New(100, "GBP")},
 				},
+				Outcomes:    []domain.Outcome{{Value: 16, Colour: domain.Red}},
+				Multipliers: []domain.Multiplier{{Position: 3, Factor: 500}},
 			},
 		},
 	}
@@ -67,8 +266,8 @@ func TestLocator_Locate(t *testing.T) {
 
 			actual := l.Locate(context.Background(), test.givenTable)
 
-			if !cmp.Equal(actual, test.expectedTable) {
-				t.Fatal(cmp.Diff(actual, test.expectedTable))
+			if !cmp.Equal(actual, test.expectedTable, opts.MoneyComparer) {
+				t.Fatal(cmp.Diff(actual, test.expectedTable, opts.MoneyComparer))
 			}
 		})
 	}
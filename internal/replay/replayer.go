@@ -0,0 +1,159 @@
+package replay
+
+import (
+	"betting/internal/bet"
+	"betting/internal/domain"
+	"betting/internal/pkg/ballplacer"
+	"betting/internal/pkg/clock"
+	"betting/internal/pkg/winnerlocator"
+	"betting/internal/table"
+	"betting/storage/memory"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// Errors returned by the Replayer.
+var (
+	ErrNoOutcome          = errors.New("recorded table has no outcome to replay")
+	ErrFailedToLoadTable  = errors.New("failed to load recorded table")
+	ErrFailedToLoadBets   = errors.New("failed to load recorded bets")
+	ErrFailedToReplaySpin = errors.New("failed to replay spin")
+	ErrFailedToSettle     = errors.New("failed to replay settlement")
+)
+
+// Result holds the recorded Table alongside its replayed settlement and any differences between the two.
+type Result struct {
+	Recorded   domain.Table
+	Replayed   domain.Table
+	Mismatches []string
+}
+
+// Reproduced reports whether the replayed settlement matches the recorded one.
+func (r Result) Reproduced() bool {
+	return len(r.Mismatches) == 0
+}
+
+// Replayer re-runs a recorded Table's Bets against the seeds of its Outcomes and the Multipliers struck for them.
+type Replayer struct {
+}
+
+// New instantiates a Replayer.
+func New() Replayer {
+	return Replayer{}
+}
+
+// Replay loads the recorded Table and its Bets into fresh storage as they were before the spin, spins with the
+// recorded seed of every ball, settles and compares the result with the recording.
+func (r Replayer) Replay(ctx context.Context, recorded domain.Table) (Result, error) {
+	if !recorded.IsSpun() {
+		return Result{}, ErrNoOutcome
+	}
+
+	seeds := make([]int64, len(recorded.Outcomes))
+
+	for i := range recorded.Outcomes {
+		seeds[i] = recorded.Outcomes[i].Seed
+	}
+
+	events := memory.NewEventStore(clock.New())
+	tableStorage := memory.NewTableStorage(events)
+	tableRepo := table.NewRepository(tableStorage)
+	betRepo := bet.NewRepository(memory.NewBetStorage(tableStorage, events, clock.New()))
+
+	err := tableRepo.Insert(ctx, domain.Table{ID: recorded.ID, Rules: recorded.Rules})
+	if err != nil {
+		return Result{}, fmt.Errorf("%v: %w", err, ErrFailedToLoadTable)
+	}
+
+	for i := range recorded.Bets {
+		b := recorded.Bets[i]
+		b.Status = domain.Unsettled
+		b.SettledAt = nil
+		b.Win = false
+		b.Refund = nil
+
+		if b.ImprisonedOn == recorded.ID {
+			b.ImprisonedOn = uuid.Nil
+		}
+
+		err = betRepo.Insert(ctx, b)
+		if err != nil {
+			return Result{}, fmt.Errorf("%v: %w", err, ErrFailedToLoadBets)
+		}
+	}
+
+	controller := table.NewController(table.ControllerParams{
+		RepositoryProvider:    tableRepo,
+		BallPlacer:            ballplacer.NewFixed(recorded.Multipliers, seeds...),
+		WinnerLocator:         winnerlocator.New(),
+		BetRepositoryProvider: betRepo,
+	})
+
+	_, err = controller.Spin(ctx, recorded.ID, domain.AnyVersion)
+	if err != nil {
+		return Result{}, fmt.Errorf("%v: %w", err, ErrFailedToReplaySpin)
+	}
+
+	replayed, err := controller.Settle(ctx, recorded.ID, domain.AnyVersion)
+	if err != nil {
+		return Result{}, fmt.Errorf("%v: %w", err, ErrFailedToSettle)
+	}
+
+	return Result{
+		Recorded:   recorded,
+		Replayed:   replayed,
+		Mismatches: compare(recorded, replayed),
+	}, nil
+}
+
+func compare(recorded, replayed domain.Table) []string {
+	var mismatches []string
+
+	if fmt.Sprint(replayed.Positions()) != fmt.Sprint(recorded.Positions()) {
+		mismatches = append(mismatches,
+			fmt.Sprintf("outcome: recorded %v, replayed %v", recorded.Positions(), replayed.Positions()))
+	}
+
+	bets := make(map[uuid.UUID]domain.Bet, len(replayed.Bets))
+
+	for i := range replayed.Bets {
+		bets[replayed.Bets[i].ID] = replayed.Bets[i]
+	}
+
+	for i := range recorded.Bets {
+		b := recorded.Bets[i]
+
+		replayedBet, ok := bets[b.ID]
+		if !ok {
+			mismatches = append(mismatches, fmt.Sprintf("bet %v: missing from replay", b.ID))
+			continue
+		}
+
+		if b.Status == domain.Settled && replayedBet.Win != b.Win {
+			mismatches = append(mismatches, fmt.Sprintf("bet %v: recorded win %v, replayed win %v", b.ID, b.Win, replayedBet.Win))
+		}
+
+		if b.Status == domain.Imprisoned && replayedBet.Status != domain.Imprisoned {
+			mismatches = append(mismatches, fmt.Sprintf("bet %v: recorded imprisoned, replayed %v", b.ID, replayedBet.Status))
+		}
+
+		if b.Status == domain.Settled && refunded(b) != refunded(replayedBet) {
+			mismatches = append(mismatches,
+				fmt.Sprintf("bet %v: recorded refund %v, replayed refund %v", b.ID, refunded(b), refunded(replayedBet)))
+		}
+	}
+
+	return mismatches
+}
+
+// refunded returns the minor units refunded to the Bet, zero when it was given nothing back.
+func refunded(b domain.Bet) int64 {
+	if b.Refund == nil {
+		return 0
+	}
+
+	return b.Refund.Amount()
+}
@@ -0,0 +1,149 @@
+package replay
+
+import (
+	"betting/internal/domain"
+	"betting/internal/pkg/ballplacer"
+	"context"
+	"testing"
+
+	"github.com/Rhymond/go-money"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+)
+
+func TestReplayer_Replay_Success(t *testing.T) {
+	outcome := ballplacer.OutcomeForSeed(1234)
+
+	tests := []struct {
+		name               string
+		givenTable         domain.Table
+		expectedMismatches []string
+	}{
+		{
+			name: "given a faithful recording, expect it to be reproduced",
+			givenTable: domain.Table{
+				ID:       uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				IsClosed: true,
+				Outcomes: []domain.Outcome{outcome},
+				Bets: []domain.Bet{
+					{
+						ID:             uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+						Status:         domain.Settled,
+						SelectedSpaces: []int{outcome.Value},
+						Stake:          money.New(100, "GBP"),
+						Win:            true,
+						Table:          uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+					},
+				},
+			},
+		},
+		{
+			name: "given a disputed recording, expect the mismatch to be reported",
+			givenTable: domain.Table{
+				ID:       uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				IsClosed: true,
+				Outcomes: []domain.Outcome{outcome},
+				Bets: []domain.Bet{
+					{
+						ID:             uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+						Status:         domain.Settled,
+						SelectedSpaces: []int{outcome.Value},
+						Stake:          money.New(100, "GBP"),
+						Win:            false,
+						Table:          uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+					},
+				},
+			},
+			expectedMismatches: []string{
+				"bet e49779f6-3507-4063-bed8-18d50174868d: recorded win false, replayed win true",
+			},
+		},
+		{
+			name: "given a bet carried from prison recorded without its release, expect the refund mismatch to be reported",
+			givenTable: domain.Table{
+				ID:       uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				IsClosed: true,
+				Outcomes: []domain.Outcome{outcome},
+				Rules:    domain.Rules{Zero: domain.EnPrison},
+				Bets: []domain.Bet{
+					{
+						ID:             uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+						Status:         domain.Settled,
+						SelectedSpaces: []int{outcome.Value},
+						Stake:          money.New(100, "GBP"),
+						ImprisonedOn:   uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
+						Table:          uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+					},
+				},
+			},
+			expectedMismatches: []string{
+				"bet e49779f6-3507-4063-bed8-18d50174868d: recorded refund 0, replayed refund 100",
+			},
+		},
+		{
+			name: "given a faithful lightning recording, expect it to be reproduced with the numbers struck",
+			givenTable: domain.Table{
+				ID:          uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				IsClosed:    true,
+				Outcomes:    []domain.Outcome{outcome},
+				Multipliers: []domain.Multiplier{{Position: outcome.Value, Factor: 300}},
+				Rules:       domain.Rules{Zero: domain.NoZeroRule, Variant: domain.Lightning},
+				Bets: []domain.Bet{
+					{
+						ID:             uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+						Status:         domain.Settled,
+						SelectedSpaces: []int{outcome.Value},
+						Stake:          money.New(100, "GBP"),
+						Win:            true,
+						Table:          uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+					},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := New().Replay(context.Background(), test.givenTable)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(actual.Mismatches, test.expectedMismatches) {
+				t.Fatal(cmp.Diff(actual.Mismatches, test.expectedMismatches))
+			}
+
+			if !cmp.Equal(actual.Replayed.Multipliers, test.givenTable.Multipliers) {
+				t.Fatal(cmp.Diff(actual.Replayed.Multipliers, test.givenTable.Multipliers))
+			}
+		})
+	}
+}
+
+func TestReplayer_Replay_Fail(t *testing.T) {
+	tests := []struct {
+		name          string
+		givenTable    domain.Table
+		expectedError error
+	}{
+		{
+			name: "given a table without an outcome, expect ErrNoOutcome",
+			givenTable: domain.Table{
+				ID: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+			},
+			expectedError: ErrNoOutcome,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := New().Replay(context.Background(), test.givenTable)
+			if err == nil {
+				t.Fatalf("expected %v, got nil", test.expectedError)
+			}
+
+			if !cmp.Equal(err, test.expectedError, cmpopts.EquateErrors()) {
+				t.Fatal(cmp.Diff(err, test.expectedError, cmpopts.EquateErrors()))
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package statistics
+
+import (
+	"betting/internal/domain"
+	"betting/internal/pkg/logging"
+	"betting/internal/pkg/tracing"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Domain errors.
+var (
+	ErrFailedToFetchStatistics = errors.New("failed to locate statistics")
+	ErrFailedToSetStatistics   = errors.New("failed to set statistics")
+)
+
+// RepositoryProvider provides both read and write operations for Statistics.
+type RepositoryProvider interface {
+	Get(ctx context.Context, series uuid.UUID) (domain.Statistics, error)
+	Set(ctx context.Context, statistics domain.Statistics) error
+}
+
+// Clock tells the time a Result was spun at when its Table does not record it.
+type Clock interface {
+	Now() time.Time
+}
+
+// Controller is responsible for the Statistics of the Results spun, both of every Table and of each series of Tables.
+type Controller struct {
+	RepositoryProvider RepositoryProvider
+	Clock              Clock
+}
+
+// NewController instantiates Controller.
+func NewController(provider RepositoryProvider, clock Clock) Controller {
+	return Controller{
+		RepositoryProvider: provider,
+		Clock:              clock,
+	}
+}
+
+// Get returns the Statistics of the given series, or of every Table when series is uuid.Nil.
+func (c Controller) Get(ctx context.Context, series uuid.UUID) (domain.Statistics, error) {
+	ctx, span := tracing.Start(ctx, "statistics.Controller.Get")
+	defer span.End()
+
+	statistics, err := c.RepositoryProvider.Get(ctx, series)
+	if err != nil {
+		return domain.Statistics{}, tracing.Fail(span, fmt.Errorf("%v: %w", err, ErrFailedToFetchStatistics))
+	}
+
+	return statistics, nil
+}
+
+// Record adds a Result per Outcome of the spun Table to the Statistics of every Table and to those of its series, each
+// spun at the time the Table was. Only the Statistics themselves are read and written, the Tables spun before are not.
+func (c Controller) Record(ctx context.Context, table domain.Table) error {
+	ctx, span := tracing.Start(ctx, "statistics.Controller.Record", tracing.KeyTableID.String(table.ID.String()))
+	defer span.End()
+
+	spunAt := c.Clock.Now()
+	if table.SpunAt != nil {
+		spunAt = *table.SpunAt
+	}
+
+	for _, series := range []uuid.UUID{uuid.Nil, table.Series} {
+		statistics, err := c.RepositoryProvider.Get(ctx, series)
+		if err != nil {
+			return tracing.Fail(span, fmt.Errorf("%v: %w", err, ErrFailedToFetchStatistics))
+		}
+
+		for i := range table.Outcomes {
+			statistics = statistics.Record(domain.Result{
+				Table:  table.ID,
+				Value:  table.Outcomes[i].Value,
+				Colour: table.Outcomes[i].Colour,
+				SpunAt: spunAt,
+			})
+		}
+
+		err = c.RepositoryProvider.Set(ctx, statistics)
+		if err != nil {
+			return tracing.Fail(span, fmt.Errorf("%v: %w", err, ErrFailedToSetStatistics))
+		}
+
+		// a Table without a series is only counted once
+		if series == table.Series {
+			break
+		}
+	}
+
+	logging.FromContext(ctx).WithField(logging.FieldTableID, table.ID).Debug("recorded results")
+
+	return nil
+}
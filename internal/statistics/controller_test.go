@@ -0,0 +1,168 @@
+package statistics
+
+import (
+	"betting/internal/domain"
+	"betting/internal/pkg/clock"
+	"betting/storage/memory"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+)
+
+var (
+	firstRound  = uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d")
+	secondRound = uuid.MustParse("f26e4a5e-1f9d-4e2a-9d1f-6d0b4c7e2a11")
+	spunAt      = time.Date(2021, 10, 11, 12, 0, 0, 0, time.UTC)
+	laterSpunAt = spunAt.Add(time.Minute)
+)
+
+func TestController_Record_Success(t *testing.T) {
+	tests := []struct {
+		name               string
+		givenTables        []domain.Table
+		givenSeries        uuid.UUID
+		expectedStatistics domain.Statistics
+		expectedHits       map[int]int
+		expectedGaps       map[int]int
+	}{
+		{
+			name: "given a spun table, expect its outcome to be recorded for every table",
+			givenTables: []domain.Table{
+				{ID: firstRound, Series: firstRound, Outcomes: []domain.Outcome{{Value: 14, Colour: domain.Red}}},
+			},
+			givenSeries: uuid.Nil,
+			expectedStatistics: domain.Statistics{
+				Spins:  1,
+				Recent: []domain.Result{{Table: firstRound, Value: 14, Colour: domain.Red, SpunAt: spunAt}},
+				Streaks: []domain.Streak{
+					{Kind: domain.ColourStreak, Value: "red", Length: 1},
+					{Kind: domain.DozenStreak, Value: "second", Length: 1},
+					{Kind: domain.ColumnStreak, Value: "second", Length: 1},
+				},
+			},
+			expectedHits: map[int]int{14: 1, 0: 0},
+			expectedGaps: map[int]int{14: 0, 0: 1},
+		},
+		{
+			name: "given rounds of a series, expect the streaks and gaps of the series",
+			givenTables: []domain.Table{
+				{ID: firstRound, Series: firstRound, Outcomes: []domain.Outcome{{Value: 1, Colour: domain.Red}}},
+				{
+					ID:       secondRound,
+					Series:   firstRound,
+					Outcomes: []domain.Outcome{{Value: 3, Colour: domain.Red}, {Value: 15, Colour: domain.Black}},
+					SpunAt:   &laterSpunAt,
+				},
+				{ID: uuid.New(), Series: uuid.New(), Outcomes: []domain.Outcome{{Value: 0, Colour: domain.Green}}},
+			},
+			givenSeries: firstRound,
+			expectedStatistics: domain.Statistics{
+				Series: firstRound,
+				Spins:  3,
+				Recent: []domain.Result{
+					{Table: secondRound, Value: 15, Colour: domain.Black, SpunAt: laterSpunAt},
+					{Table: secondRound, Value: 3, Colour: domain.Red, SpunAt: laterSpunAt},
+					{Table: firstRound, Value: 1, Colour: domain.Red, SpunAt: spunAt},
+				},
+				Streaks: []domain.Streak{
+					{Kind: domain.ColourStreak, Value: "black", Length: 1},
+					{Kind: domain.DozenStreak, Value: "second", Length: 1},
+					{Kind: domain.ColumnStreak, Value: "third", Length: 2},
+				},
+			},
+			expectedHits: map[int]int{1: 1, 3: 1, 15: 1, 0: 0},
+			expectedGaps: map[int]int{1: 2, 3: 1, 15: 0, 0: 3},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := NewController(NewRepository(memory.NewStatisticsStorage()), clock.NewFake(spunAt))
+
+			for i := range test.givenTables {
+				err := c.Record(context.Background(), test.givenTables[i])
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			actual, err := c.Get(context.Background(), test.givenSeries)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ignore := cmpopts.IgnoreFields(domain.Statistics{}, "Hits", "LastHit")
+
+			if !cmp.Equal(actual, test.expectedStatistics, ignore) {
+				t.Fatal(cmp.Diff(actual, test.expectedStatistics, ignore))
+			}
+
+			for position, expected := range test.expectedHits {
+				if !cmp.Equal(actual.HitsOf(position), expected) {
+					t.Fatal(position, cmp.Diff(actual.HitsOf(position), expected))
+				}
+			}
+
+			for position, expected := range test.expectedGaps {
+				if !cmp.Equal(actual.Gap(position), expected) {
+					t.Fatal(position, cmp.Diff(actual.Gap(position), expected))
+				}
+			}
+		})
+	}
+}
+
+func TestController_Record_Fail(t *testing.T) {
+	tests := []struct {
+		name          string
+		givenRepo     RepositoryProvider
+		expectedError error
+	}{
+		{
+			name:          "given the statistics cannot be read, expect ErrFailedToFetchStatistics",
+			givenRepo:     mockRepository{GivenGetError: errors.New("storage unavailable")},
+			expectedError: ErrFailedToFetchStatistics,
+		},
+		{
+			name:          "given the statistics cannot be written, expect ErrFailedToSetStatistics",
+			givenRepo:     mockRepository{GivenSetError: errors.New("storage unavailable")},
+			expectedError: ErrFailedToSetStatistics,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := NewController(test.givenRepo, clock.NewFake(spunAt))
+
+			err := c.Record(context.Background(), domain.Table{
+				ID:       firstRound,
+				Series:   firstRound,
+				Outcomes: []domain.Outcome{{Value: 14, Colour: domain.Red}},
+			})
+			if err == nil {
+				t.Fatalf("expected %v, got nil", test.expectedError)
+			}
+
+			if !cmp.Equal(err, test.expectedError, cmpopts.EquateErrors()) {
+				t.Fatal(cmp.Diff(err, test.expectedError, cmpopts.EquateErrors()))
+			}
+		})
+	}
+}
+
+type mockRepository struct {
+	GivenStatistics domain.Statistics
+	GivenGetError   error
+	GivenSetError   error
+}
+
+func (m mockRepository) Get(_ context.Context, _ uuid.UUID) (domain.Statistics, error) {
+	return m.GivenStatistics, m.GivenGetError
+}
+
+func (m mockRepository) Set(_ context.Context, _ domain.Statistics) error {
+	return m.GivenSetError
+}
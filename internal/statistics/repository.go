@@ -0,0 +1,64 @@
+package statistics
+
+import (
+	"betting/internal/domain"
+	"betting/internal/pkg/tracing"
+	"betting/storage"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// StorageProvider provides both read and write operations for Statistics.
+type StorageProvider interface {
+	StorageReader
+	StorageWriter
+}
+
+// StorageReader provides read operations for Statistics.
+type StorageReader interface {
+	Get(ctx context.Context, series uuid.UUID) (storage.Statistics, error)
+}
+
+// StorageWriter provides write operations for Statistics.
+type StorageWriter interface {
+	Set(ctx context.Context, statistics storage.Statistics) error
+}
+
+// Repository allows for Statistics to be stored.
+type Repository struct {
+	StorageProvider StorageProvider
+}
+
+// NewRepository instantiates a Repository.
+func NewRepository(provider StorageProvider) Repository {
+	return Repository{
+		StorageProvider: provider,
+	}
+}
+
+// Get returns the Statistics of the given series.
+func (r Repository) Get(ctx context.Context, series uuid.UUID) (domain.Statistics, error) {
+	ctx, span := tracing.Start(ctx, "statistics.Repository.Get")
+	defer span.End()
+
+	statistics, err := r.StorageProvider.Get(ctx, series)
+	if err != nil {
+		return domain.Statistics{}, tracing.Fail(span, err)
+	}
+
+	return storage.AdaptStatisticsToDomain(statistics), nil
+}
+
+// Set replaces the Statistics of their series.
+func (r Repository) Set(ctx context.Context, statistics domain.Statistics) error {
+	ctx, span := tracing.Start(ctx, "statistics.Repository.Set")
+	defer span.End()
+
+	err := r.StorageProvider.Set(ctx, storage.AdaptStatisticsFromDomain(statistics))
+	if err != nil {
+		return tracing.Fail(span, err)
+	}
+
+	return nil
+}
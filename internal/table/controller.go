@@ -2,9 +2,15 @@ package table
 
 import (
 	"betting/internal/domain"
+	"betting/internal/pkg/actor"
+	"betting/internal/pkg/clock"
+	"betting/internal/pkg/logging"
+	"betting/internal/pkg/tracing"
+	"betting/storage"
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -16,9 +22,22 @@ var (
 	ErrFailedToSpinTable    = errors.New("failed to spin table")
 	ErrFailedToSetOutcome   = errors.New("failed to set outcome on table")
 	ErrFailedToFetchTable   = errors.New("failed to locate table")
+	ErrFailedToListTables   = errors.New("failed to list tables")
 	ErrFailedToFetchBets    = errors.New("failed to locate bets")
 	ErrFailedFailedToSettle = errors.New("failed to settle bets")
+	ErrFailedToSettleTable  = errors.New("failed to settle table")
 	ErrFailedToSetWinners   = errors.New("failed to set winners")
+	ErrFailedToCarryBets    = errors.New("failed to carry imprisoned bets")
+	ErrFailedToAwardJackpot = errors.New("failed to award jackpot")
+	ErrFailedToRecordResult = errors.New("failed to record results")
+	ErrFailedToAudit        = errors.New("failed to audit change")
+	ErrVersionConflict      = errors.New("table has been modified since it was read")
+	ErrTableClosed          = errors.New("table has already been closed")
+	ErrTableSpun            = errors.New("table has already been spun")
+	ErrTableNotSpun         = errors.New("table has not been spun")
+	ErrTableSettled         = errors.New("table has already been settled")
+	ErrPreviousNotSettled   = errors.New("previous table has not been settled")
+	ErrPreviousContinued    = errors.New("previous table has already been continued")
 )
 
 // RepositoryProvider provides both read and write operations for Tables.
@@ -30,19 +49,35 @@ type RepositoryProvider interface {
 // Reader provides read operations for Tables.
 type Reader interface {
 	Get(ctx context.Context, id uuid.UUID) (domain.Table, error)
-	List(ctx context.Context) ([]domain.Table, error)
+	List(ctx context.Context, query domain.TableQuery) (domain.TablePage, error)
 }
 
 // Writer provides write operations for Tables.
 type Writer interface {
 	Insert(ctx context.Context, table domain.Table) error
-	Close(ctx context.Context, id uuid.UUID) error
-	SetOutcome(ctx context.Context, id uuid.UUID, outcome domain.Outcome) error
+	Close(ctx context.Context, id uuid.UUID, version int64, closedAt time.Time, closedBy string) error
+	SetOutcomes(
+		ctx context.Context,
+		id uuid.UUID,
+		version int64,
+		outcomes []domain.Outcome,
+		multipliers []domain.Multiplier,
+		spunAt time.Time,
+		spunBy string,
+	) error
+	Settle(ctx context.Context, id uuid.UUID, version int64, settledAt time.Time, settledBy string) error
 }
 
-// BallPlacer generates the landing position of the ball.
+// Clock tells the time each step in the lifecycle of a Table is taken.
+type Clock interface {
+	Now() time.Time
+}
+
+// BallPlacer generates the landing position of each of the given number of balls and the Multipliers struck on a
+// Lightning Table.
 type BallPlacer interface {
-	GetPosition(ctx context.Context) domain.Outcome
+	GetPositions(ctx context.Context, balls int) []domain.Outcome
+	GetMultipliers(ctx context.Context) []domain.Multiplier
 }
 
 // WinnerLocator finds all winning bets.
@@ -50,6 +85,36 @@ type WinnerLocator interface {
 	Locate(ctx context.Context, table domain.Table) domain.Table
 }
 
+// Aggregator summarises the Bets placed on a Table.
+type Aggregator interface {
+	Summarise(ctx context.Context, table domain.Table) domain.TableSummary
+}
+
+// Jackpot pays the Pool out to the winning side bets of a settled Table when it triggers the Jackpot.
+type Jackpot interface {
+	Award(ctx context.Context, table domain.Table) (domain.Table, error)
+}
+
+// History records the Outcomes of every Table once it has been spun.
+type History interface {
+	Record(ctx context.Context, table domain.Table) error
+}
+
+// Auditor records each change made to a Table, with the state of the Table before and after it.
+type Auditor interface {
+	Record(ctx context.Context, action domain.AuditAction, subject uuid.UUID, before, after interface{}) error
+}
+
+// UnitOfWork runs fn as a single unit, undoing its writes to Table, Bet and Jackpot storage should it return an error.
+type UnitOfWork interface {
+	Transact(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Notifier is told of every Table once it has been spun or settled.
+type Notifier interface {
+	Publish(ctx context.Context, table domain.Table)
+}
+
 // BetRepositoryProvider provides read and write operations for Bet storage.
 type BetRepositoryProvider interface {
 	BetRepositoryWriter
@@ -59,6 +124,7 @@ type BetRepositoryProvider interface {
 // BetRepositoryWriter provides write operations for Bet storage.
 type BetRepositoryWriter interface {
 	SetWinners(ctx context.Context, bets []domain.Bet) error
+	Carry(ctx context.Context, from, to uuid.UUID) error
 	Spin(ctx context.Context, id uuid.UUID) error
 	Settle(ctx context.Context, id uuid.UUID) error
 }
@@ -66,6 +132,7 @@ type BetRepositoryWriter interface {
 // BetRepositoryReader provides read operations for Bet storage.
 type BetRepositoryReader interface {
 	List(ctx context.Context, id uuid.UUID) ([]domain.Bet, error)
+	ListByTables(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID][]domain.Bet, error)
 }
 
 // Controller is responsible for doing business logic for a Table.
@@ -74,48 +141,211 @@ type Controller struct {
 	BallPlacer            BallPlacer
 	WinnerLocator         WinnerLocator
 	BetRepositoryProvider BetRepositoryProvider
+	Aggregator            Aggregator
+	Notifier              Notifier
+	UnitOfWork            UnitOfWork
+	Jackpot               Jackpot
+	History               History
+	Clock                 Clock
+	Auditor               Auditor
 }
 
-// ControllerParams hold the dependencies required for a Controller.
+// ControllerParams hold the dependencies required for a Controller, the Notifier, UnitOfWork, Jackpot, History, Clock and
+// Auditor are optional. Without a UnitOfWork the writes made by a failed Spin or Settle are not undone, without a
+// Jackpot it is never paid, without a History the Outcomes are not recorded, without a Clock the system clock is used and
+// without an Auditor changes are not audited.
 type ControllerParams struct {
 	RepositoryProvider    RepositoryProvider
 	BallPlacer            BallPlacer
 	WinnerLocator         WinnerLocator
 	BetRepositoryProvider BetRepositoryProvider
+	Aggregator            Aggregator
+	Notifier              Notifier
+	UnitOfWork            UnitOfWork
+	Jackpot               Jackpot
+	History               History
+	Clock                 Clock
+	Auditor               Auditor
 }
 
 // NewController instantiates Controller.
 func NewController(p ControllerParams) Controller {
+	if p.Clock == nil {
+		p.Clock = clock.New()
+	}
+
 	return Controller{
 		RepositoryProvider:    p.RepositoryProvider,
 		BallPlacer:            p.BallPlacer,
 		WinnerLocator:         p.WinnerLocator,
 		BetRepositoryProvider: p.BetRepositoryProvider,
+		Aggregator:            p.Aggregator,
+		Notifier:              p.Notifier,
+		UnitOfWork:            p.UnitOfWork,
+		Jackpot:               p.Jackpot,
+		History:               p.History,
+		Clock:                 p.Clock,
+		Auditor:               p.Auditor,
 	}
 }
 
-// Create generates a new Table in memory.
-func (c Controller) Create(ctx context.Context) (domain.Table, error) {
+// Create generates a new Table in memory with the given Rules. When previous is not uuid.Nil the Table is the next round
+// of that Table, in its Series, and the Bets imprisoned on it are carried onto the new Table, as a single unit of work.
+// The previous Table must have been settled, otherwise ErrPreviousNotSettled is returned, and is only continued once,
+// continuing it again returns ErrPreviousContinued. Otherwise the Table starts a Series of its own. Each step of the Table's lifecycle is stamped with the time of the Clock
+// and the actor of ctx, and is audited along with the changes it made.
+func (c Controller) Create(ctx context.Context, rules domain.Rules, previous uuid.UUID) (domain.Table, error) {
+	if rules.Zero == "" {
+		rules.Zero = domain.NoZeroRule
+	}
+
+	if rules.Variant == "" {
+		rules.Variant = domain.SingleBall
+	}
+
 	table := domain.Table{
-		ID:       uuid.New(),
-		Bets:     nil,
-		IsClosed: false,
+		ID:        uuid.New(),
+		Bets:      nil,
+		IsClosed:  false,
+		Rules:     rules,
+		Previous:  previous,
+		CreatedAt: c.Clock.Now(),
+		CreatedBy: actor.FromContext(ctx),
+	}
+
+	table.Series = table.ID
+
+	ctx, span := tracing.Start(ctx, "table.Controller.Create", tracing.KeyTableID.String(table.ID.String()))
+	defer span.End()
+
+	err := rules.Validate()
+	if err != nil {
+		return domain.Table{}, tracing.Fail(span, err)
+	}
+
+	logging.FromContext(ctx).WithField(logging.FieldTableID, table.ID).Debug("creating table")
+
+	err = c.transact(ctx, func(ctx context.Context) error {
+		var err error
+
+		table, err = c.create(ctx, table)
+		if err != nil {
+			return err
+		}
+
+		return c.audit(ctx, domain.CreateTable, table.ID, nil, table)
+	})
+	if err != nil {
+		return domain.Table{}, tracing.Fail(span, err)
+	}
+
+	return table, nil
+}
+
+func (c Controller) create(ctx context.Context, table domain.Table) (domain.Table, error) {
+	if table.Previous != uuid.Nil {
+		previous, err := c.get(ctx, table.Previous, domain.AnyVersion)
+		if err != nil {
+			return domain.Table{}, err
+		}
+
+		if previous.SettledAt == nil {
+			return domain.Table{}, ErrPreviousNotSettled
+		}
+
+		table.Series = previous.Series
 	}
 
 	err := c.RepositoryProvider.Insert(ctx, table)
 	if err != nil {
-		return domain.Table{}, fmt.Errorf("%v: %w", err, ErrFailedToCreateTable)
+		return domain.Table{}, wrapWrite(err, ErrFailedToCreateTable)
+	}
+
+	table.Version = domain.FirstVersion
+
+	if table.Previous == uuid.Nil {
+		return table, nil
+	}
+
+	err = c.BetRepositoryProvider.Carry(ctx, table.Previous, table.ID)
+	if err != nil {
+		return domain.Table{}, fmt.Errorf("%v: %w", err, ErrFailedToCarryBets)
+	}
+
+	bets, err := c.BetRepositoryProvider.List(ctx, table.ID)
+	if err != nil {
+		return domain.Table{}, fmt.Errorf("%v: %w", err, ErrFailedToFetchBets)
+	}
+
+	table.Bets = bets
+
+	logging.FromContext(ctx).WithField(logging.FieldTableID, table.ID).WithField("bets", len(bets)).Debug("carried imprisoned bets")
+
+	return table, nil
+}
+
+// Spin closes the Table, sets all Bets to live, strikes the Multipliers of a Lightning Table, generates an outcome per
+// ball, updates the Table with them, records them in the History, audits the change and returns updated resource. The
+// steps are run as a single unit of work, so a failure leaves the Table as it was. Unless version is domain.AnyVersion
+// the Table must be at that version, otherwise ErrVersionConflict is returned. A Table is only spun once, spinning it
+// again returns ErrTableClosed.
+func (c Controller) Spin(ctx context.Context, id uuid.UUID, version int64) (domain.Table, error) {
+	ctx, span := tracing.Start(ctx, "table.Controller.Spin", tracing.KeyTableID.String(id.String()))
+	defer span.End()
+
+	var table domain.Table
+
+	err := c.transact(ctx, func(ctx context.Context) error {
+		var err error
+
+		table, err = c.spin(ctx, id, version)
+
+		return err
+	})
+	if err != nil {
+		return domain.Table{}, tracing.Fail(span, err)
+	}
+
+	span.SetAttributes(tracing.KeyBetCount.Int(len(table.Bets)))
+
+	if table.IsSpun() {
+		colours := make([]string, len(table.Outcomes))
+
+		for i := range table.Outcomes {
+			colours[i] = table.Outcomes[i].Colour.String()
+		}
+
+		span.SetAttributes(
+			tracing.KeyOutcomeValue.IntSlice(table.Positions()),
+			tracing.KeyOutcomeColour.StringSlice(colours),
+		)
 	}
 
+	c.publish(ctx, table)
+
 	return table, nil
 }
 
-// Spin closes the Table, sets all Bets to live, generates the outcome, updates the Table with outcome and returns
-// updated resource.
-func (c Controller) Spin(ctx context.Context, id uuid.UUID) (domain.Table, error) {
-	err := c.RepositoryProvider.Close(ctx, id)
+func (c Controller) spin(ctx context.Context, id uuid.UUID, version int64) (domain.Table, error) {
+	logger := logging.FromContext(ctx).WithField(logging.FieldTableID, id)
+
+	current, err := c.get(ctx, id, version)
+	if err != nil {
+		return domain.Table{}, err
+	}
+
+	before, err := c.before(ctx, current)
 	if err != nil {
-		return domain.Table{}, fmt.Errorf("%v: %w", err, ErrFailedToCloseTable)
+		return domain.Table{}, err
+	}
+
+	now, by := c.Clock.Now(), actor.FromContext(ctx)
+
+	logger.Debug("closing table")
+
+	err = c.RepositoryProvider.Close(ctx, id, current.Version, now, by)
+	if err != nil {
+		return domain.Table{}, wrapWrite(err, ErrFailedToCloseTable)
 	}
 
 	err = c.BetRepositoryProvider.Spin(ctx, id)
@@ -123,11 +353,21 @@ func (c Controller) Spin(ctx context.Context, id uuid.UUID) (domain.Table, error
 		return domain.Table{}, fmt.Errorf("%v: %w", err, ErrFailedToSpinTable)
 	}
 
-	position := c.BallPlacer.GetPosition(ctx)
+	var multipliers []domain.Multiplier
+	if current.Rules.Variant == domain.Lightning {
+		multipliers = c.BallPlacer.GetMultipliers(ctx)
+
+		logger.WithField("multipliers", len(multipliers)).Debug("numbers struck")
+	}
+
+	outcomes := c.BallPlacer.GetPositions(ctx, current.Rules.Balls())
+
+	logger.WithField("positions", domain.Table{Outcomes: outcomes}.Positions()).Debug("balls placed")
 
-	err = c.RepositoryProvider.SetOutcome(ctx, id, position)
+	// closing the table moved it on to the next version
+	err = c.RepositoryProvider.SetOutcomes(ctx, id, current.Version+1, outcomes, multipliers, now, by)
 	if err != nil {
-		return domain.Table{}, fmt.Errorf("%v: %w", err, ErrFailedToSetOutcome)
+		return domain.Table{}, wrapWrite(err, ErrFailedToSetOutcome)
 	}
 
 	table, err := c.RepositoryProvider.Get(ctx, id)
@@ -142,26 +382,79 @@ func (c Controller) Spin(ctx context.Context, id uuid.UUID) (domain.Table, error
 
 	table.Bets = bets
 
+	if c.History != nil {
+		err = c.History.Record(ctx, table)
+		if err != nil {
+			return domain.Table{}, fmt.Errorf("%v: %w", err, ErrFailedToRecordResult)
+		}
+	}
+
+	err = c.audit(ctx, domain.SpinTable, id, before, table)
+	if err != nil {
+		return domain.Table{}, err
+	}
+
+	return table, nil
+}
+
+// Settle records when the Table was settled and by whom, updates all Bets to settled, finds all Winners (if any), pays
+// out the Jackpot should the Table trigger it, audits the change and returns the updated Table. The steps are run as a
+// single unit of work, so a failure leaves the Table and its Bets as they were. The Table must have been spun, otherwise
+// ErrTableNotSpun is returned, and only settled once, otherwise ErrTableSettled is returned. Unless version is
+// domain.AnyVersion it must be at that version, otherwise ErrVersionConflict is returned.
+func (c Controller) Settle(ctx context.Context, id uuid.UUID, version int64) (domain.Table, error) {
+	ctx, span := tracing.Start(ctx, "table.Controller.Settle", tracing.KeyTableID.String(id.String()))
+	defer span.End()
+
+	var table domain.Table
+
+	err := c.transact(ctx, func(ctx context.Context) error {
+		var err error
+
+		table, err = c.settle(ctx, id, version)
+
+		return err
+	})
+	if err != nil {
+		return domain.Table{}, tracing.Fail(span, err)
+	}
+
+	span.SetAttributes(tracing.KeyBetCount.Int(len(table.Bets)))
+
+	c.publish(ctx, table)
+
 	return table, nil
 }
 
-// Settle updates all Bets to settled, finds all Winners (if any) and returns the updated Table.
-func (c Controller) Settle(ctx context.Context, id uuid.UUID) (domain.Table, error) {
-	err := c.BetRepositoryProvider.Settle(ctx, id)
+func (c Controller) settle(ctx context.Context, id uuid.UUID, version int64) (domain.Table, error) {
+	logger := logging.FromContext(ctx).WithField(logging.FieldTableID, id)
+
+	table, err := c.get(ctx, id, version)
 	if err != nil {
-		return domain.Table{}, fmt.Errorf("%v: %w", err, ErrFailedFailedToSettle)
+		return domain.Table{}, err
 	}
 
-	table, err := c.RepositoryProvider.Get(ctx, id)
+	if !table.IsSpun() {
+		return domain.Table{}, ErrTableNotSpun
+	}
+
+	before, err := c.before(ctx, table)
 	if err != nil {
-		return domain.Table{}, fmt.Errorf("%v: %w", err, ErrFailedToFetchTable)
+		return domain.Table{}, err
 	}
 
-	table = c.WinnerLocator.Locate(ctx, table)
+	now, by := c.Clock.Now(), actor.FromContext(ctx)
 
-	err = c.BetRepositoryProvider.SetWinners(ctx, table.Bets)
+	err = c.RepositoryProvider.Settle(ctx, id, table.Version, now, by)
 	if err != nil {
-		return domain.Table{}, fmt.Errorf("%v: %w", err, ErrFailedToSetWinners)
+		return domain.Table{}, wrapWrite(err, ErrFailedToSettleTable)
+	}
+
+	logger.Debug("settling bets")
+
+	err = c.BetRepositoryProvider.Settle(ctx, id)
+	if err != nil {
+		return domain.Table{}, fmt.Errorf("%v: %w", err, ErrFailedFailedToSettle)
 	}
 
 	bets, err := c.BetRepositoryProvider.List(ctx, table.ID)
@@ -171,16 +464,139 @@ func (c Controller) Settle(ctx context.Context, id uuid.UUID) (domain.Table, err
 
 	table.Bets = bets
 
+	table = c.WinnerLocator.Locate(ctx, table)
+
+	logger.WithField("bets", len(table.Bets)).Debug("located winners")
+
+	if c.Jackpot != nil {
+		table, err = c.Jackpot.Award(ctx, table)
+		if err != nil {
+			return domain.Table{}, fmt.Errorf("%v: %w", err, ErrFailedToAwardJackpot)
+		}
+	}
+
+	err = c.BetRepositoryProvider.SetWinners(ctx, table.Bets)
+	if err != nil {
+		return domain.Table{}, wrapWrite(err, ErrFailedToSetWinners)
+	}
+
+	// settling the table moved it on to the next version
+	table.SettledAt, table.SettledBy = &now, by
+	table.Version++
+
+	err = c.audit(ctx, domain.SettleTable, id, before, table)
+	if err != nil {
+		return domain.Table{}, err
+	}
+
 	return table, nil
 }
 
 // Get retrieves a Table for a given ID.
 func (c Controller) Get(ctx context.Context, id uuid.UUID) (domain.Table, error) {
+	ctx, span := tracing.Start(ctx, "table.Controller.Get", tracing.KeyTableID.String(id.String()))
+	defer span.End()
+
+	table, err := c.RepositoryProvider.Get(ctx, id)
+	if err != nil {
+		return domain.Table{}, tracing.Fail(span, fmt.Errorf("%v: %w", err, ErrFailedToFetchTable))
+	}
+
+	bets, err := c.BetRepositoryProvider.List(ctx, table.ID)
+	if err != nil {
+		return domain.Table{}, tracing.Fail(span, fmt.Errorf("%v: %w", err, ErrFailedToFetchBets))
+	}
+
+	table.Bets = bets
+
+	span.SetAttributes(tracing.KeyBetCount.Int(len(bets)))
+
+	return table, nil
+}
+
+// Summary aggregates the Bets of a Table into its stakes, exposure per pocket and, once settled, the house's result.
+func (c Controller) Summary(ctx context.Context, id uuid.UUID) (domain.TableSummary, error) {
+	ctx, span := tracing.Start(ctx, "table.Controller.Summary", tracing.KeyTableID.String(id.String()))
+	defer span.End()
+
+	table, err := c.Get(ctx, id)
+	if err != nil {
+		return domain.TableSummary{}, tracing.Fail(span, err)
+	}
+
+	return c.Aggregator.Summarise(ctx, table), nil
+}
+
+// List returns a page of the Tables matching the query along with their associated Bets, unless the query omits them.
+func (c Controller) List(ctx context.Context, query domain.TableQuery) (domain.TablePage, error) {
+	ctx, span := tracing.Start(ctx, "table.Controller.List")
+	defer span.End()
+
+	page, err := c.RepositoryProvider.List(ctx, query)
+	if err != nil {
+		return domain.TablePage{}, tracing.Fail(span, fmt.Errorf("%v: %w", err, ErrFailedToListTables))
+	}
+
+	span.SetAttributes(tracing.KeyTableCount.Int(len(page.Tables)))
+
+	if query.OmitBets {
+		return page, nil
+	}
+
+	ids := make([]uuid.UUID, len(page.Tables))
+
+	for i := range page.Tables {
+		ids[i] = page.Tables[i].ID
+	}
+
+	bets, err := c.BetRepositoryProvider.ListByTables(ctx, ids)
+	if err != nil {
+		return domain.TablePage{}, tracing.Fail(span, fmt.Errorf("%v: %w", err, ErrFailedToFetchBets))
+	}
+
+	for i := range page.Tables {
+		page.Tables[i].Bets = bets[page.Tables[i].ID]
+	}
+
+	return page, nil
+}
+
+func (c Controller) publish(ctx context.Context, table domain.Table) {
+	if c.Notifier == nil {
+		return
+	}
+
+	c.Notifier.Publish(ctx, table)
+}
+
+func (c Controller) transact(ctx context.Context, fn func(ctx context.Context) error) error {
+	if c.UnitOfWork == nil {
+		return fn(ctx)
+	}
+
+	return c.UnitOfWork.Transact(ctx, fn)
+}
+
+// get retrieves the Table for the given ID, which must be at the given version unless it is domain.AnyVersion.
+func (c Controller) get(ctx context.Context, id uuid.UUID, version int64) (domain.Table, error) {
 	table, err := c.RepositoryProvider.Get(ctx, id)
 	if err != nil {
 		return domain.Table{}, fmt.Errorf("%v: %w", err, ErrFailedToFetchTable)
 	}
 
+	if version != domain.AnyVersion && table.Version != version {
+		return domain.Table{}, fmt.Errorf("expected version %v, got %v: %w", version, table.Version, ErrVersionConflict)
+	}
+
+	return table, nil
+}
+
+// before returns the Table as it is ahead of a change, along with its Bets, for the change to be audited against.
+func (c Controller) before(ctx context.Context, table domain.Table) (domain.Table, error) {
+	if c.Auditor == nil {
+		return table, nil
+	}
+
 	bets, err := c.BetRepositoryProvider.List(ctx, table.ID)
 	if err != nil {
 		return domain.Table{}, fmt.Errorf("%v: %w", err, ErrFailedToFetchBets)
@@ -191,21 +607,37 @@ func (c Controller) Get(ctx context.Context, id uuid.UUID) (domain.Table, error)
 	return table, nil
 }
 
-// List returns all Tables and their associated Bets.
-func (c Controller) List(ctx context.Context) ([]domain.Table, error) {
-	tables, err := c.RepositoryProvider.List(ctx)
+// audit records the change made to the Table by the given action with the Auditor, if there is one.
+func (c Controller) audit(ctx context.Context, action domain.AuditAction, id uuid.UUID, before, after interface{}) error {
+	if c.Auditor == nil {
+		return nil
+	}
+
+	err := c.Auditor.Record(ctx, action, id, before, after)
 	if err != nil {
-		return nil, fmt.Errorf("%v: %w", err, ErrFailedToFetchTable)
+		return fmt.Errorf("%v: %w", err, ErrFailedToAudit)
 	}
 
-	for i := range tables {
-		bets, err := c.BetRepositoryProvider.List(ctx, tables[i].ID)
-		if err != nil {
-			continue
-		}
+	return nil
+}
 
-		tables[i].Bets = bets
+// wrapWrite annotates an error from a write with the given error, or with ErrVersionConflict when the write lost a
+// race with another and with the matching lifecycle error when the Table had already moved past the step written.
+func wrapWrite(err error, target error) error {
+	switch {
+	case errors.Is(err, storage.ErrVersionConflict):
+		target = ErrVersionConflict
+	case errors.Is(err, storage.ErrTableClosed):
+		target = ErrTableClosed
+	case errors.Is(err, storage.ErrTableSpun):
+		target = ErrTableSpun
+	case errors.Is(err, storage.ErrTableNotSpun):
+		target = ErrTableNotSpun
+	case errors.Is(err, storage.ErrTableSettled):
+		target = ErrTableSettled
+	case errors.Is(err, storage.ErrTableContinued):
+		target = ErrPreviousContinued
 	}
 
-	return tables, nil
+	return fmt.Errorf("%v: %w", err, target)
 }
@@ -1,16 +1,37 @@
 package table
 
 import (
+	"betting/internal/bet"
 	"betting/internal/domain"
+	"betting/internal/pkg/actor"
+	"betting/internal/pkg/clock"
+	"betting/internal/pkg/exposure"
+	"betting/internal/pkg/tracing"
+	"betting/storage"
+	"betting/storage/memory"
+	"betting/testing/opts"
 	"context"
+	"errors"
+	"math"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/Rhymond/go-money"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/google/uuid"
 )
 
+var stampedAt = time.Date(2021, 10, 11, 12, 0, 0, 0, time.UTC)
+
 func TestController_Create_Success(t *testing.T) {
 	tests := []struct {
 		name               string
@@ -18,6 +39,9 @@ func TestController_Create_Success(t *testing.T) {
 		givenBetRepository BetRepositoryProvider
 		givenBallPlacer    BallPlacer
 		givenLocator       WinnerLocator
+		givenRules         domain.Rules
+		givenPrevious      uuid.UUID
+		expectedSeries     uuid.UUID
 		expectedTable      domain.Table
 	}{
 		{
@@ -27,9 +51,70 @@ func TestController_Create_Success(t *testing.T) {
 			givenBallPlacer:    mockBallPlacer{},
 			givenLocator:       mockLocator{},
 			expectedTable: domain.Table{
-				Bets:     nil,
-				IsClosed: false,
-				Outcome:  nil,
+				CreatedAt: stampedAt,
+				CreatedBy: "croupier",
+				Version:   domain.FirstVersion,
+				Bets:      nil,
+				IsClosed:  false,
+				Rules:     domain.Rules{Zero: domain.NoZeroRule, Variant: domain.SingleBall},
+			},
+		},
+		{
+			name:               "given en prison rules, expect the table to be created with them",
+			givenRepository:    mockTableRepositoryProvider{},
+			givenBetRepository: mockBetRepository{},
+			givenRules:         domain.Rules{Zero: domain.EnPrison},
+			expectedTable: domain.Table{
+				CreatedAt: stampedAt,
+				CreatedBy: "croupier",
+				Version:   domain.FirstVersion,
+				Rules:     domain.Rules{Zero: domain.EnPrison, Variant: domain.SingleBall},
+			},
+		},
+		{
+			name:               "given multi wheel rules, expect the table to be created with them",
+			givenRepository:    mockTableRepositoryProvider{},
+			givenBetRepository: mockBetRepository{},
+			givenRules:         domain.Rules{Variant: domain.MultiWheel, Wheels: 4},
+			expectedTable: domain.Table{
+				CreatedAt: stampedAt,
+				CreatedBy: "croupier",
+				Version:   domain.FirstVersion,
+				Rules:     domain.Rules{Zero: domain.NoZeroRule, Variant: domain.MultiWheel, Wheels: 4},
+			},
+		},
+		{
+			name: "given a previous table, expect its imprisoned bets to be carried onto the table",
+			givenRepository: mockTableRepositoryProvider{
+				GivenGetTable: domain.Table{
+					ID:        uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+					IsClosed:  true,
+					SettledAt: &stampedAt,
+					Series:    uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
+				},
+			},
+			givenBetRepository: mockBetRepository{
+				GivenListBets: []domain.Bet{
+					{
+						Status:         domain.Imprisoned,
+						SelectedSpaces: []int{1, 3, 5, 7, 9, 12, 14, 16, 18, 19, 21, 23, 25, 27, 30, 32, 34, 36},
+					},
+				},
+			},
+			givenRules:     domain.Rules{Zero: domain.EnPrison},
+			givenPrevious:  uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+			expectedSeries: uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce"),
+			expectedTable: domain.Table{
+				CreatedAt: stampedAt,
+				CreatedBy: "croupier",
+				Version:   domain.FirstVersion,
+				Bets: []domain.Bet{
+					{
+						Status:         domain.Imprisoned,
+						SelectedSpaces: []int{1, 3, 5, 7, 9, 12, 14, 16, 18, 19, 21, 23, 25, 27, 30, 32, 34, 36},
+					},
+				},
+				Rules: domain.Rules{Zero: domain.EnPrison, Variant: domain.SingleBall},
 			},
 		},
 	}
@@ -40,9 +125,12 @@ func TestController_Create_Success(t *testing.T) {
 				BallPlacer:            test.givenBallPlacer,
 				WinnerLocator:         test.givenLocator,
 				BetRepositoryProvider: test.givenBetRepository,
+				Clock:                 clock.NewFake(stampedAt),
 			})
 
-			actual, err := controller.Create(context.Background())
+			ctx := actor.NewContext(context.Background(), "croupier")
+
+			actual, err := controller.Create(ctx, test.givenRules, test.givenPrevious)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -50,6 +138,19 @@ func TestController_Create_Success(t *testing.T) {
 			if !cmp.Equal(actual, test.expectedTable, cmpopts.IgnoreTypes(uuid.UUID{})) {
 				t.Fatal(cmp.Diff(actual, test.expectedTable, cmpopts.IgnoreTypes(uuid.UUID{})))
 			}
+
+			if !cmp.Equal(actual.Previous, test.givenPrevious) {
+				t.Fatal(cmp.Diff(actual.Previous, test.givenPrevious))
+			}
+
+			expectedSeries := test.expectedSeries
+			if expectedSeries == uuid.Nil {
+				expectedSeries = actual.ID
+			}
+
+			if !cmp.Equal(actual.Series, expectedSeries) {
+				t.Fatal(cmp.Diff(actual.Series, expectedSeries))
+			}
 		})
 	}
 }
@@ -61,6 +162,9 @@ func TestController_Create_Fail(t *testing.T) {
 		givenBetRepository BetRepositoryProvider
 		givenBallPlacer    BallPlacer
 		givenLocator       WinnerLocator
+		givenRules         domain.Rules
+		givenPrevious      uuid.UUID
+		givenAuditor       Auditor
 		expectedError      error
 	}{
 		{
@@ -71,6 +175,78 @@ func TestController_Create_Fail(t *testing.T) {
 			givenBallPlacer: mockBallPlacer{},
 			expectedError:   ErrFailedToCreateTable,
 		},
+		{
+			name:            "given an unknown zero rule, expect invalid rules",
+			givenRepository: mockTableRepositoryProvider{},
+			givenRules:      domain.Rules{Zero: "surrender"},
+			expectedError:   domain.ErrInvalidRules,
+		},
+		{
+			name:            "given an unknown variant, expect invalid rules",
+			givenRepository: mockTableRepositoryProvider{},
+			givenRules:      domain.Rules{Variant: "triple_ball"},
+			expectedError:   domain.ErrInvalidRules,
+		},
+		{
+			name:            "given more wheels than may be spun, expect invalid rules",
+			givenRepository: mockTableRepositoryProvider{},
+			givenRules:      domain.Rules{Variant: domain.MultiWheel, Wheels: domain.MaxWheels + 1},
+			expectedError:   domain.ErrInvalidRules,
+		},
+		{
+			name:            "given wheels without the multi wheel variant, expect invalid rules",
+			givenRepository: mockTableRepositoryProvider{},
+			givenRules:      domain.Rules{Variant: domain.DoubleBall, Wheels: 2},
+			expectedError:   domain.ErrInvalidRules,
+		},
+		{
+			name:            "given la partage with two balls, expect invalid rules",
+			givenRepository: mockTableRepositoryProvider{},
+			givenRules:      domain.Rules{Zero: domain.LaPartage, Variant: domain.DoubleBall},
+			expectedError:   domain.ErrInvalidRules,
+		},
+		{
+			name: "given a previous table that does not exist, expect failed to fetch table",
+			givenRepository: mockTableRepositoryProvider{
+				GivenGetError: memory.ErrNoTables,
+			},
+			givenPrevious: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+			expectedError: ErrFailedToFetchTable,
+		},
+		{
+			name: "given the bets fail to be carried, expect failed to carry bets",
+			givenRepository: mockTableRepositoryProvider{
+				GivenGetTable: domain.Table{SettledAt: &stampedAt},
+			},
+			givenBetRepository: mockBetRepository{
+				GivenCarryError: memory.ErrNoTables,
+			},
+			givenPrevious: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+			expectedError: ErrFailedToCarryBets,
+		},
+		{
+			name: "given a previous table that has not been settled, expect ErrPreviousNotSettled",
+			givenRepository: mockTableRepositoryProvider{
+				GivenGetTable: domain.Table{IsClosed: true, Outcomes: []domain.Outcome{{Value: 0, Colour: domain.Green}}},
+			},
+			givenPrevious: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+			expectedError: ErrPreviousNotSettled,
+		},
+		{
+			name: "given a previous table that has already been continued, expect ErrPreviousContinued",
+			givenRepository: mockTableRepositoryProvider{
+				GivenGetTable:    domain.Table{SettledAt: &stampedAt},
+				GivenInsertError: storage.ErrTableContinued,
+			},
+			givenPrevious: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+			expectedError: ErrPreviousContinued,
+		},
+		{
+			name:            "given an audit error, expect error to be returned",
+			givenRepository: mockTableRepositoryProvider{},
+			givenAuditor:    &mockAuditor{GivenError: errors.New("storage unavailable")},
+			expectedError:   ErrFailedToAudit,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -79,9 +255,10 @@ func TestController_Create_Fail(t *testing.T) {
 				BallPlacer:            test.givenBallPlacer,
 				WinnerLocator:         test.givenLocator,
 				BetRepositoryProvider: test.givenBetRepository,
+				Auditor:               test.givenAuditor,
 			})
 
-			_, err := controller.Create(context.Background())
+			_, err := controller.Create(context.Background(), test.givenRules, test.givenPrevious)
 			if err == nil {
 				t.Fatalf("expected %v, got nil", test.expectedError)
 			}
@@ -93,6 +270,44 @@ func TestController_Create_Fail(t *testing.T) {
 	}
 }
 
+func TestController_Create_InvalidRules(t *testing.T) {
+	tests := []struct {
+		name          string
+		givenRules    domain.Rules
+		expectedError string
+	}{
+		{
+			name:          "given an unknown variant, expect it to be named",
+			givenRules:    domain.Rules{Variant: "triple_ball"},
+			expectedError: "variant triple_ball: table rules are not valid",
+		},
+		{
+			name:          "given wheels without the multi wheel variant, expect them to be named",
+			givenRules:    domain.Rules{Variant: domain.DoubleBall, Wheels: 2},
+			expectedError: "2 wheels with variant double_ball: table rules are not valid",
+		},
+		{
+			name:          "given la partage with two balls, expect both to be named",
+			givenRules:    domain.Rules{Zero: domain.LaPartage, Variant: domain.DoubleBall},
+			expectedError: "zero rule la_partage with 2 balls: table rules are not valid",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := NewController(ControllerParams{RepositoryProvider: mockTableRepositoryProvider{}})
+
+			_, err := controller.Create(context.Background(), test.givenRules, uuid.Nil)
+			if err == nil {
+				t.Fatalf("expected %v, got nil", test.expectedError)
+			}
+
+			if !cmp.Equal(err.Error(), test.expectedError) {
+				t.Fatal(cmp.Diff(err.Error(), test.expectedError))
+			}
+		})
+	}
+}
+
 func TestController_Spin_Success(t *testing.T) {
 	tests := []struct {
 		name               string
@@ -110,28 +325,28 @@ func TestController_Spin_Success(t *testing.T) {
 					ID:       uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
 					Bets:     nil,
 					IsClosed: false,
-					Outcome: &domain.Outcome{
+					Outcomes: []domain.Outcome{{
 						Value:  16,
 						Colour: domain.Red,
-					},
+					}},
 				},
 			},
 			givenBetRepository: mockBetRepository{},
 			givenBallPlacer: mockBallPlacer{
-				GivenOutcome: domain.Outcome{
+				GivenOutcomes: []domain.Outcome{{
 					Value:  16,
 					Colour: domain.Red,
-				},
+				}},
 			},
 			givenLocator: mockLocator{
 				GivenTable: domain.Table{
 					ID:       uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
 					Bets:     nil,
 					IsClosed: false,
-					Outcome: &domain.Outcome{
+					Outcomes: []domain.Outcome{{
 						Value:  16,
 						Colour: domain.Red,
-					},
+					}},
 				},
 			},
 			givenID: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
@@ -139,23 +354,30 @@ func TestController_Spin_Success(t *testing.T) {
 				ID:       uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
 				Bets:     nil,
 				IsClosed: false,
-				Outcome: &domain.Outcome{
+				Outcomes: []domain.Outcome{{
 					Value:  16,
 					Colour: domain.Red,
-				},
+				}},
 			},
 		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
+			notifier := &mockNotifier{}
+			history := &mockHistory{}
+			auditor := &mockAuditor{}
+
 			controller := NewController(ControllerParams{
 				RepositoryProvider:    test.givenRepository,
 				BallPlacer:            test.givenBallPlacer,
 				WinnerLocator:         test.givenLocator,
 				BetRepositoryProvider: test.givenBetRepository,
+				Notifier:              notifier,
+				History:               history,
+				Auditor:               auditor,
 			})
 
-			actual, err := controller.Spin(context.Background(), test.givenID)
+			actual, err := controller.Spin(context.Background(), test.givenID, domain.AnyVersion)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -163,6 +385,18 @@ func TestController_Spin_Success(t *testing.T) {
 			if !cmp.Equal(actual, test.expectedTable) {
 				t.Fatal(cmp.Diff(actual, test.expectedTable))
 			}
+
+			if !cmp.Equal(notifier.Published, []domain.Table{test.expectedTable}) {
+				t.Fatal(cmp.Diff(notifier.Published, []domain.Table{test.expectedTable}))
+			}
+
+			if !cmp.Equal(history.Recorded, []domain.Table{test.expectedTable}) {
+				t.Fatal(cmp.Diff(history.Recorded, []domain.Table{test.expectedTable}))
+			}
+
+			if !cmp.Equal(auditor.Actions, []domain.AuditAction{domain.SpinTable}) {
+				t.Fatal(cmp.Diff(auditor.Actions, []domain.AuditAction{domain.SpinTable}))
+			}
 		})
 	}
 }
@@ -174,7 +408,10 @@ func TestController_Spin_Fail(t *testing.T) {
 		givenBetRepository BetRepositoryProvider
 		givenBallPlacer    BallPlacer
 		givenLocator       WinnerLocator
+		givenHistory       History
+		givenAuditor       Auditor
 		givenID            uuid.UUID
+		givenVersion       int64
 		expectedError      error
 	}{
 		{
@@ -198,7 +435,7 @@ func TestController_Spin_Fail(t *testing.T) {
 		{
 			name: "given a repo set outcome error, expect error to be returned",
 			givenRepository: mockTableRepositoryProvider{
-				GivenSetOutcomeError: ErrFailedToCreateTable,
+				GivenSetOutcomesError: ErrFailedToCreateTable,
 			},
 			givenBetRepository: mockBetRepository{},
 			givenBallPlacer:    mockBallPlacer{},
@@ -222,6 +459,59 @@ func TestController_Spin_Fail(t *testing.T) {
 			givenBallPlacer: mockBallPlacer{},
 			expectedError:   ErrFailedToFetchBets,
 		},
+		{
+			name: "given a version other than the stored one, expect a version conflict",
+			givenRepository: mockTableRepositoryProvider{
+				GivenGetTable: domain.Table{Version: 2},
+			},
+			givenBetRepository: mockBetRepository{},
+			givenBallPlacer:    mockBallPlacer{},
+			givenVersion:       1,
+			expectedError:      ErrVersionConflict,
+		},
+		{
+			name: "given the table is modified before it is closed, expect a version conflict",
+			givenRepository: mockTableRepositoryProvider{
+				GivenCloseError: storage.ErrVersionConflict,
+			},
+			givenBetRepository: mockBetRepository{},
+			givenBallPlacer:    mockBallPlacer{},
+			expectedError:      ErrVersionConflict,
+		},
+		{
+			name: "given a table which has already been spun, expect ErrTableClosed",
+			givenRepository: mockTableRepositoryProvider{
+				GivenCloseError: storage.ErrTableClosed,
+			},
+			givenBetRepository: mockBetRepository{},
+			givenBallPlacer:    mockBallPlacer{},
+			expectedError:      ErrTableClosed,
+		},
+		{
+			name: "given a table spun between being closed and its outcome set, expect ErrTableSpun",
+			givenRepository: mockTableRepositoryProvider{
+				GivenSetOutcomesError: storage.ErrTableSpun,
+			},
+			givenBetRepository: mockBetRepository{},
+			givenBallPlacer:    mockBallPlacer{},
+			expectedError:      ErrTableSpun,
+		},
+		{
+			name:               "given a history record error, expect error to be returned",
+			givenRepository:    mockTableRepositoryProvider{},
+			givenBetRepository: mockBetRepository{},
+			givenBallPlacer:    mockBallPlacer{},
+			givenHistory:       &mockHistory{GivenError: errors.New("storage unavailable")},
+			expectedError:      ErrFailedToRecordResult,
+		},
+		{
+			name:               "given an audit error, expect error to be returned",
+			givenRepository:    mockTableRepositoryProvider{},
+			givenBetRepository: mockBetRepository{},
+			givenBallPlacer:    mockBallPlacer{},
+			givenAuditor:       &mockAuditor{GivenError: errors.New("storage unavailable")},
+			expectedError:      ErrFailedToAudit,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -230,9 +520,11 @@ func TestController_Spin_Fail(t *testing.T) {
 				BallPlacer:            test.givenBallPlacer,
 				WinnerLocator:         test.givenLocator,
 				BetRepositoryProvider: test.givenBetRepository,
+				History:               test.givenHistory,
+				Auditor:               test.givenAuditor,
 			})
 
-			_, err := controller.Spin(context.Background(), test.givenID)
+			_, err := controller.Spin(context.Background(), test.givenID, test.givenVersion)
 			if err == nil {
 				t.Fatalf("expected %v, got nil", test.expectedError)
 			}
@@ -244,13 +536,333 @@ func TestController_Spin_Fail(t *testing.T) {
 	}
 }
 
+func TestController_Spin_Spans(t *testing.T) {
+	events := memory.NewEventStore(clock.NewFake(stampedAt))
+	tableStorage := memory.NewTableStorage(events)
+	betStorage := memory.NewBetStorage(tableStorage, events, clock.NewFake(stampedAt))
+
+	tableID := uuid.New()
+
+	err := tableStorage.Insert(context.Background(), storage.Table{ID: tableID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = betStorage.Insert(context.Background(), storage.Bet{ID: uuid.New(), Stake: money.New(100, "GBP"), Table: tableID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recorder := tracetest.NewSpanRecorder()
+
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() {
+		otel.SetTracerProvider(trace.NewNoopTracerProvider())
+	})
+
+	controller := NewController(ControllerParams{
+		RepositoryProvider:    NewRepository(tableStorage),
+		BetRepositoryProvider: bet.NewRepository(betStorage),
+		BallPlacer:            mockBallPlacer{GivenOutcomes: []domain.Outcome{{Value: 14, Colour: domain.Red}}},
+	})
+
+	_, err = controller.Spin(context.Background(), tableID, domain.AnyVersion)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spans := recorder.Ended()
+
+	names := make([]string, len(spans))
+
+	for i := range spans {
+		names[i] = spans[i].Name()
+	}
+
+	expectedNames := []string{
+		"memory.TableStorage.Get",
+		"table.Repository.Get",
+		"memory.EventStore.Append",
+		"memory.TableStorage.Close",
+		"table.Repository.Close",
+		"memory.EventStore.Append",
+		"memory.BetStorage.UpdateStateByTableID",
+		"bet.Repository.Spin",
+		"memory.EventStore.Append",
+		"memory.TableStorage.SetOutcomes",
+		"table.Repository.SetOutcomes",
+		"memory.TableStorage.Get",
+		"table.Repository.Get",
+		"memory.BetStorage.List",
+		"bet.Repository.List",
+		"table.Controller.Spin",
+	}
+
+	if !cmp.Equal(names, expectedNames) {
+		t.Fatal(cmp.Diff(names, expectedNames))
+	}
+
+	spin := spans[len(spans)-1]
+
+	for i := range spans[:len(spans)-1] {
+		if spans[i].SpanContext().TraceID() != spin.SpanContext().TraceID() {
+			t.Fatalf("expected %v to be traced within %v", spans[i].Name(), spin.Name())
+		}
+	}
+
+	attributes := make(map[attribute.Key]string, len(spin.Attributes()))
+
+	for _, kv := range spin.Attributes() {
+		attributes[kv.Key] = kv.Value.Emit()
+	}
+
+	expectedAttributes := map[attribute.Key]string{
+		tracing.KeyTableID:       tableID.String(),
+		tracing.KeyOutcomeValue:  "[14]",
+		tracing.KeyOutcomeColour: "[red]",
+		tracing.KeyBetCount:      "1",
+	}
+
+	if !cmp.Equal(attributes, expectedAttributes) {
+		t.Fatal(cmp.Diff(attributes, expectedAttributes))
+	}
+}
+
+func TestController_Spin_Multipliers(t *testing.T) {
+	tests := []struct {
+		name                string
+		givenRules          domain.Rules
+		givenMultipliers    []domain.Multiplier
+		expectedMultipliers []domain.Multiplier
+	}{
+		{
+			name:                "given a lightning table, expect the struck numbers to be stored with the outcome",
+			givenRules:          domain.Rules{Zero: domain.NoZeroRule, Variant: domain.Lightning},
+			givenMultipliers:    []domain.Multiplier{{Position: 14, Factor: 500}, {Position: 0, Factor: 50}},
+			expectedMultipliers: []domain.Multiplier{{Position: 14, Factor: 500}, {Position: 0, Factor: 50}},
+		},
+		{
+			name:             "given a single ball table, expect no numbers to be struck",
+			givenRules:       domain.Rules{Zero: domain.NoZeroRule, Variant: domain.SingleBall},
+			givenMultipliers: []domain.Multiplier{{Position: 14, Factor: 500}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			events := memory.NewEventStore(clock.NewFake(stampedAt))
+			tableStorage := memory.NewTableStorage(events)
+
+			controller := NewController(ControllerParams{
+				RepositoryProvider:    NewRepository(tableStorage),
+				BetRepositoryProvider: bet.NewRepository(memory.NewBetStorage(tableStorage, events, clock.NewFake(stampedAt))),
+				BallPlacer: mockBallPlacer{
+					GivenOutcomes:    []domain.Outcome{{Value: 14, Colour: domain.Red}},
+					GivenMultipliers: test.givenMultipliers,
+				},
+			})
+
+			table, err := controller.Create(context.Background(), test.givenRules, uuid.Nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			actual, err := controller.Spin(context.Background(), table.ID, domain.AnyVersion)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(actual.Multipliers, test.expectedMultipliers) {
+				t.Fatal(cmp.Diff(actual.Multipliers, test.expectedMultipliers))
+			}
+		})
+	}
+}
+
+func TestController_Spin_Rollback(t *testing.T) {
+	events := memory.NewEventStore(clock.NewFake(stampedAt))
+	tableStorage := memory.NewTableStorage(events)
+	betStorage := memory.NewBetStorage(tableStorage, events, clock.NewFake(stampedAt))
+
+	tableID := uuid.New()
+	betID := uuid.New()
+
+	err := tableStorage.Insert(context.Background(), storage.Table{ID: tableID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = betStorage.Insert(context.Background(), storage.Bet{
+		ID:     betID,
+		Status: domain.Unsettled.String(),
+		Stake:  money.New(100, "GBP"),
+		Table:  tableID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	controller := NewController(ControllerParams{
+		RepositoryProvider:    NewRepository(failingOutcomeStorage{TableStorage: tableStorage}),
+		BetRepositoryProvider: bet.NewRepository(betStorage),
+		BallPlacer:            mockBallPlacer{GivenOutcomes: []domain.Outcome{{Value: 14, Colour: domain.Red}}},
+		UnitOfWork:            memory.NewUnitOfWork(),
+	})
+
+	_, err = controller.Spin(context.Background(), tableID, domain.AnyVersion)
+	if !cmp.Equal(err, ErrFailedToSetOutcome, cmpopts.EquateErrors()) {
+		t.Fatal(cmp.Diff(err, ErrFailedToSetOutcome, cmpopts.EquateErrors()))
+	}
+
+	table, err := tableStorage.Get(context.Background(), tableID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if table.IsClosed {
+		t.Fatal("expected the table to be reopened")
+	}
+
+	b, err := betStorage.Get(context.Background(), betID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !cmp.Equal(b.Status, domain.Unsettled.String()) {
+		t.Fatal(cmp.Diff(b.Status, domain.Unsettled.String()))
+	}
+}
+
+// TestController_Spin_ConcurrentBets places bets while the table is spun, every bet must either be refused or be
+// carried into the round; none may be left unsettled on a closed table.
+func TestController_Spin_ConcurrentBets(t *testing.T) {
+	const (
+		rounds  = 50
+		placers = 20
+	)
+
+	events := memory.NewEventStore(clock.NewFake(stampedAt))
+	tableStorage := memory.NewTableStorage(events)
+	betStorage := memory.NewBetStorage(tableStorage, events, clock.NewFake(stampedAt))
+
+	controller := NewController(ControllerParams{
+		RepositoryProvider:    NewRepository(tableStorage),
+		BetRepositoryProvider: bet.NewRepository(betStorage),
+		BallPlacer:            mockBallPlacer{GivenOutcomes: []domain.Outcome{{Value: 14, Colour: domain.Red}}},
+		UnitOfWork:            memory.NewUnitOfWork(),
+	})
+	betController := bet.NewController(bet.ControllerParams{
+		RepositoryProvider: bet.NewRepository(betStorage),
+		TableRepoProvider:  yieldingTableReader{Repository: NewRepository(tableStorage)},
+		ExposureLimiter:    exposure.New(exposure.Limits{"GBP": math.MaxInt64}, nil),
+	})
+
+	for round := 0; round < rounds; round++ {
+		table, err := controller.Create(context.Background(), domain.Rules{}, uuid.Nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var (
+			wg      sync.WaitGroup
+			placed  int64
+			errs    = make(chan error, placers)
+			started = make(chan struct{})
+		)
+
+		for i := 0; i < placers; i++ {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				<-started
+
+				_, err := betController.Create(context.Background(), domain.Bet{
+					ID:             uuid.New(),
+					Status:         domain.Unsettled,
+					SelectedSpaces: []int{14},
+					Stake:          money.New(100, "GBP"),
+					Table:          table.ID,
+				})
+				if err != nil {
+					errs <- err
+
+					return
+				}
+
+				atomic.AddInt64(&placed, 1)
+			}()
+		}
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			<-started
+
+			// Spin at a different point of the placements each round.
+			time.Sleep(time.Duration(round%4) * yieldFor / 2)
+
+			_, err := controller.Spin(context.Background(), table.ID, domain.AnyVersion)
+			if err != nil {
+				errs <- err
+			}
+		}()
+
+		close(started)
+		wg.Wait()
+		close(errs)
+
+		for err := range errs {
+			if !errors.Is(err, bet.ErrTableClosed) {
+				t.Fatal(err)
+			}
+		}
+
+		bets, err := betStorage.List(context.Background(), table.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !cmp.Equal(int64(len(bets)), placed) {
+			t.Fatal(cmp.Diff(int64(len(bets)), placed))
+		}
+
+		for i := range bets {
+			if !cmp.Equal(bets[i].Status, domain.Live.String()) {
+				t.Fatalf("round %v: bet %v escaped the spin: %v", round, bets[i].ID, cmp.Diff(bets[i].Status, domain.Live.String()))
+			}
+		}
+	}
+}
+
+const yieldFor = time.Millisecond
+
+// yieldingTableReader gives way to other goroutines once a table has been read, widening the window in which it can be
+// closed before a bet placed against it is stored.
+type yieldingTableReader struct {
+	Repository
+}
+
+func (y yieldingTableReader) Get(ctx context.Context, id uuid.UUID) (domain.Table, error) {
+	table, err := y.Repository.Get(ctx, id)
+
+	time.Sleep(yieldFor)
+
+	return table, err
+}
+
 func TestController_Settle_Success(t *testing.T) {
+	spunTable := domain.Table{Outcomes: []domain.Outcome{{Value: 16, Colour: domain.Red}}}
+
 	tests := []struct {
 		name               string
 		givenRepository    RepositoryProvider
 		givenBetRepository BetRepositoryProvider
 		givenBallPlacer    BallPlacer
 		givenLocator       WinnerLocator
+		givenJackpot       Jackpot
 		givenID            uuid.UUID
 		expectedTable      domain.Table
 	}{
@@ -261,10 +873,10 @@ func TestController_Settle_Success(t *testing.T) {
 					ID:       uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
 					Bets:     nil,
 					IsClosed: false,
-					Outcome: &domain.Outcome{
+					Outcomes: []domain.Outcome{{
 						Value:  16,
 						Colour: domain.Red,
-					},
+					}},
 				},
 			},
 			givenLocator: mockLocator{
@@ -272,65 +884,164 @@ func TestController_Settle_Success(t *testing.T) {
 					ID:       uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
 					Bets:     nil,
 					IsClosed: false,
-					Outcome: &domain.Outcome{
+					Outcomes: []domain.Outcome{{
 						Value:  16,
 						Colour: domain.Red,
-					},
+					}},
 				},
 			},
 			givenBetRepository: mockBetRepository{},
 			givenID:            uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
 			expectedTable: domain.Table{
-				ID:       uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
-				Bets:     nil,
-				IsClosed: false,
-				Outcome: &domain.Outcome{
+				ID:        uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				SettledAt: &stampedAt,
+				SettledBy: "croupier",
+				Version:   1,
+				Bets:      nil,
+				IsClosed:  false,
+				Outcomes: []domain.Outcome{{
 					Value:  16,
 					Colour: domain.Red,
+				}},
+			},
+		},
+		{
+			name:            "given a table triggering the jackpot, expect the bets with their share of the pool",
+			givenRepository: mockTableRepositoryProvider{GivenGetTable: spunTable},
+			givenLocator: mockLocator{
+				GivenTable: domain.Table{
+					ID:       uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+					Outcomes: []domain.Outcome{{Value: 16, Colour: domain.Red}},
+					Bets: []domain.Bet{
+						{
+							ID:           uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+							Win:          true,
+							Contribution: money.New(10, "GBP"),
+						},
+					},
+				},
+			},
+			givenJackpot: mockJackpot{
+				GivenTable: domain.Table{
+					ID:       uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+					Outcomes: []domain.Outcome{{Value: 16, Colour: domain.Red}},
+					Bets: []domain.Bet{
+						{
+							ID:           uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+							Win:          true,
+							Contribution: money.New(10, "GBP"),
+							JackpotWin:   money.New(5000, "GBP"),
+						},
+					},
+				},
+			},
+			givenBetRepository: mockBetRepository{},
+			givenID:            uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+			expectedTable: domain.Table{
+				ID:        uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				SettledAt: &stampedAt,
+				SettledBy: "croupier",
+				Version:   1,
+				Outcomes:  []domain.Outcome{{Value: 16, Colour: domain.Red}},
+				Bets: []domain.Bet{
+					{
+						ID:           uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+						Win:          true,
+						Contribution: money.New(10, "GBP"),
+						JackpotWin:   money.New(5000, "GBP"),
+					},
 				},
 			},
 		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
+			notifier := &mockNotifier{}
+
 			controller := NewController(ControllerParams{
 				RepositoryProvider:    test.givenRepository,
 				BallPlacer:            test.givenBallPlacer,
 				WinnerLocator:         test.givenLocator,
 				BetRepositoryProvider: test.givenBetRepository,
+				Notifier:              notifier,
+				Jackpot:               test.givenJackpot,
+				Clock:                 clock.NewFake(stampedAt),
 			})
 
-			actual, err := controller.Settle(context.Background(), test.givenID)
+			ctx := actor.NewContext(context.Background(), "croupier")
+
+			actual, err := controller.Settle(ctx, test.givenID, domain.AnyVersion)
 			if err != nil {
 				t.Fatal(err)
 			}
 
-			if !cmp.Equal(actual, test.expectedTable) {
-				t.Fatal(cmp.Diff(actual, test.expectedTable))
+			if !cmp.Equal(actual, test.expectedTable, opts.MoneyComparer) {
+				t.Fatal(cmp.Diff(actual, test.expectedTable, opts.MoneyComparer))
+			}
+
+			if !cmp.Equal(notifier.Published, []domain.Table{test.expectedTable}, opts.MoneyComparer) {
+				t.Fatal(cmp.Diff(notifier.Published, []domain.Table{test.expectedTable}, opts.MoneyComparer))
 			}
 		})
 	}
 }
 
 func TestController_Settle_Fail(t *testing.T) {
+	spunTable := domain.Table{Outcomes: []domain.Outcome{{Value: 16, Colour: domain.Red}}}
+
 	tests := []struct {
 		name               string
 		givenRepository    RepositoryProvider
 		givenBetRepository BetRepositoryProvider
 		givenBallPlacer    BallPlacer
 		givenLocator       WinnerLocator
+		givenJackpot       Jackpot
+		givenAuditor       Auditor
 		givenID            uuid.UUID
 		expectedError      error
 	}{
+		{
+			name:               "given a table that has not been spun, expect ErrTableNotSpun",
+			givenRepository:    mockTableRepositoryProvider{},
+			givenBetRepository: mockBetRepository{},
+			expectedError:      ErrTableNotSpun,
+		},
 		{
 			name:            "given a repo settle error, expect error to be returned",
-			givenRepository: mockTableRepositoryProvider{},
+			givenRepository: mockTableRepositoryProvider{GivenGetTable: spunTable},
 			givenBetRepository: mockBetRepository{
 				GivenSettleError: ErrFailedFailedToSettle,
 			},
 			givenBallPlacer: mockBallPlacer{},
 			expectedError:   ErrFailedFailedToSettle,
 		},
+		{
+			name: "given a table repo settle error, expect error to be returned",
+			givenRepository: mockTableRepositoryProvider{
+				GivenGetTable:    spunTable,
+				GivenSettleError: memory.ErrNoTables,
+			},
+			givenBetRepository: mockBetRepository{},
+			expectedError:      ErrFailedToSettleTable,
+		},
+		{
+			name: "given the table is modified before it is settled, expect a version conflict",
+			givenRepository: mockTableRepositoryProvider{
+				GivenGetTable:    spunTable,
+				GivenSettleError: storage.ErrVersionConflict,
+			},
+			givenBetRepository: mockBetRepository{},
+			expectedError:      ErrVersionConflict,
+		},
+		{
+			name: "given a table which has already been settled, expect ErrTableSettled",
+			givenRepository: mockTableRepositoryProvider{
+				GivenGetTable:    spunTable,
+				GivenSettleError: storage.ErrTableSettled,
+			},
+			givenBetRepository: mockBetRepository{},
+			expectedError:      ErrTableSettled,
+		},
 		{
 			name: "given a repo get error, expect error to be returned",
 			givenRepository: mockTableRepositoryProvider{
@@ -342,7 +1053,7 @@ func TestController_Settle_Fail(t *testing.T) {
 		},
 		{
 			name:            "given a repo set winners error, expect error to be returned",
-			givenRepository: mockTableRepositoryProvider{},
+			givenRepository: mockTableRepositoryProvider{GivenGetTable: spunTable},
 			givenBetRepository: mockBetRepository{
 				GivenSetWinnersError: ErrFailedToSetWinners,
 			},
@@ -352,7 +1063,7 @@ func TestController_Settle_Fail(t *testing.T) {
 		},
 		{
 			name:            "given a bet repo list error, expect error to be returned",
-			givenRepository: mockTableRepositoryProvider{},
+			givenRepository: mockTableRepositoryProvider{GivenGetTable: spunTable},
 			givenBetRepository: mockBetRepository{
 				GivenListError: ErrFailedToFetchBets,
 			},
@@ -360,6 +1071,25 @@ func TestController_Settle_Fail(t *testing.T) {
 			givenBallPlacer: mockBallPlacer{},
 			expectedError:   ErrFailedToFetchBets,
 		},
+		{
+			name:               "given the jackpot fails to pay out, expect error to be returned",
+			givenRepository:    mockTableRepositoryProvider{GivenGetTable: spunTable},
+			givenBetRepository: mockBetRepository{},
+			givenLocator:       mockLocator{},
+			givenJackpot: mockJackpot{
+				GivenError: errors.New("pool exhausted"),
+			},
+			givenBallPlacer: mockBallPlacer{},
+			expectedError:   ErrFailedToAwardJackpot,
+		},
+		{
+			name:               "given an audit error, expect error to be returned",
+			givenRepository:    mockTableRepositoryProvider{GivenGetTable: spunTable},
+			givenBetRepository: mockBetRepository{},
+			givenLocator:       mockLocator{},
+			givenAuditor:       &mockAuditor{GivenError: errors.New("storage unavailable")},
+			expectedError:      ErrFailedToAudit,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -368,9 +1098,11 @@ func TestController_Settle_Fail(t *testing.T) {
 				BallPlacer:            test.givenBallPlacer,
 				WinnerLocator:         test.givenLocator,
 				BetRepositoryProvider: test.givenBetRepository,
+				Jackpot:               test.givenJackpot,
+				Auditor:               test.givenAuditor,
 			})
 
-			_, err := controller.Settle(context.Background(), test.givenID)
+			_, err := controller.Settle(context.Background(), test.givenID, domain.AnyVersion)
 			if err == nil {
 				t.Fatalf("expected %v, got nil", test.expectedError)
 			}
@@ -389,50 +1121,88 @@ func TestController_List_Success(t *testing.T) {
 		givenBetRepository BetRepositoryProvider
 		givenBallPlacer    BallPlacer
 		givenLocator       WinnerLocator
-		givenID            uuid.UUID
-		expectedTables     []domain.Table
+		givenQuery         domain.TableQuery
+		expectedPage       domain.TablePage
 	}{
 		{
 			name: "expect all tables to be returned",
 			givenRepository: mockTableRepositoryProvider{
-				GivenListTables: []domain.Table{
-					{
-						ID:       uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
-						Bets:     nil,
-						IsClosed: false,
-						Outcome: &domain.Outcome{
-							Value:  16,
-							Colour: domain.Red,
+				GivenListPage: domain.TablePage{
+					Tables: []domain.Table{
+						{
+							ID:       uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+							Bets:     nil,
+							IsClosed: false,
+							Outcomes: []domain.Outcome{{
+								Value:  16,
+								Colour: domain.Red,
+							}},
 						},
 					},
 				},
 			},
 			givenLocator: mockLocator{},
 			givenBetRepository: mockBetRepository{
-				GivenListBets: []domain.Bet{
+				GivenListByTables: map[uuid.UUID][]domain.Bet{
+					uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"): {
+						{
+							ID:    uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+							Table: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+						},
+					},
+				},
+			},
+			expectedPage: domain.TablePage{
+				Tables: []domain.Table{
 					{
-						ID:    uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
-						Table: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+						ID: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+						Bets: []domain.Bet{
+							{
+								ID:    uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+								Table: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+							},
+						},
+						IsClosed: false,
+						Outcomes: []domain.Outcome{{
+							Value:  16,
+							Colour: domain.Red,
+						}},
 					},
 				},
 			},
-			givenID: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
-			expectedTables: []domain.Table{
-				{
-					ID: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
-					Bets: []domain.Bet{
+		},
+		{
+			name: "given bets are omitted, expect tables to be returned without bets",
+			givenRepository: mockTableRepositoryProvider{
+				GivenListPage: domain.TablePage{
+					Tables: []domain.Table{
 						{
-							ID:    uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
-							Table: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+							ID: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
 						},
 					},
-					IsClosed: false,
-					Outcome: &domain.Outcome{
-						Value:  16,
-						Colour: domain.Red,
+					Next: &domain.TableCursor{
+						ID: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
 					},
 				},
 			},
+			givenLocator: mockLocator{},
+			givenBetRepository: mockBetRepository{
+				GivenListError: ErrFailedToFetchBets,
+			},
+			givenQuery: domain.TableQuery{
+				Limit:    1,
+				OmitBets: true,
+			},
+			expectedPage: domain.TablePage{
+				Tables: []domain.Table{
+					{
+						ID: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+					},
+				},
+				Next: &domain.TableCursor{
+					ID: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				},
+			},
 		},
 	}
 	for _, test := range tests {
@@ -444,13 +1214,13 @@ func TestController_List_Success(t *testing.T) {
 				BetRepositoryProvider: test.givenBetRepository,
 			})
 
-			actual, err := controller.List(context.Background())
+			actual, err := controller.List(context.Background(), test.givenQuery)
 			if err != nil {
 				t.Fatal(err)
 			}
 
-			if !cmp.Equal(actual, test.expectedTables) {
-				t.Fatal(cmp.Diff(actual, test.expectedTables))
+			if !cmp.Equal(actual, test.expectedPage) {
+				t.Fatal(cmp.Diff(actual, test.expectedPage))
 			}
 		})
 	}
@@ -463,18 +1233,34 @@ func TestController_List_Fail(t *testing.T) {
 		givenBetRepository BetRepositoryProvider
 		givenBallPlacer    BallPlacer
 		givenLocator       WinnerLocator
-		givenID            uuid.UUID
+		givenQuery         domain.TableQuery
 		expectedError      error
 	}{
 		{
 			name: "given repo list error, expect it to be returned",
 			givenRepository: mockTableRepositoryProvider{
-				GivenListError: ErrFailedToFetchTable,
+				GivenListError: memory.ErrNoTables,
 			},
 			givenLocator:       mockLocator{},
 			givenBetRepository: mockBetRepository{},
-			givenID:            uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
-			expectedError:      ErrFailedToFetchTable,
+			expectedError:      ErrFailedToListTables,
+		},
+		{
+			name: "given bet repo list error, expect it to be returned",
+			givenRepository: mockTableRepositoryProvider{
+				GivenListPage: domain.TablePage{
+					Tables: []domain.Table{
+						{
+							ID: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+						},
+					},
+				},
+			},
+			givenLocator: mockLocator{},
+			givenBetRepository: mockBetRepository{
+				GivenListError: ErrFailedToFetchBets,
+			},
+			expectedError: ErrFailedToFetchBets,
 		},
 	}
 	for _, test := range tests {
@@ -486,7 +1272,7 @@ func TestController_List_Fail(t *testing.T) {
 				BetRepositoryProvider: test.givenBetRepository,
 			})
 
-			_, err := controller.List(context.Background())
+			_, err := controller.List(context.Background(), test.givenQuery)
 			if err == nil {
 				t.Fatalf("expected %v, got nil", test.expectedError)
 			}
@@ -515,10 +1301,10 @@ func TestController_Get_Success(t *testing.T) {
 					ID:       uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
 					Bets:     nil,
 					IsClosed: false,
-					Outcome: &domain.Outcome{
+					Outcomes: []domain.Outcome{{
 						Value:  16,
 						Colour: domain.Red,
-					},
+					}},
 				},
 			},
 			givenLocator:       mockLocator{},
@@ -528,10 +1314,10 @@ func TestController_Get_Success(t *testing.T) {
 				ID:       uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
 				Bets:     nil,
 				IsClosed: false,
-				Outcome: &domain.Outcome{
+				Outcomes: []domain.Outcome{{
 					Value:  16,
 					Colour: domain.Red,
-				},
+				}},
 			},
 		},
 	}
@@ -606,21 +1392,162 @@ func TestController_Get_Fail(t *testing.T) {
 	}
 }
 
+func TestController_Summary_Success(t *testing.T) {
+	tests := []struct {
+		name               string
+		givenRepository    RepositoryProvider
+		givenBetRepository BetRepositoryProvider
+		givenAggregator    Aggregator
+		givenID            uuid.UUID
+		expectedSummary    domain.TableSummary
+	}{
+		{
+			name: "expect the table and its bets to be summarised",
+			givenRepository: mockTableRepositoryProvider{
+				GivenGetTable: domain.Table{
+					ID: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				},
+			},
+			givenBetRepository: mockBetRepository{
+				GivenListBets: []domain.Bet{
+					{
+						ID:    uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+						Table: uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+					},
+				},
+			},
+			givenAggregator: mockAggregator{},
+			givenID:         uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+			expectedSummary: domain.TableSummary{
+				TableID:  uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+				BetCount: 1,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := NewController(ControllerParams{
+				RepositoryProvider:    test.givenRepository,
+				BetRepositoryProvider: test.givenBetRepository,
+				Aggregator:            test.givenAggregator,
+			})
+
+			actual, err := controller.Summary(context.Background(), test.givenID)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(actual, test.expectedSummary) {
+				t.Fatal(cmp.Diff(actual, test.expectedSummary))
+			}
+		})
+	}
+}
+
+func TestController_Summary_Fail(t *testing.T) {
+	tests := []struct {
+		name               string
+		givenRepository    RepositoryProvider
+		givenBetRepository BetRepositoryProvider
+		givenAggregator    Aggregator
+		givenID            uuid.UUID
+		expectedError      error
+	}{
+		{
+			name: "given a repo get error, expect error to be returned",
+			givenRepository: mockTableRepositoryProvider{
+				GivenGetError: ErrFailedToFetchTable,
+			},
+			givenBetRepository: mockBetRepository{},
+			givenAggregator:    mockAggregator{},
+			expectedError:      ErrFailedToFetchTable,
+		},
+		{
+			name:            "given a bet repo list error, expect error to be returned",
+			givenRepository: mockTableRepositoryProvider{},
+			givenBetRepository: mockBetRepository{
+				GivenListError: ErrFailedToFetchBets,
+			},
+			givenAggregator: mockAggregator{},
+			expectedError:   ErrFailedToFetchBets,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := NewController(ControllerParams{
+				RepositoryProvider:    test.givenRepository,
+				BetRepositoryProvider: test.givenBetRepository,
+				Aggregator:            test.givenAggregator,
+			})
+
+			_, err := controller.Summary(context.Background(), test.givenID)
+			if err == nil {
+				t.Fatalf("expected %v, got nil", test.expectedError)
+			}
+
+			if !cmp.Equal(err, test.expectedError, cmpopts.EquateErrors()) {
+				t.Fatal(cmp.Diff(err, test.expectedError, cmpopts.EquateErrors()))
+			}
+		})
+	}
+}
+
+func BenchmarkController_List(b *testing.B) {
+	events := memory.NewEventStore(clock.NewFake(stampedAt))
+	tableStorage := memory.NewTableStorage(events)
+	betStorage := memory.NewBetStorage(tableStorage, events, clock.NewFake(stampedAt))
+
+	controller := NewController(ControllerParams{
+		RepositoryProvider:    NewRepository(tableStorage),
+		BetRepositoryProvider: bet.NewRepository(betStorage),
+	})
+
+	for i := 0; i < 1000; i++ {
+		table, err := controller.Create(context.Background(), domain.Rules{}, uuid.Nil)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		for j := 0; j < 20; j++ {
+			err = betStorage.Insert(context.Background(), storage.Bet{
+				ID:    uuid.New(),
+				Stake: money.New(100, "GBP"),
+				Table: table.ID,
+			})
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := controller.List(context.Background(), domain.TableQuery{Limit: 500})
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 type mockTableRepositoryProvider struct {
-	GivenGetTable        domain.Table
-	GivenGetError        error
-	GivenListTables      []domain.Table
-	GivenListError       error
-	GivenInsertError     error
-	GivenCloseError      error
-	GivenSetOutcomeError error
+	GivenGetTable         domain.Table
+	GivenGetError         error
+	GivenListPage         domain.TablePage
+	GivenListError        error
+	GivenInsertError      error
+	GivenCloseError       error
+	GivenSetOutcomesError error
+	GivenSettleError      error
 }
 
 type mockBetRepository struct {
 	GivenSetWinnersError error
+	GivenCarryError      error
 	GivenSettleError     error
 	GivenSpinError       error
 	GivenListBets        []domain.Bet
+	GivenListByTables    map[uuid.UUID][]domain.Bet
 	GivenListError       error
 }
 
@@ -628,6 +1555,10 @@ func (m mockBetRepository) SetWinners(_ context.Context, _ []domain.Bet) error {
 	return m.GivenSetWinnersError
 }
 
+func (m mockBetRepository) Carry(_ context.Context, _, _ uuid.UUID) error {
+	return m.GivenCarryError
+}
+
 func (m mockBetRepository) Spin(_ context.Context, _ uuid.UUID) error {
 	return m.GivenSpinError
 }
@@ -640,32 +1571,70 @@ func (m mockBetRepository) List(_ context.Context, _ uuid.UUID) ([]domain.Bet, e
 	return m.GivenListBets, m.GivenListError
 }
 
+func (m mockBetRepository) ListByTables(_ context.Context, _ []uuid.UUID) (map[uuid.UUID][]domain.Bet, error) {
+	return m.GivenListByTables, m.GivenListError
+}
+
 func (m mockTableRepositoryProvider) Get(_ context.Context, _ uuid.UUID) (domain.Table, error) {
 	return m.GivenGetTable, m.GivenGetError
 }
 
-func (m mockTableRepositoryProvider) List(_ context.Context) ([]domain.Table, error) {
-	return m.GivenListTables, m.GivenListError
+func (m mockTableRepositoryProvider) List(_ context.Context, _ domain.TableQuery) (domain.TablePage, error) {
+	return m.GivenListPage, m.GivenListError
 }
 
 func (m mockTableRepositoryProvider) Insert(_ context.Context, _ domain.Table) error {
 	return m.GivenInsertError
 }
 
-func (m mockTableRepositoryProvider) Close(_ context.Context, _ uuid.UUID) error {
+func (m mockTableRepositoryProvider) Close(_ context.Context, _ uuid.UUID, _ int64, _ time.Time, _ string) error {
 	return m.GivenCloseError
 }
 
-func (m mockTableRepositoryProvider) SetOutcome(_ context.Context, _ uuid.UUID, _ domain.Outcome) error {
-	return m.GivenSetOutcomeError
+func (m mockTableRepositoryProvider) SetOutcomes(
+	_ context.Context,
+	_ uuid.UUID,
+	_ int64,
+	_ []domain.Outcome,
+	_ []domain.Multiplier,
+	_ time.Time,
+	_ string,
+) error {
+	return m.GivenSetOutcomesError
+}
+
+func (m mockTableRepositoryProvider) Settle(_ context.Context, _ uuid.UUID, _ int64, _ time.Time, _ string) error {
+	return m.GivenSettleError
+}
+
+// failingOutcomeStorage fails to set the outcomes of any Table.
+type failingOutcomeStorage struct {
+	*memory.TableStorage
+}
+
+func (f failingOutcomeStorage) SetOutcomes(
+	_ context.Context,
+	_ uuid.UUID,
+	_ int64,
+	_ []storage.Outcome,
+	_ []storage.Multiplier,
+	_ time.Time,
+	_ string,
+) error {
+	return memory.ErrNoTables
 }
 
 type mockBallPlacer struct {
-	GivenOutcome domain.Outcome
+	GivenOutcomes    []domain.Outcome
+	GivenMultipliers []domain.Multiplier
+}
+
+func (m mockBallPlacer) GetPositions(_ context.Context, _ int) []domain.Outcome {
+	return m.GivenOutcomes
 }
 
-func (m mockBallPlacer) GetPosition(_ context.Context) domain.Outcome {
-	return m.GivenOutcome
+func (m mockBallPlacer) GetMultipliers(_ context.Context) []domain.Multiplier {
+	return m.GivenMultipliers
 }
 
 type mockLocator struct {
@@ -675,3 +1644,52 @@ type mockLocator struct {
 func (m mockLocator) Locate(_ context.Context, _ domain.Table) domain.Table {
 	return m.GivenTable
 }
+
+type mockAggregator struct {
+}
+
+func (m mockAggregator) Summarise(_ context.Context, table domain.Table) domain.TableSummary {
+	return domain.TableSummary{
+		TableID:  table.ID,
+		BetCount: len(table.Bets),
+	}
+}
+
+type mockNotifier struct {
+	Published []domain.Table
+}
+
+func (m *mockNotifier) Publish(_ context.Context, table domain.Table) {
+	m.Published = append(m.Published, table)
+}
+
+type mockJackpot struct {
+	GivenTable domain.Table
+	GivenError error
+}
+
+func (m mockJackpot) Award(_ context.Context, _ domain.Table) (domain.Table, error) {
+	return m.GivenTable, m.GivenError
+}
+
+type mockHistory struct {
+	GivenError error
+	Recorded   []domain.Table
+}
+
+func (m *mockHistory) Record(_ context.Context, table domain.Table) error {
+	m.Recorded = append(m.Recorded, table)
+
+	return m.GivenError
+}
+
+type mockAuditor struct {
+	GivenError error
+	Actions    []domain.AuditAction
+}
+
+func (m *mockAuditor) Record(_ context.Context, action domain.AuditAction, _ uuid.UUID, _, _ interface{}) error {
+	m.Actions = append(m.Actions, action)
+
+	return m.GivenError
+}
@@ -2,8 +2,11 @@ package table
 
 import (
 	"betting/internal/domain"
+	"betting/internal/pkg/logging"
+	"betting/internal/pkg/tracing"
 	"betting/storage"
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -16,15 +19,24 @@ type StorageProvider interface {
 
 // StorageWriter provides write operations for Tables.
 type StorageWriter interface {
-	Close(ctx context.Context, id uuid.UUID) error
+	Close(ctx context.Context, id uuid.UUID, version int64, closedAt time.Time, closedBy string) error
 	Insert(ctx context.Context, table storage.Table) error
-	SetOutcome(ctx context.Context, id uuid.UUID, outcome storage.Outcome) error
+	SetOutcomes(
+		ctx context.Context,
+		id uuid.UUID,
+		version int64,
+		outcomes []storage.Outcome,
+		multipliers []storage.Multiplier,
+		spunAt time.Time,
+		spunBy string,
+	) error
+	Settle(ctx context.Context, id uuid.UUID, version int64, settledAt time.Time, settledBy string) error
 }
 
 // StorageReader provides read operations for Tables.
 type StorageReader interface {
 	Get(ctx context.Context, id uuid.UUID) (storage.Table, error)
-	List(ctx context.Context) ([]storage.Table, error)
+	List(ctx context.Context, query storage.TableQuery) (storage.TablePage, error)
 }
 
 // Repository allows for storing Tables and Bets in memory.
@@ -39,20 +51,34 @@ func NewRepository(storageProvider StorageProvider) Repository {
 	}
 }
 
-// Close sets the Table to closed so no more Bets can be added to it.
-func (r Repository) Close(ctx context.Context, id uuid.UUID) error {
-	return r.StorageProvider.Close(ctx, id)
+// Close sets the Table to closed so no more Bets can be added to it, provided it is still at the given version. The time
+// it was closed at and by whom are recorded.
+func (r Repository) Close(ctx context.Context, id uuid.UUID, version int64, closedAt time.Time, closedBy string) error {
+	ctx, span := tracing.Start(ctx, "table.Repository.Close", tracing.KeyTableID.String(id.String()))
+	defer span.End()
+
+	logging.FromContext(ctx).WithField(logging.FieldTableID, id).Debug("storing closed table")
+
+	return r.StorageProvider.Close(ctx, id, version, closedAt, closedBy)
 }
 
 // Insert adapts from domain to storage and stores it in memory.
 func (r Repository) Insert(ctx context.Context, table domain.Table) error {
+	ctx, span := tracing.Start(ctx, "table.Repository.Insert", tracing.KeyTableID.String(table.ID.String()))
+	defer span.End()
+
 	adaptedTable := storage.AdaptTableFromDomain(table)
 
+	logging.FromContext(ctx).WithField(logging.FieldTableID, table.ID).Debug("storing table")
+
 	return r.StorageProvider.Insert(ctx, adaptedTable)
 }
 
 // Get retrieves a Table for a given ID.
 func (r Repository) Get(ctx context.Context, id uuid.UUID) (domain.Table, error) {
+	ctx, span := tracing.Start(ctx, "table.Repository.Get", tracing.KeyTableID.String(id.String()))
+	defer span.End()
+
 	table, err := r.StorageProvider.Get(ctx, id)
 	if err != nil {
 		return domain.Table{}, err
@@ -61,25 +87,49 @@ func (r Repository) Get(ctx context.Context, id uuid.UUID) (domain.Table, error)
 	return storage.AdaptTableToDomain(table), nil
 }
 
-// List retrieves all Tables.
-func (r Repository) List(ctx context.Context) ([]domain.Table, error) {
-	tables, err := r.StorageProvider.List(ctx)
+// List retrieves a page of the Tables matching the query.
+func (r Repository) List(ctx context.Context, query domain.TableQuery) (domain.TablePage, error) {
+	ctx, span := tracing.Start(ctx, "table.Repository.List")
+	defer span.End()
+
+	page, err := r.StorageProvider.List(ctx, storage.AdaptTableQueryFromDomain(query))
 	if err != nil {
-		return nil, err
+		return domain.TablePage{}, err
 	}
 
-	ts := make([]domain.Table, len(tables))
-
-	for i := range tables {
-		ts[i] = storage.AdaptTableToDomain(tables[i])
-	}
+	return storage.AdaptTablePageToDomain(page), nil
+}
 
-	return ts, nil
+// SetOutcomes adapts from domain to storage and updates the given Table in memory, along with any Multipliers struck
+// for the spin and when it was spun by whom, provided it is still at the given version.
+func (r Repository) SetOutcomes(
+	ctx context.Context,
+	id uuid.UUID,
+	version int64,
+	outcomes []domain.Outcome,
+	multipliers []domain.Multiplier,
+	spunAt time.Time,
+	spunBy string,
+) error {
+	ctx, span := tracing.Start(ctx, "table.Repository.SetOutcomes", tracing.KeyTableID.String(id.String()))
+	defer span.End()
+
+	logging.FromContext(ctx).WithField(logging.FieldTableID, id).Debug("storing outcomes")
+
+	return r.StorageProvider.SetOutcomes(ctx, id, version,
+		storage.AdaptOutcomesFromDomain(outcomes),
+		storage.AdaptMultipliersFromDomain(multipliers),
+		spunAt,
+		spunBy,
+	)
 }
 
-// SetOutcome adapts from domain to storage and updates the given Table in memory.
-func (r Repository) SetOutcome(ctx context.Context, id uuid.UUID, outcome domain.Outcome) error {
-	storageOutcome := storage.AdaptOutcomeFromDomain(&outcome)
+// Settle records when the Table was settled and by whom, provided it is still at the given version.
+func (r Repository) Settle(ctx context.Context, id uuid.UUID, version int64, settledAt time.Time, settledBy string) error {
+	ctx, span := tracing.Start(ctx, "table.Repository.Settle", tracing.KeyTableID.String(id.String()))
+	defer span.End()
+
+	logging.FromContext(ctx).WithField(logging.FieldTableID, id).Debug("storing settled table")
 
-	return r.StorageProvider.SetOutcome(ctx, id, *storageOutcome)
+	return r.StorageProvider.Settle(ctx, id, version, settledAt, settledBy)
 }
@@ -6,6 +6,7 @@ import (
 	"betting/storage/memory"
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -29,7 +30,7 @@ func TestRepository_Close_Success(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			repo := NewRepository(test.givenTableStorage)
 
-			err := repo.Close(context.Background(), test.givenID)
+			err := repo.Close(context.Background(), test.givenID, 1, time.Time{}, "")
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -57,7 +58,7 @@ func TestRepository_Close_Fail(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			repo := NewRepository(test.givenTableStorage)
 
-			err := repo.Close(context.Background(), test.givenID)
+			err := repo.Close(context.Background(), test.givenID, 1, time.Time{}, "")
 			if err == nil {
 				t.Fatalf("expected %v, got nil", test.expectedError)
 			}
@@ -81,7 +82,6 @@ func TestRepository_Insert_Success(t *testing.T) {
 				ID:       uuid.MustParse("c4b39dc0-2ff4-4405-b3cb-c4f87a9c82fb"),
 				Bets:     nil,
 				IsClosed: false,
-				Outcome:  nil,
 			},
 			givenTableStorage: mockTableStorage{},
 		},
@@ -112,14 +112,12 @@ func TestRepository_Get_Success(t *testing.T) {
 				GivenGetTable: storage.Table{
 					ID:       uuid.MustParse("c4b39dc0-2ff4-4405-b3cb-c4f87a9c82fb"),
 					IsClosed: false,
-					Outcome:  nil,
 				},
 			},
 			expectedTable: domain.Table{
 				ID:       uuid.MustParse("c4b39dc0-2ff4-4405-b3cb-c4f87a9c82fb"),
 				Bets:     nil,
 				IsClosed: false,
-				Outcome:  nil,
 			},
 		},
 	}
@@ -175,25 +173,56 @@ func TestRepository_List_Success(t *testing.T) {
 	tests := []struct {
 		name              string
 		givenTableStorage StorageProvider
-		expectedTables    []domain.Table
+		givenQuery        domain.TableQuery
+		expectedPage      domain.TablePage
 	}{
 		{
 			name: "expect all tables to be returned",
 			givenTableStorage: mockTableStorage{
-				GivenListTables: []storage.Table{
+				GivenListPage: storage.TablePage{
+					Tables: []storage.Table{
+						{
+							ID:       uuid.MustParse("c4b39dc0-2ff4-4405-b3cb-c4f87a9c82fb"),
+							IsClosed: false,
+						},
+					},
+				},
+			},
+			expectedPage: domain.TablePage{
+				Tables: []domain.Table{
 					{
 						ID:       uuid.MustParse("c4b39dc0-2ff4-4405-b3cb-c4f87a9c82fb"),
+						Bets:     nil,
 						IsClosed: false,
-						Outcome:  nil,
 					},
 				},
 			},
-			expectedTables: []domain.Table{
-				{
-					ID:       uuid.MustParse("c4b39dc0-2ff4-4405-b3cb-c4f87a9c82fb"),
-					Bets:     nil,
-					IsClosed: false,
-					Outcome:  nil,
+		},
+		{
+			name: "given more tables follow, expect the next cursor to be returned",
+			givenTableStorage: mockTableStorage{
+				GivenListPage: storage.TablePage{
+					Tables: []storage.Table{
+						{
+							ID: uuid.MustParse("c4b39dc0-2ff4-4405-b3cb-c4f87a9c82fb"),
+						},
+					},
+					Next: &storage.TableCursor{
+						ID: uuid.MustParse("c4b39dc0-2ff4-4405-b3cb-c4f87a9c82fb"),
+					},
+				},
+			},
+			givenQuery: domain.TableQuery{
+				Limit: 1,
+			},
+			expectedPage: domain.TablePage{
+				Tables: []domain.Table{
+					{
+						ID: uuid.MustParse("c4b39dc0-2ff4-4405-b3cb-c4f87a9c82fb"),
+					},
+				},
+				Next: &domain.TableCursor{
+					ID: uuid.MustParse("c4b39dc0-2ff4-4405-b3cb-c4f87a9c82fb"),
 				},
 			},
 		},
@@ -202,13 +231,13 @@ func TestRepository_List_Success(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			repo := NewRepository(test.givenTableStorage)
 
-			actual, err := repo.List(context.Background())
+			actual, err := repo.List(context.Background(), test.givenQuery)
 			if err != nil {
 				t.Fatal(err)
 			}
 
-			if !cmp.Equal(actual, test.expectedTables) {
-				t.Fatal(cmp.Diff(actual, test.expectedTables))
+			if !cmp.Equal(actual, test.expectedPage) {
+				t.Fatal(cmp.Diff(actual, test.expectedPage))
 			}
 		})
 	}
@@ -232,7 +261,7 @@ func TestRepository_List_Fail(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			repo := NewRepository(test.givenTableStorage)
 
-			_, err := repo.List(context.Background())
+			_, err := repo.List(context.Background(), domain.TableQuery{})
 			if err == nil {
 				t.Fatalf("expected %v, got nil", test.expectedError)
 			}
@@ -244,20 +273,20 @@ func TestRepository_List_Fail(t *testing.T) {
 	}
 }
 
-func TestRepository_SetOutcome_Success(t *testing.T) {
+func TestRepository_SetOutcomes_Success(t *testing.T) {
 	tests := []struct {
 		name              string
 		givenID           uuid.UUID
-		givenOutcome      domain.Outcome
+		givenOutcomes     []domain.Outcome
 		givenTableStorage StorageProvider
 	}{
 		{
-			name:    "given an id and outcome, expect the table to updated",
+			name:    "given an id and outcomes, expect the table to updated",
 			givenID: uuid.MustParse("c4b39dc0-2ff4-4405-b3cb-c4f87a9c82fb"),
-			givenOutcome: domain.Outcome{
+			givenOutcomes: []domain.Outcome{{
 				Value:  16,
 				Colour: "red",
-			},
+			}},
 			givenTableStorage: mockTableStorage{},
 		},
 	}
@@ -265,7 +294,7 @@ func TestRepository_SetOutcome_Success(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			repo := NewRepository(test.givenTableStorage)
 
-			err := repo.SetOutcome(context.Background(), test.givenID, test.givenOutcome)
+			err := repo.SetOutcomes(context.Background(), test.givenID, 1, test.givenOutcomes, nil, time.Time{}, "")
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -274,16 +303,17 @@ func TestRepository_SetOutcome_Success(t *testing.T) {
 }
 
 type mockTableStorage struct {
-	GivenCloseError      error
-	GivenInsertError     error
-	GivenSetOutcomeError error
-	GivenGetTable        storage.Table
-	GivenGetError        error
-	GivenListTables      []storage.Table
-	GivenListError       error
+	GivenCloseError       error
+	GivenInsertError      error
+	GivenSetOutcomesError error
+	GivenSettleError      error
+	GivenGetTable         storage.Table
+	GivenGetError         error
+	GivenListPage         storage.TablePage
+	GivenListError        error
 }
 
-func (m mockTableStorage) Close(_ context.Context, _ uuid.UUID) error {
+func (m mockTableStorage) Close(_ context.Context, _ uuid.UUID, _ int64, _ time.Time, _ string) error {
 	return m.GivenCloseError
 }
 
@@ -291,14 +321,26 @@ func (m mockTableStorage) Insert(_ context.Context, _ storage.Table) error {
 	return m.GivenInsertError
 }
 
-func (m mockTableStorage) SetOutcome(_ context.Context, _ uuid.UUID, _ storage.Outcome) error {
-	return m.GivenSetOutcomeError
+func (m mockTableStorage) SetOutcomes(
+	_ context.Context,
+	_ uuid.UUID,
+	_ int64,
+	_ []storage.Outcome,
+	_ []storage.Multiplier,
+	_ time.Time,
+	_ string,
+) error {
+	return m.GivenSetOutcomesError
+}
+
+func (m mockTableStorage) Settle(_ context.Context, _ uuid.UUID, _ int64, _ time.Time, _ string) error {
+	return m.GivenSettleError
 }
 
 func (m mockTableStorage) Get(_ context.Context, _ uuid.UUID) (storage.Table, error) {
 	return m.GivenGetTable, m.GivenGetError
 }
 
-func (m mockTableStorage) List(_ context.Context) ([]storage.Table, error) {
-	return m.GivenListTables, m.GivenListError
+func (m mockTableStorage) List(_ context.Context, _ storage.TableQuery) (storage.TablePage, error) {
+	return m.GivenListPage, m.GivenListError
 }
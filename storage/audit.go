@@ -0,0 +1,128 @@
+package storage
+
+import (
+	"betting/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditEntry is the storage representation of domain.AuditEntry.
+type AuditEntry struct {
+	Sequence     int64
+	Action       string
+	Subject      uuid.UUID
+	Actor        string
+	RequestID    string
+	Route        string
+	BodyHash     string
+	RecordedAt   time.Time
+	Changes      []Change
+	PreviousHash string
+	Hash         string
+}
+
+// Change is the storage representation of domain.Change.
+type Change struct {
+	Field  string
+	Before string
+	After  string
+}
+
+// AuditQuery is the storage representation of domain.AuditQuery. A Limit of zero returns every matching entry.
+type AuditQuery struct {
+	Subject uuid.UUID
+	Actor   string
+	After   int64
+	Limit   int
+}
+
+// AuditPage is the storage representation of domain.AuditPage.
+type AuditPage struct {
+	Entries []AuditEntry
+	Next    int64
+}
+
+// AdaptAuditEntryToDomain returns a domain.AuditEntry for a given AuditEntry.
+func AdaptAuditEntryToDomain(entry AuditEntry) domain.AuditEntry {
+	e := domain.AuditEntry{
+		Sequence:     entry.Sequence,
+		Action:       domain.AuditAction(entry.Action),
+		Subject:      entry.Subject,
+		Actor:        entry.Actor,
+		RequestID:    entry.RequestID,
+		Route:        entry.Route,
+		BodyHash:     entry.BodyHash,
+		RecordedAt:   entry.RecordedAt,
+		PreviousHash: entry.PreviousHash,
+		Hash:         entry.Hash,
+	}
+
+	if entry.Changes != nil {
+		e.Changes = make([]domain.Change, len(entry.Changes))
+	}
+
+	for i := range entry.Changes {
+		e.Changes[i] = domain.Change{
+			Field:  entry.Changes[i].Field,
+			Before: entry.Changes[i].Before,
+			After:  entry.Changes[i].After,
+		}
+	}
+
+	return e
+}
+
+// AdaptAuditEntryFromDomain returns an AuditEntry for a given domain.AuditEntry.
+func AdaptAuditEntryFromDomain(entry domain.AuditEntry) AuditEntry {
+	e := AuditEntry{
+		Sequence:     entry.Sequence,
+		Action:       entry.Action.String(),
+		Subject:      entry.Subject,
+		Actor:        entry.Actor,
+		RequestID:    entry.RequestID,
+		Route:        entry.Route,
+		BodyHash:     entry.BodyHash,
+		RecordedAt:   entry.RecordedAt,
+		PreviousHash: entry.PreviousHash,
+		Hash:         entry.Hash,
+	}
+
+	if entry.Changes != nil {
+		e.Changes = make([]Change, len(entry.Changes))
+	}
+
+	for i := range entry.Changes {
+		e.Changes[i] = Change{
+			Field:  entry.Changes[i].Field,
+			Before: entry.Changes[i].Before,
+			After:  entry.Changes[i].After,
+		}
+	}
+
+	return e
+}
+
+// AdaptAuditQueryFromDomain adapts a domain.AuditQuery to an AuditQuery.
+func AdaptAuditQueryFromDomain(query domain.AuditQuery) AuditQuery {
+	return AuditQuery{
+		Subject: query.Subject,
+		Actor:   query.Actor,
+		After:   query.After,
+		Limit:   query.Limit,
+	}
+}
+
+// AdaptAuditPageToDomain adapts an AuditPage to a domain.AuditPage.
+func AdaptAuditPageToDomain(page AuditPage) domain.AuditPage {
+	entries := make([]domain.AuditEntry, len(page.Entries))
+
+	for i := range page.Entries {
+		entries[i] = AdaptAuditEntryToDomain(page.Entries[i])
+	}
+
+	return domain.AuditPage{
+		Entries: entries,
+		Next:    page.Next,
+	}
+}
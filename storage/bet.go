@@ -8,16 +8,38 @@ import (
 	"github.com/google/uuid"
 )
 
-// Bet is storage representation of domain.Bet.
+// Bet is storage representation of domain.Bet. Version starts at one and is incremented by every write.
 type Bet struct {
 	ID             uuid.UUID
 	Status         string
 	SelectedSpaces []int
 	Stake          *money.Money
+	Announcement   *Announcement
+	Placements     []Placement
 	PlacedAt       time.Time
 	SettledAt      *time.Time
 	Win            bool
+	Refund         *money.Money
+	ImprisonedOn   uuid.UUID
 	Table          uuid.UUID
+	Slip           uuid.UUID
+	Contribution   *money.Money
+	JackpotWin     *money.Money
+	Version        int64
+}
+
+// Announcement is the storage representation of domain.Announcement.
+type Announcement struct {
+	Call       string
+	Number     int
+	Neighbours int
+}
+
+// Placement is the storage representation of domain.Placement.
+type Placement struct {
+	SelectedSpaces []int
+	Stake          *money.Money
+	Win            bool
 }
 
 // AdaptBetsToDomain adapts multiple Bet to domain.Bet.
@@ -48,10 +70,18 @@ func AdaptBetToStorage(bet domain.Bet) Bet {
 		Status:         bet.Status.String(),
 		SelectedSpaces: bet.SelectedSpaces,
 		Stake:          bet.Stake,
+		Announcement:   adaptAnnouncementToStorage(bet.Announcement),
+		Placements:     adaptPlacementsToStorage(bet.Placements),
 		PlacedAt:       bet.PlacedAt,
 		Win:            bet.Win,
 		SettledAt:      bet.SettledAt,
+		Refund:         bet.Refund,
+		ImprisonedOn:   bet.ImprisonedOn,
 		Table:          bet.Table,
+		Slip:           bet.Slip,
+		Contribution:   bet.Contribution,
+		JackpotWin:     bet.JackpotWin,
+		Version:        bet.Version,
 	}
 }
 
@@ -62,9 +92,78 @@ func AdaptBetToDomain(bet Bet) domain.Bet {
 		Status:         domain.BetStatus(bet.Status),
 		SelectedSpaces: bet.SelectedSpaces,
 		Stake:          bet.Stake,
+		Announcement:   adaptAnnouncementToDomain(bet.Announcement),
+		Placements:     adaptPlacementsToDomain(bet.Placements),
 		PlacedAt:       bet.PlacedAt,
 		SettledAt:      bet.SettledAt,
 		Win:            bet.Win,
+		Refund:         bet.Refund,
+		ImprisonedOn:   bet.ImprisonedOn,
 		Table:          bet.Table,
+		Slip:           bet.Slip,
+		Jackpot:        bet.Contribution != nil,
+		Contribution:   bet.Contribution,
+		JackpotWin:     bet.JackpotWin,
+		Version:        bet.Version,
+	}
+}
+
+func adaptAnnouncementToStorage(announcement *domain.Announcement) *Announcement {
+	if announcement == nil {
+		return nil
+	}
+
+	return &Announcement{
+		Call:       announcement.Call.String(),
+		Number:     announcement.Number,
+		Neighbours: announcement.Neighbours,
 	}
 }
+
+func adaptAnnouncementToDomain(announcement *Announcement) *domain.Announcement {
+	if announcement == nil {
+		return nil
+	}
+
+	return &domain.Announcement{
+		Call:       domain.Call(announcement.Call),
+		Number:     announcement.Number,
+		Neighbours: announcement.Neighbours,
+	}
+}
+
+func adaptPlacementsToStorage(placements []domain.Placement) []Placement {
+	if placements == nil {
+		return nil
+	}
+
+	p := make([]Placement, len(placements))
+
+	for i := range placements {
+		p[i] = Placement{
+			SelectedSpaces: placements[i].SelectedSpaces,
+			Stake:          placements[i].Stake,
+			Win:            placements[i].Win,
+		}
+	}
+
+	return p
+}
+
+func adaptPlacementsToDomain(placements []Placement) []domain.Placement {
+	if placements == nil {
+		return nil
+	}
+
+	p := make([]domain.Placement, len(placements))
+
+	for i := range placements {
+		p[i] = domain.Placement{
+			SelectedSpaces: placements[i].SelectedSpaces,
+			Stake:          placements[i].Stake,
+			Win:            placements[i].Win,
+		}
+	}
+
+	return p
+}
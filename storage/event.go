@@ -0,0 +1,107 @@
+package storage
+
+import (
+	"betting/internal/domain"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventStore holds the Events the Tables and Bets in storage are projected from. Events are only ever appended.
+type EventStore interface {
+	Append(ctx context.Context, events ...Event) error
+	List(ctx context.Context, stream uuid.UUID) ([]Event, error)
+	All(ctx context.Context) ([]Event, error)
+}
+
+// Event is the storage representation of domain.Event, Data holding one of the event types below as JSON.
+type Event struct {
+	Stream     uuid.UUID
+	Sequence   int64
+	Type       string
+	RecordedAt time.Time
+	Data       []byte
+}
+
+// TableClosed is the Data of a domain.TableClosed Event.
+type TableClosed struct {
+	ClosedAt time.Time
+	ClosedBy string
+}
+
+// OutcomeDrawn is the Data of a domain.OutcomeDrawn Event.
+type OutcomeDrawn struct {
+	Outcomes    []Outcome
+	Multipliers []Multiplier
+	SpunAt      time.Time
+	SpunBy      string
+}
+
+// TableSettled is the Data of a domain.TableSettled Event.
+type TableSettled struct {
+	SettledAt time.Time
+	SettledBy string
+}
+
+// BetsCarried is the Data of a domain.BetsCarried Event, the imprisoned Bets moved from a Table onto the Stream's.
+type BetsCarried struct {
+	From uuid.UUID
+	Bets []uuid.UUID
+}
+
+// BetsMarked is the Data of a domain.BetsMarked Event, every Bet of the Stream's Table moved to Status. SettledAt is
+// only set when they are settled.
+type BetsMarked struct {
+	Status    string
+	SettledAt *time.Time
+}
+
+// BetsSettled is the Data of a domain.BetsSettled Event, the Bets of the Stream's Table with their results.
+type BetsSettled struct {
+	Bets []Bet
+}
+
+// NewEvent returns an Event of the given type on the given stream, encoding data as its Data. A domain.TableOpened Event
+// holds a Table and a domain.BetPlaced Event a Bet.
+func NewEvent(stream uuid.UUID, kind domain.EventType, data interface{}) (Event, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, fmt.Errorf("%v event: %w", kind, err)
+	}
+
+	return Event{
+		Stream: stream,
+		Type:   kind.String(),
+		Data:   b,
+	}, nil
+}
+
+// Decode reads the Data of the Event into v.
+func (e Event) Decode(v interface{}) error {
+	err := json.Unmarshal(e.Data, v)
+	if err != nil {
+		return fmt.Errorf("%v event %d: %w", e.Type, e.Sequence, err)
+	}
+
+	return nil
+}
+
+// AdaptEventsToDomain adapts Events to domain.Events.
+func AdaptEventsToDomain(events []Event) []domain.Event {
+	e := make([]domain.Event, len(events))
+
+	for i := range events {
+		e[i] = domain.Event{
+			Stream:     events[i].Stream,
+			Sequence:   events[i].Sequence,
+			Type:       domain.EventType(events[i].Type),
+			RecordedAt: events[i].RecordedAt,
+			Data:       events[i].Data,
+		}
+	}
+
+	return e
+}
@@ -0,0 +1,58 @@
+package storage
+
+import (
+	"betting/internal/domain"
+
+	"github.com/Rhymond/go-money"
+	"github.com/google/uuid"
+)
+
+// Jackpot is the storage representation of domain.Jackpot.
+type Jackpot struct {
+	Pool   []*money.Money
+	Awards []JackpotAward
+}
+
+// JackpotAward is the storage representation of domain.JackpotAward.
+type JackpotAward struct {
+	Bet    uuid.UUID
+	Table  uuid.UUID
+	Amount *money.Money
+}
+
+// AdaptJackpotToDomain returns a domain.Jackpot for a given Jackpot.
+func AdaptJackpotToDomain(jackpot Jackpot) domain.Jackpot {
+	var awards []domain.JackpotAward
+
+	if jackpot.Awards != nil {
+		awards = make([]domain.JackpotAward, len(jackpot.Awards))
+	}
+
+	for i := range jackpot.Awards {
+		awards[i] = domain.JackpotAward{
+			Bet:    jackpot.Awards[i].Bet,
+			Table:  jackpot.Awards[i].Table,
+			Amount: jackpot.Awards[i].Amount,
+		}
+	}
+
+	return domain.Jackpot{
+		Pool:   jackpot.Pool,
+		Awards: awards,
+	}
+}
+
+// AdaptJackpotAwardsToStorage adapts multiple domain.JackpotAward to JackpotAward.
+func AdaptJackpotAwardsToStorage(awards []domain.JackpotAward) []JackpotAward {
+	a := make([]JackpotAward, len(awards))
+
+	for i := range awards {
+		a[i] = JackpotAward{
+			Bet:    awards[i].Bet,
+			Table:  awards[i].Table,
+			Amount: awards[i].Amount,
+		}
+	}
+
+	return a
+}
@@ -0,0 +1,104 @@
+package memory
+
+import (
+	"betting/internal/pkg/tracing"
+	"betting/storage"
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// AuditStorage holds the AuditEntries in the order they were recorded. Entries can only be appended, and only when
+// they follow the last entry stored, so the chain of hashes cannot be forked. An entry appended within a unit of work is
+// removed again should the unit fail, units of work being run one at a time no other entry can have followed it.
+type AuditStorage struct {
+	entries []storage.AuditEntry
+	sync.RWMutex
+}
+
+// NewAuditStorage instantiates AuditStorage.
+func NewAuditStorage() *AuditStorage {
+	return &AuditStorage{}
+}
+
+// Last returns the latest AuditEntry, the zero AuditEntry when none has been recorded.
+func (a *AuditStorage) Last(ctx context.Context) (storage.AuditEntry, error) {
+	_, span := tracing.Start(ctx, "memory.AuditStorage.Last")
+	defer span.End()
+
+	a.RLock()
+	defer a.RUnlock()
+
+	if len(a.entries) == 0 {
+		return storage.AuditEntry{}, nil
+	}
+
+	return a.entries[len(a.entries)-1], nil
+}
+
+// Append records the given AuditEntry, provided it follows the latest one.
+func (a *AuditStorage) Append(ctx context.Context, entry storage.AuditEntry) error {
+	_, span := tracing.Start(ctx, "memory.AuditStorage.Append")
+	defer span.End()
+
+	a.Lock()
+	defer a.Unlock()
+
+	var last storage.AuditEntry
+	if len(a.entries) > 0 {
+		last = a.entries[len(a.entries)-1]
+	}
+
+	if entry.Sequence != last.Sequence+1 || entry.PreviousHash != last.Hash {
+		return tracing.Fail(span, storage.ErrChainBroken)
+	}
+
+	length := len(a.entries)
+
+	a.entries = append(a.entries, entry)
+
+	record(ctx, a, func() {
+		a.entries = a.entries[:length]
+	})
+
+	return nil
+}
+
+// List returns a page of the AuditEntries matching the query, in the order they were recorded.
+func (a *AuditStorage) List(ctx context.Context, query storage.AuditQuery) (storage.AuditPage, error) {
+	_, span := tracing.Start(ctx, "memory.AuditStorage.List")
+	defer span.End()
+
+	a.RLock()
+	defer a.RUnlock()
+
+	var page storage.AuditPage
+
+	// sequences start at one, so the entries after a sequence start at its index
+	start := query.After
+	if start < 0 {
+		start = 0
+	}
+
+	for i := start; i < int64(len(a.entries)); i++ {
+		entry := a.entries[i]
+
+		if query.Subject != uuid.Nil && entry.Subject != query.Subject {
+			continue
+		}
+
+		if query.Actor != "" && entry.Actor != query.Actor {
+			continue
+		}
+
+		if query.Limit > 0 && len(page.Entries) == query.Limit {
+			page.Next = page.Entries[len(page.Entries)-1].Sequence
+			break
+		}
+
+		page.Entries = append(page.Entries, entry)
+	}
+
+	return page, nil
+}
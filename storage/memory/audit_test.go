@@ -0,0 +1,148 @@
+package memory
+
+import (
+	"betting/storage"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+)
+
+var (
+	auditTable = uuid.MustParse("5d0b9f2e-61a4-4c57-9b0e-2f3a7c8d9e10")
+	auditBet   = uuid.MustParse("9e8d7c6b-5a49-4382-8716-05f4e3d2c1b0")
+)
+
+// auditEntries returns a chain of entries on auditTable and auditBet, made by the croupier and then by the player.
+func auditEntries() []storage.AuditEntry {
+	return []storage.AuditEntry{
+		{Sequence: 1, Action: "table.create", Subject: auditTable, Actor: "croupier", Hash: "a"},
+		{Sequence: 2, Action: "bet.create", Subject: auditBet, Actor: "player", PreviousHash: "a", Hash: "b"},
+		{Sequence: 3, Action: "table.spin", Subject: auditTable, Actor: "croupier", PreviousHash: "b", Hash: "c"},
+		{Sequence: 4, Action: "table.settle", Subject: auditTable, Actor: "croupier", PreviousHash: "c", Hash: "d"},
+	}
+}
+
+func TestAuditStorage_List_Success(t *testing.T) {
+	entries := auditEntries()
+
+	tests := []struct {
+		name         string
+		givenQuery   storage.AuditQuery
+		expectedPage storage.AuditPage
+	}{
+		{
+			name:         "given no query, expect every entry",
+			givenQuery:   storage.AuditQuery{},
+			expectedPage: storage.AuditPage{Entries: entries},
+		},
+		{
+			name:         "given a subject, expect only its entries",
+			givenQuery:   storage.AuditQuery{Subject: auditTable},
+			expectedPage: storage.AuditPage{Entries: []storage.AuditEntry{entries[0], entries[2], entries[3]}},
+		},
+		{
+			name:         "given an actor, expect only their entries",
+			givenQuery:   storage.AuditQuery{Actor: "player"},
+			expectedPage: storage.AuditPage{Entries: []storage.AuditEntry{entries[1]}},
+		},
+		{
+			name:         "given a limit, expect a page continuing after its last entry",
+			givenQuery:   storage.AuditQuery{Subject: auditTable, Limit: 2},
+			expectedPage: storage.AuditPage{Entries: []storage.AuditEntry{entries[0], entries[2]}, Next: 3},
+		},
+		{
+			name:         "given a page continued after a sequence, expect the entries after it",
+			givenQuery:   storage.AuditQuery{Subject: auditTable, After: 3, Limit: 2},
+			expectedPage: storage.AuditPage{Entries: []storage.AuditEntry{entries[3]}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			audit := NewAuditStorage()
+
+			for i := range entries {
+				err := audit.Append(context.Background(), entries[i])
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			actual, err := audit.List(context.Background(), test.givenQuery)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(actual, test.expectedPage) {
+				t.Fatal(cmp.Diff(actual, test.expectedPage))
+			}
+		})
+	}
+}
+
+func TestAuditStorage_Append_Fail(t *testing.T) {
+	tests := []struct {
+		name          string
+		givenEntry    storage.AuditEntry
+		expectedError error
+	}{
+		{
+			name:          "given an entry skipping a sequence, expect the chain to be broken",
+			givenEntry:    storage.AuditEntry{Sequence: 3, PreviousHash: "a", Hash: "c"},
+			expectedError: storage.ErrChainBroken,
+		},
+		{
+			name:          "given an entry following another hash, expect the chain to be broken",
+			givenEntry:    storage.AuditEntry{Sequence: 2, PreviousHash: "z", Hash: "b"},
+			expectedError: storage.ErrChainBroken,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			audit := NewAuditStorage()
+
+			err := audit.Append(context.Background(), auditEntries()[0])
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = audit.Append(context.Background(), test.givenEntry)
+			if !cmp.Equal(err, test.expectedError, cmpopts.EquateErrors()) {
+				t.Fatal(cmp.Diff(err, test.expectedError, cmpopts.EquateErrors()))
+			}
+		})
+	}
+}
+
+func TestAuditStorage_Transact_Rollback(t *testing.T) {
+	entries := auditEntries()
+	audit := NewAuditStorage()
+
+	err := audit.Append(context.Background(), entries[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = NewUnitOfWork().Transact(context.Background(), func(ctx context.Context) error {
+		err := audit.Append(ctx, entries[1])
+		if err != nil {
+			return err
+		}
+
+		return errTransaction
+	})
+	if !cmp.Equal(err, errTransaction, cmpopts.EquateErrors()) {
+		t.Fatal(cmp.Diff(err, errTransaction, cmpopts.EquateErrors()))
+	}
+
+	actual, err := audit.Last(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !cmp.Equal(actual, entries[0]) {
+		t.Fatal(cmp.Diff(actual, entries[0]))
+	}
+}
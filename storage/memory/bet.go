@@ -2,11 +2,12 @@ package memory
 
 import (
 	"betting/internal/domain"
+	"betting/internal/pkg/clock"
+	"betting/internal/pkg/tracing"
 	"betting/storage"
 	"context"
 	"errors"
 	"sync"
-	"time"
 
 	"github.com/google/uuid"
 )
@@ -17,100 +18,522 @@ var (
 	ErrInvalidKey   = errors.New("invalid key given")
 )
 
-// BetStorage holds the record of all created Bets.
+// BetStorage holds the record of all created Bets, indexed by the Table they were placed on and the Slip they were
+// placed with in order of placement. Bets are only accepted for Tables in the given TableStorage which are still open.
+// As with TableStorage, the Bets are projected from the Events recorded on the stream of their Table.
 type BetStorage struct {
-	bets map[uuid.UUID]storage.Bet
+	bets    map[uuid.UUID]storage.Bet
+	byTable map[uuid.UUID][]uuid.UUID
+	bySlip  map[uuid.UUID][]uuid.UUID
+	tables  *TableStorage
+	events  storage.EventStore
+	clock   clock.Clock
 	sync.RWMutex
 }
 
-// NewBetStorage instantiates BetStorage.
-func NewBetStorage() *BetStorage {
+// NewBetStorage instantiates BetStorage, placing Bets against the Tables held by tables, recording their Events in the
+// given EventStore and stamping the time they are settled from the given Clock.
+func NewBetStorage(tables *TableStorage, events storage.EventStore, clock clock.Clock) *BetStorage {
 	return &BetStorage{
-		bets: make(map[uuid.UUID]storage.Bet),
+		bets:    make(map[uuid.UUID]storage.Bet),
+		byTable: make(map[uuid.UUID][]uuid.UUID),
+		bySlip:  make(map[uuid.UUID][]uuid.UUID),
+		tables:  tables,
+		events:  events,
+		clock:   clock,
 	}
 }
 
+// Rebuild replaces the Bets held with those projected from every Event in the EventStore, in the order they were
+// recorded. Events of Tables are passed over.
+func (b *BetStorage) Rebuild(ctx context.Context) error {
+	ctx, span := tracing.Start(ctx, "memory.BetStorage.Rebuild")
+	defer span.End()
+
+	events, err := b.events.All(ctx)
+	if err != nil {
+		return tracing.Fail(span, err)
+	}
+
+	b.Lock()
+	defer b.Unlock()
+
+	bets, byTable, bySlip := b.bets, b.byTable, b.bySlip
+
+	b.bets = make(map[uuid.UUID]storage.Bet)
+	b.byTable = make(map[uuid.UUID][]uuid.UUID)
+	b.bySlip = make(map[uuid.UUID][]uuid.UUID)
+
+	for i := range events {
+		err = b.apply(events[i])
+		if err != nil {
+			b.bets, b.byTable, b.bySlip = bets, byTable, bySlip
+
+			return tracing.Fail(span, err)
+		}
+	}
+
+	span.SetAttributes(tracing.KeyBetCount.Int(len(b.bets)))
+
+	return nil
+}
+
 // Get returns a Bet for a given ID.
-func (b *BetStorage) Get(_ context.Context, id uuid.UUID) (storage.Bet, error) {
+func (b *BetStorage) Get(ctx context.Context, id uuid.UUID) (storage.Bet, error) {
+	_, span := tracing.Start(ctx, "memory.BetStorage.Get", tracing.KeyBetID.String(id.String()))
+	defer span.End()
+
 	b.RLock()
 	defer b.RUnlock()
 
 	bet, ok := b.bets[id]
 	if !ok {
-		return storage.Bet{}, ErrInvalidKey
+		return storage.Bet{}, tracing.Fail(span, ErrInvalidKey)
 	}
 
 	return bet, nil
 }
 
-// Insert creates a new Bet in memory.
-func (b *BetStorage) Insert(_ context.Context, bet storage.Bet) error {
+// Insert creates a new Bet in memory at the first version, provided its Table is still open. The Table is checked while
+// the Bets are locked so it cannot close, and have its Bets moved on, between the check and the write.
+func (b *BetStorage) Insert(ctx context.Context, bet storage.Bet) error {
+	ctx, span := tracing.Start(ctx, "memory.BetStorage.Insert",
+		tracing.KeyBetID.String(bet.ID.String()),
+		tracing.KeyTableID.String(bet.Table.String()),
+	)
+	defer span.End()
+
 	b.Lock()
 	defer b.Unlock()
 	_, ok := b.bets[bet.ID]
 	if ok {
-		return ErrDuplicateKey
+		return tracing.Fail(span, ErrDuplicateKey)
+	}
+
+	err := b.tables.checkOpen(bet.Table)
+	if err != nil {
+		return tracing.Fail(span, err)
+	}
+
+	err = b.place(ctx, bet)
+	if err != nil {
+		return tracing.Fail(span, err)
+	}
+
+	return nil
+}
+
+// InsertSlip creates the Bets of a Slip in memory at the first version, either all of them are inserted or none are.
+// As with Insert, the Table of each Bet must still be open when they are written.
+func (b *BetStorage) InsertSlip(ctx context.Context, bets []storage.Bet) error {
+	ctx, span := tracing.Start(ctx, "memory.BetStorage.InsertSlip", tracing.KeyBetCount.Int(len(bets)))
+	defer span.End()
+
+	b.Lock()
+	defer b.Unlock()
+
+	seen := make(map[uuid.UUID]bool, len(bets))
+
+	for i := range bets {
+		_, ok := b.bets[bets[i].ID]
+		if ok || seen[bets[i].ID] {
+			return tracing.Fail(span, ErrDuplicateKey)
+		}
+
+		seen[bets[i].ID] = true
+
+		err := b.tables.checkOpen(bets[i].Table)
+		if err != nil {
+			return tracing.Fail(span, err)
+		}
+	}
+
+	err := b.place(ctx, bets...)
+	if err != nil {
+		return tracing.Fail(span, err)
+	}
+
+	return nil
+}
+
+// place records a BetPlaced Event for each of the given Bets on the stream of its Table and indexes them at the first
+// version, the lock must already be held.
+func (b *BetStorage) place(ctx context.Context, bets ...storage.Bet) error {
+	events := make([]storage.Event, len(bets))
+
+	for i := range bets {
+		var err error
+
+		events[i], err = storage.NewEvent(bets[i].Table, domain.BetPlaced, bets[i])
+		if err != nil {
+			return err
+		}
 	}
 
-	b.bets[bet.ID] = bet
+	err := b.append(ctx, events...)
+	if err != nil {
+		return err
+	}
+
+	record(ctx, b, func() {
+		for i := range bets {
+			bet := bets[i]
+
+			delete(b.bets, bet.ID)
+			b.byTable[bet.Table] = removeID(b.byTable[bet.Table], bet.ID)
+
+			if bet.Slip != uuid.Nil {
+				b.bySlip[bet.Slip] = removeID(b.bySlip[bet.Slip], bet.ID)
+			}
+		}
+	})
 
 	return nil
 }
 
 // List returns all the Bets for a given Table ID.
-func (b *BetStorage) List(_ context.Context, id uuid.UUID) ([]storage.Bet, error) {
+func (b *BetStorage) List(ctx context.Context, id uuid.UUID) ([]storage.Bet, error) {
+	_, span := tracing.Start(ctx, "memory.BetStorage.List", tracing.KeyTableID.String(id.String()))
+	defer span.End()
+
 	b.RLock()
 	defer b.RUnlock()
 
-	var bets []storage.Bet
+	return b.listByTable(id), nil
+}
+
+// ListBySlip returns the Bets placed with a given Slip ID, in the order they were given.
+func (b *BetStorage) ListBySlip(ctx context.Context, id uuid.UUID) ([]storage.Bet, error) {
+	_, span := tracing.Start(ctx, "memory.BetStorage.ListBySlip", tracing.KeySlipID.String(id.String()))
+	defer span.End()
+
+	b.RLock()
+	defer b.RUnlock()
+
+	ids := b.bySlip[id]
+	if len(ids) == 0 {
+		return nil, tracing.Fail(span, ErrInvalidKey)
+	}
+
+	bets := make([]storage.Bet, len(ids))
+
+	for i := range ids {
+		bets[i] = b.bets[ids[i]]
+	}
+
+	return bets, nil
+}
+
+// ListByTables returns all the Bets for each of the given Table IDs, keyed by Table ID. Tables without Bets are
+// absent from the result.
+func (b *BetStorage) ListByTables(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID][]storage.Bet, error) {
+	_, span := tracing.Start(ctx, "memory.BetStorage.ListByTables", tracing.KeyTableCount.Int(len(ids)))
+	defer span.End()
 
-	for i := range b.bets {
-		if b.bets[i].Table != id {
+	b.RLock()
+	defer b.RUnlock()
+
+	bets := make(map[uuid.UUID][]storage.Bet, len(ids))
+
+	for i := range ids {
+		tableBets := b.listByTable(ids[i])
+		if tableBets == nil {
 			continue
 		}
 
-		bets = append(bets, b.bets[i])
+		bets[ids[i]] = tableBets
 	}
 
 	return bets, nil
 }
 
-// UpdateStateByTableID updates all Bets for a given Table with the given status.
-func (b *BetStorage) UpdateStateByTableID(_ context.Context, id uuid.UUID, status domain.BetStatus) error {
+func (b *BetStorage) listByTable(id uuid.UUID) []storage.Bet {
+	ids := b.byTable[id]
+	if len(ids) == 0 {
+		return nil
+	}
+
+	bets := make([]storage.Bet, len(ids))
+
+	for i := range ids {
+		bets[i] = b.bets[ids[i]]
+	}
+
+	return bets
+}
+
+// UpdateStateByTableID updates all Bets for a given Table with the given status, moving each to its next version.
+func (b *BetStorage) UpdateStateByTableID(ctx context.Context, id uuid.UUID, status domain.BetStatus) error {
+	ctx, span := tracing.Start(ctx, "memory.BetStorage.UpdateStateByTableID", tracing.KeyTableID.String(id.String()))
+	defer span.End()
+
 	b.Lock()
 	defer b.Unlock()
 
-	for i := range b.bets {
-		if b.bets[i].Table != id {
+	previous := b.listByTable(id)
+	if len(previous) == 0 {
+		return nil
+	}
+
+	marked := storage.BetsMarked{Status: status.String()}
+
+	if status == domain.Settled {
+		t := b.clock.Now()
+
+		marked.SettledAt = &t
+	}
+
+	event, err := storage.NewEvent(id, domain.BetsMarked, marked)
+	if err != nil {
+		return tracing.Fail(span, err)
+	}
+
+	err = b.append(ctx, event)
+	if err != nil {
+		return tracing.Fail(span, err)
+	}
+
+	record(ctx, b, func() {
+		b.restore(previous)
+	})
+
+	return nil
+}
+
+// CarryImprisoned moves the imprisoned Bets of one Table onto another, each to its next version, so they are resolved
+// by the round that follows. The Table carried to must still be open.
+func (b *BetStorage) CarryImprisoned(ctx context.Context, from, to uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "memory.BetStorage.CarryImprisoned", tracing.KeyTableID.String(to.String()))
+	defer span.End()
+
+	b.Lock()
+	defer b.Unlock()
+
+	err := b.tables.checkOpen(to)
+	if err != nil {
+		return tracing.Fail(span, err)
+	}
+
+	var carried []storage.Bet
+
+	moved := storage.BetsCarried{From: from}
+
+	for _, betID := range b.byTable[from] {
+		bet := b.bets[betID]
+		if bet.Status != domain.Imprisoned.String() {
 			continue
 		}
 
-		bet := b.bets[i]
+		carried = append(carried, bet)
+		moved.Bets = append(moved.Bets, bet.ID)
+	}
+
+	if len(carried) == 0 {
+		return nil
+	}
 
-		bet.Status = status.String()
+	event, err := storage.NewEvent(to, domain.BetsCarried, moved)
+	if err != nil {
+		return tracing.Fail(span, err)
+	}
 
-		if status == domain.Settled {
-			t := time.Now().UTC()
+	err = b.append(ctx, event)
+	if err != nil {
+		return tracing.Fail(span, err)
+	}
 
-			bet.SettledAt = &t
+	record(ctx, b, func() {
+		for i := range carried {
+			b.byTable[to] = removeID(b.byTable[to], carried[i].ID)
+			b.byTable[from] = append(b.byTable[from], carried[i].ID)
 		}
 
-		b.bets[i] = bet
-	}
+		b.restore(carried)
+	})
+
+	span.SetAttributes(tracing.KeyBetCount.Int(len(carried)))
 
 	return nil
 }
 
-// SetWinners sets Bets to won status if they have the same number as the Outcome.
-func (b *BetStorage) SetWinners(_ context.Context, bets []storage.Bet) error {
+// SetWinners sets Bets to won status if they have the same number as the Outcome. Each Bet must still be at the version
+// it was read at, otherwise none are written.
+func (b *BetStorage) SetWinners(ctx context.Context, bets []storage.Bet) error {
+	ctx, span := tracing.Start(ctx, "memory.BetStorage.SetWinners", tracing.KeyBetCount.Int(len(bets)))
+	defer span.End()
+
 	b.Lock()
 	defer b.Unlock()
 
+	previous := make([]storage.Bet, 0, len(bets))
+
 	for i := range bets {
-		bet := bets[i]
+		if p, ok := b.bets[bets[i].ID]; ok {
+			if p.Version != bets[i].Version {
+				return tracing.Fail(span, storage.ErrVersionConflict)
+			}
+
+			previous = append(previous, p)
+		}
+	}
+
+	// the Bets of each Table are settled by an Event on its stream, in the order the Tables are first given
+	var tables []uuid.UUID
+
+	settled := make(map[uuid.UUID]storage.BetsSettled)
+
+	for i := range bets {
+		s, ok := settled[bets[i].Table]
+		if !ok {
+			tables = append(tables, bets[i].Table)
+		}
+
+		s.Bets = append(s.Bets, bets[i])
+		settled[bets[i].Table] = s
+	}
+
+	events := make([]storage.Event, len(tables))
+
+	for i := range tables {
+		var err error
+
+		events[i], err = storage.NewEvent(tables[i], domain.BetsSettled, settled[tables[i]])
+		if err != nil {
+			return tracing.Fail(span, err)
+		}
+	}
+
+	err := b.append(ctx, events...)
+	if err != nil {
+		return tracing.Fail(span, err)
+	}
+
+	record(ctx, b, func() {
+		for i := range bets {
+			delete(b.bets, bets[i].ID)
+		}
+
+		b.restore(previous)
+	})
+
+	return nil
+}
+
+// append records the given Events and applies them, the lock must already be held.
+func (b *BetStorage) append(ctx context.Context, events ...storage.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	err := b.events.Append(ctx, events...)
+	if err != nil {
+		return err
+	}
+
+	for i := range events {
+		err = b.apply(events[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// apply projects the Event onto the Bets of its stream, each Event after a Bet is placed moving the Bets it changes on
+// to their next version. The lock must already be held.
+func (b *BetStorage) apply(event storage.Event) error {
+	switch domain.EventType(event.Type) {
+	case domain.BetPlaced:
+		var bet storage.Bet
+
+		err := event.Decode(&bet)
+		if err != nil {
+			return err
+		}
+
+		bet.Version = 1
 
 		b.bets[bet.ID] = bet
+		b.byTable[bet.Table] = append(b.byTable[bet.Table], bet.ID)
+
+		if bet.Slip != uuid.Nil {
+			b.bySlip[bet.Slip] = append(b.bySlip[bet.Slip], bet.ID)
+		}
+	case domain.BetsCarried:
+		var carried storage.BetsCarried
+
+		err := event.Decode(&carried)
+		if err != nil {
+			return err
+		}
+
+		for _, id := range carried.Bets {
+			bet := b.bets[id]
+
+			bet.Table = event.Stream
+			bet.Version++
+
+			b.bets[id] = bet
+			b.byTable[carried.From] = removeID(b.byTable[carried.From], id)
+			b.byTable[event.Stream] = append(b.byTable[event.Stream], id)
+		}
+	case domain.BetsMarked:
+		var marked storage.BetsMarked
+
+		err := event.Decode(&marked)
+		if err != nil {
+			return err
+		}
+
+		for _, id := range b.byTable[event.Stream] {
+			bet := b.bets[id]
+
+			bet.Status = marked.Status
+			bet.Version++
+
+			if marked.SettledAt != nil {
+				settledAt := *marked.SettledAt
+
+				bet.SettledAt = &settledAt
+			}
+
+			b.bets[id] = bet
+		}
+	case domain.BetsSettled:
+		var settled storage.BetsSettled
+
+		err := event.Decode(&settled)
+		if err != nil {
+			return err
+		}
+
+		for i := range settled.Bets {
+			bet := settled.Bets[i]
+
+			bet.Version++
+
+			b.bets[bet.ID] = bet
+		}
 	}
 
 	return nil
 }
+
+// restore puts back the given Bets as they were before a write, the lock must already be held.
+func (b *BetStorage) restore(bets []storage.Bet) {
+	for i := range bets {
+		b.bets[bets[i].ID] = bets[i]
+	}
+}
+
+// removeID returns ids without the given ID, keeping the order of the rest.
+func removeID(ids []uuid.UUID, id uuid.UUID) []uuid.UUID {
+	for i := range ids {
+		if ids[i] == id {
+			return append(ids[:i:i], ids[i+1:]...)
+		}
+	}
+
+	return ids
+}
@@ -2,9 +2,11 @@ package memory
 
 import (
 	"betting/internal/domain"
+	"betting/internal/pkg/clock"
 	"betting/storage"
 	"betting/testing/opts"
 	"context"
+	"fmt"
 	"sync"
 	"testing"
 
@@ -42,6 +44,7 @@ func TestBetStorage_Get_Success(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			store := BetStorage{
 				bets:    test.givenBets,
+				byTable: indexByTable(test.givenBets),
 				RWMutex: sync.RWMutex{},
 			}
 
@@ -80,6 +83,7 @@ func TestBetStorage_Get_Fail(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			store := BetStorage{
 				bets:    test.givenBets,
+				byTable: indexByTable(test.givenBets),
 				RWMutex: sync.RWMutex{},
 			}
 
@@ -97,22 +101,27 @@ func TestBetStorage_Get_Fail(t *testing.T) {
 
 func TestBetStorage_Insert_Success(t *testing.T) {
 	tests := []struct {
-		name      string
-		givenBets map[uuid.UUID]storage.Bet
-		givenBet  storage.Bet
+		name        string
+		givenTables map[uuid.UUID]storage.Table
+		givenBet    storage.Bet
 	}{
 		{
-			name:      "given a bet which has not previously been inserted, expect success",
-			givenBets: map[uuid.UUID]storage.Bet{},
+			name: "given a bet which has not previously been inserted on an open table, expect success",
+			givenTables: map[uuid.UUID]storage.Table{
+				uuid.MustParse("6e6e9f5e-0f3b-4d8e-9a4f-36d1b7a1d6c2"): {
+					ID: uuid.MustParse("6e6e9f5e-0f3b-4d8e-9a4f-36d1b7a1d6c2"),
+				},
+			},
 			givenBet: storage.Bet{
 				ID:    uuid.MustParse("22ee17b5-fae7-4c13-80cc-4354820df3d4"),
 				Stake: money.New(100, "GBP"),
+				Table: uuid.MustParse("6e6e9f5e-0f3b-4d8e-9a4f-36d1b7a1d6c2"),
 			},
 		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			store := NewBetStorage()
+			store := NewBetStorage(&TableStorage{tables: test.givenTables}, NewEventStore(clock.NewFake(stampedAt)), clock.NewFake(stampedAt))
 
 			err := store.Insert(context.Background(), test.givenBet)
 			if err != nil {
@@ -125,29 +134,66 @@ func TestBetStorage_Insert_Success(t *testing.T) {
 func TestBetStorage_Insert_Fail(t *testing.T) {
 	tests := []struct {
 		name          string
+		givenTables   map[uuid.UUID]storage.Table
 		givenBets     map[uuid.UUID]storage.Bet
 		givenBet      storage.Bet
 		expectedError error
 	}{
 		{
 			name: "given a bet which has already been inserted, expect ErrDuplicateKey",
+			givenTables: map[uuid.UUID]storage.Table{
+				uuid.MustParse("6e6e9f5e-0f3b-4d8e-9a4f-36d1b7a1d6c2"): {
+					ID: uuid.MustParse("6e6e9f5e-0f3b-4d8e-9a4f-36d1b7a1d6c2"),
+				},
+			},
 			givenBets: map[uuid.UUID]storage.Bet{
 				uuid.MustParse("22ee17b5-fae7-4c13-80cc-4354820df3d4"): {
 					ID:    uuid.MustParse("22ee17b5-fae7-4c13-80cc-4354820df3d4"),
 					Stake: money.New(100, "GBP"),
+					Table: uuid.MustParse("6e6e9f5e-0f3b-4d8e-9a4f-36d1b7a1d6c2"),
 				},
 			},
 			givenBet: storage.Bet{
 				ID:    uuid.MustParse("22ee17b5-fae7-4c13-80cc-4354820df3d4"),
 				Stake: money.New(100, "GBP"),
+				Table: uuid.MustParse("6e6e9f5e-0f3b-4d8e-9a4f-36d1b7a1d6c2"),
 			},
 			expectedError: ErrDuplicateKey,
 		},
+		{
+			name: "given a bet on a closed table, expect storage.ErrTableClosed",
+			givenTables: map[uuid.UUID]storage.Table{
+				uuid.MustParse("6e6e9f5e-0f3b-4d8e-9a4f-36d1b7a1d6c2"): {
+					ID:       uuid.MustParse("6e6e9f5e-0f3b-4d8e-9a4f-36d1b7a1d6c2"),
+					IsClosed: true,
+				},
+			},
+			givenBets: map[uuid.UUID]storage.Bet{},
+			givenBet: storage.Bet{
+				ID:    uuid.MustParse("22ee17b5-fae7-4c13-80cc-4354820df3d4"),
+				Stake: money.New(100, "GBP"),
+				Table: uuid.MustParse("6e6e9f5e-0f3b-4d8e-9a4f-36d1b7a1d6c2"),
+			},
+			expectedError: storage.ErrTableClosed,
+		},
+		{
+			name:        "given a bet on a table which does not exist, expect ErrNoTables",
+			givenTables: map[uuid.UUID]storage.Table{},
+			givenBets:   map[uuid.UUID]storage.Bet{},
+			givenBet: storage.Bet{
+				ID:    uuid.MustParse("22ee17b5-fae7-4c13-80cc-4354820df3d4"),
+				Stake: money.New(100, "GBP"),
+				Table: uuid.MustParse("6e6e9f5e-0f3b-4d8e-9a4f-36d1b7a1d6c2"),
+			},
+			expectedError: ErrNoTables,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			store := BetStorage{
 				bets:    test.givenBets,
+				byTable: indexByTable(test.givenBets),
+				tables:  &TableStorage{tables: test.givenTables},
 				RWMutex: sync.RWMutex{},
 			}
 
@@ -159,6 +205,107 @@ func TestBetStorage_Insert_Fail(t *testing.T) {
 			if !cmp.Equal(err, test.expectedError, cmpopts.EquateErrors()) {
 				t.Fatal(cmp.Diff(err, test.expectedError, cmpopts.EquateErrors()))
 			}
+
+			if len(store.bets) != len(test.givenBets) {
+				t.Fatalf("expected %v bets to be stored, got %v", len(test.givenBets), len(store.bets))
+			}
+		})
+	}
+}
+
+func TestBetStorage_InsertSlip_Success(t *testing.T) {
+	tableID := uuid.MustParse("6e6e9f5e-0f3b-4d8e-9a4f-36d1b7a1d6c2")
+	slipID := uuid.MustParse("8f1f5f5c-7a43-4d0e-a4a8-1b8a2b8f4c11")
+
+	tables := &TableStorage{tables: map[uuid.UUID]storage.Table{tableID: {ID: tableID}}}
+	store := NewBetStorage(tables, NewEventStore(clock.NewFake(stampedAt)), clock.NewFake(stampedAt))
+
+	bets := []storage.Bet{
+		{ID: uuid.MustParse("22ee17b5-fae7-4c13-80cc-4354820df3d4"), Table: tableID, Slip: slipID},
+		{ID: uuid.MustParse("c4b39dc0-2ff4-4405-b3cb-c4f87a9c82fb"), Table: tableID, Slip: slipID},
+	}
+
+	err := store.InsertSlip(context.Background(), bets)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual, err := store.ListBySlip(context.Background(), slipID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []storage.Bet{
+		{ID: uuid.MustParse("22ee17b5-fae7-4c13-80cc-4354820df3d4"), Table: tableID, Slip: slipID, Version: 1},
+		{ID: uuid.MustParse("c4b39dc0-2ff4-4405-b3cb-c4f87a9c82fb"), Table: tableID, Slip: slipID, Version: 1},
+	}
+
+	if !cmp.Equal(actual, expected) {
+		t.Fatal(cmp.Diff(actual, expected))
+	}
+}
+
+func TestBetStorage_InsertSlip_Fail(t *testing.T) {
+	openTable := uuid.MustParse("6e6e9f5e-0f3b-4d8e-9a4f-36d1b7a1d6c2")
+	closedTable := uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce")
+	slipID := uuid.MustParse("8f1f5f5c-7a43-4d0e-a4a8-1b8a2b8f4c11")
+	existing := uuid.MustParse("22ee17b5-fae7-4c13-80cc-4354820df3d4")
+
+	tests := []struct {
+		name          string
+		givenBets     []storage.Bet
+		expectedError error
+	}{
+		{
+			name: "given a bet which has already been inserted, expect none of the slip to be inserted",
+			givenBets: []storage.Bet{
+				{ID: uuid.MustParse("c4b39dc0-2ff4-4405-b3cb-c4f87a9c82fb"), Table: openTable, Slip: slipID},
+				{ID: existing, Table: openTable, Slip: slipID},
+			},
+			expectedError: ErrDuplicateKey,
+		},
+		{
+			name: "given the same bet twice, expect none of the slip to be inserted",
+			givenBets: []storage.Bet{
+				{ID: uuid.MustParse("c4b39dc0-2ff4-4405-b3cb-c4f87a9c82fb"), Table: openTable, Slip: slipID},
+				{ID: uuid.MustParse("c4b39dc0-2ff4-4405-b3cb-c4f87a9c82fb"), Table: openTable, Slip: slipID},
+			},
+			expectedError: ErrDuplicateKey,
+		},
+		{
+			name: "given a bet on a closed table, expect none of the slip to be inserted",
+			givenBets: []storage.Bet{
+				{ID: uuid.MustParse("c4b39dc0-2ff4-4405-b3cb-c4f87a9c82fb"), Table: openTable, Slip: slipID},
+				{ID: uuid.MustParse("49cffe67-9798-4327-9760-c4b81562f928"), Table: closedTable, Slip: slipID},
+			},
+			expectedError: storage.ErrTableClosed,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			store := NewBetStorage(&TableStorage{tables: map[uuid.UUID]storage.Table{
+				openTable:   {ID: openTable},
+				closedTable: {ID: closedTable, IsClosed: true},
+			}}, NewEventStore(clock.NewFake(stampedAt)), clock.NewFake(stampedAt))
+
+			err := store.Insert(context.Background(), storage.Bet{ID: existing, Table: openTable})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = store.InsertSlip(context.Background(), test.givenBets)
+			if !cmp.Equal(err, test.expectedError, cmpopts.EquateErrors()) {
+				t.Fatal(cmp.Diff(err, test.expectedError, cmpopts.EquateErrors()))
+			}
+
+			if len(store.bets) != 1 {
+				t.Fatalf("expected only the existing bet to be stored, got %v", len(store.bets))
+			}
+
+			_, err = store.ListBySlip(context.Background(), slipID)
+			if !cmp.Equal(err, ErrInvalidKey, cmpopts.EquateErrors()) {
+				t.Fatal(cmp.Diff(err, ErrInvalidKey, cmpopts.EquateErrors()))
+			}
 		})
 	}
 }
@@ -198,6 +345,7 @@ func TestBetStorage_List(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			store := BetStorage{
 				bets:    test.givenBets,
+				byTable: indexByTable(test.givenBets),
 				RWMutex: sync.RWMutex{},
 			}
 
@@ -268,6 +416,7 @@ func TestBetStorage_FindWinners(t *testing.T) {
 					Stake:          money.New(100, "GBP"),
 					Table:          uuid.MustParse("1a31c7a1-6577-44c6-b3be-829674bf5175"),
 					SelectedSpaces: []int{12, 14},
+					Version:        1,
 				},
 				uuid.MustParse("0438312a-cd6c-44b2-9c98-966b975e11d2"): {
 					ID:             uuid.MustParse("0438312a-cd6c-44b2-9c98-966b975e11d2"),
@@ -275,11 +424,13 @@ func TestBetStorage_FindWinners(t *testing.T) {
 					Table:          uuid.MustParse("1a31c7a1-6577-44c6-b3be-829674bf5175"),
 					SelectedSpaces: []int{12, 14, 16},
 					Win:            true,
+					Version:        1,
 				},
 				uuid.MustParse("31b128ac-37de-4b24-99ca-1f3646798f41"): {
-					ID:    uuid.MustParse("31b128ac-37de-4b24-99ca-1f3646798f41"),
-					Stake: money.New(200, "GBP"),
-					Table: uuid.MustParse("78e7a130-761e-4188-a204-715e3ab747a3"),
+					ID:      uuid.MustParse("31b128ac-37de-4b24-99ca-1f3646798f41"),
+					Stake:   money.New(200, "GBP"),
+					Table:   uuid.MustParse("78e7a130-761e-4188-a204-715e3ab747a3"),
+					Version: 1,
 				},
 			},
 		},
@@ -287,7 +438,9 @@ func TestBetStorage_FindWinners(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			store := BetStorage{
+				events:  NewEventStore(clock.NewFake(stampedAt)),
 				bets:    test.givenBets,
+				byTable: indexByTable(test.givenBets),
 				RWMutex: sync.RWMutex{},
 			}
 
@@ -303,6 +456,71 @@ func TestBetStorage_FindWinners(t *testing.T) {
 	}
 }
 
+func TestBetStorage_SetWinners_Fail(t *testing.T) {
+	tests := []struct {
+		name          string
+		givenBets     map[uuid.UUID]storage.Bet
+		bets          []storage.Bet
+		expectedError error
+	}{
+		{
+			name: "given a bet which has been written since it was read, expect a conflict and no bets written",
+			givenBets: map[uuid.UUID]storage.Bet{
+				uuid.MustParse("22ee17b5-fae7-4c13-80cc-4354820df3d4"): {
+					ID:      uuid.MustParse("22ee17b5-fae7-4c13-80cc-4354820df3d4"),
+					Stake:   money.New(100, "GBP"),
+					Table:   uuid.MustParse("1a31c7a1-6577-44c6-b3be-829674bf5175"),
+					Version: 1,
+				},
+				uuid.MustParse("0438312a-cd6c-44b2-9c98-966b975e11d2"): {
+					ID:      uuid.MustParse("0438312a-cd6c-44b2-9c98-966b975e11d2"),
+					Stake:   money.New(100, "GBP"),
+					Table:   uuid.MustParse("1a31c7a1-6577-44c6-b3be-829674bf5175"),
+					Version: 3,
+				},
+			},
+			bets: []storage.Bet{
+				{
+					ID:      uuid.MustParse("22ee17b5-fae7-4c13-80cc-4354820df3d4"),
+					Stake:   money.New(100, "GBP"),
+					Table:   uuid.MustParse("1a31c7a1-6577-44c6-b3be-829674bf5175"),
+					Win:     true,
+					Version: 1,
+				},
+				{
+					ID:      uuid.MustParse("0438312a-cd6c-44b2-9c98-966b975e11d2"),
+					Stake:   money.New(100, "GBP"),
+					Table:   uuid.MustParse("1a31c7a1-6577-44c6-b3be-829674bf5175"),
+					Win:     true,
+					Version: 2,
+				},
+			},
+			expectedError: storage.ErrVersionConflict,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			store := BetStorage{
+				bets:    test.givenBets,
+				byTable: indexByTable(test.givenBets),
+				RWMutex: sync.RWMutex{},
+			}
+
+			err := store.SetWinners(context.Background(), test.bets)
+
+			if !cmp.Equal(err, test.expectedError, cmpopts.EquateErrors()) {
+				t.Fatal(cmp.Diff(err, test.expectedError, cmpopts.EquateErrors()))
+			}
+
+			for id, bet := range store.bets {
+				if bet.Win {
+					t.Fatalf("expected %v not to be written", id)
+				}
+			}
+		})
+	}
+}
+
 func TestBetStorage_UpdateStateByTableID(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -341,6 +559,7 @@ func TestBetStorage_UpdateStateByTableID(t *testing.T) {
 					Table:          uuid.MustParse("1a31c7a1-6577-44c6-b3be-829674bf5175"),
 					SelectedSpaces: []int{12, 14},
 					Status:         "live",
+					Version:        1,
 				},
 				uuid.MustParse("0438312a-cd6c-44b2-9c98-966b975e11d2"): {
 					ID:             uuid.MustParse("0438312a-cd6c-44b2-9c98-966b975e11d2"),
@@ -348,6 +567,7 @@ func TestBetStorage_UpdateStateByTableID(t *testing.T) {
 					Table:          uuid.MustParse("1a31c7a1-6577-44c6-b3be-829674bf5175"),
 					SelectedSpaces: []int{12, 14, 16},
 					Status:         "live",
+					Version:        1,
 				},
 				uuid.MustParse("31b128ac-37de-4b24-99ca-1f3646798f41"): {
 					ID:    uuid.MustParse("31b128ac-37de-4b24-99ca-1f3646798f41"),
@@ -386,6 +606,8 @@ func TestBetStorage_UpdateStateByTableID(t *testing.T) {
 					Table:          uuid.MustParse("1a31c7a1-6577-44c6-b3be-829674bf5175"),
 					SelectedSpaces: []int{12, 14},
 					Status:         "settled",
+					SettledAt:      &stampedAt,
+					Version:        1,
 				},
 				uuid.MustParse("0438312a-cd6c-44b2-9c98-966b975e11d2"): {
 					ID:             uuid.MustParse("0438312a-cd6c-44b2-9c98-966b975e11d2"),
@@ -393,6 +615,8 @@ func TestBetStorage_UpdateStateByTableID(t *testing.T) {
 					Table:          uuid.MustParse("1a31c7a1-6577-44c6-b3be-829674bf5175"),
 					SelectedSpaces: []int{12, 14, 16},
 					Status:         "settled",
+					SettledAt:      &stampedAt,
+					Version:        1,
 				},
 				uuid.MustParse("31b128ac-37de-4b24-99ca-1f3646798f41"): {
 					ID:    uuid.MustParse("31b128ac-37de-4b24-99ca-1f3646798f41"),
@@ -405,7 +629,10 @@ func TestBetStorage_UpdateStateByTableID(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			store := BetStorage{
+				events:  NewEventStore(clock.NewFake(stampedAt)),
 				bets:    test.givenBets,
+				byTable: indexByTable(test.givenBets),
+				clock:   clock.NewFake(stampedAt),
 				RWMutex: sync.RWMutex{},
 			}
 
@@ -414,9 +641,316 @@ func TestBetStorage_UpdateStateByTableID(t *testing.T) {
 				t.Fatal(err)
 			}
 
-			if !cmp.Equal(store.bets, test.expectedBets, opts.MoneyComparer, cmpopts.IgnoreFields(storage.Bet{}, "SettledAt")) {
-				t.Fatal(cmp.Diff(store.bets, test.expectedBets, opts.MoneyComparer, cmpopts.IgnoreFields(storage.Bet{}, "SettledAt")))
+			if !cmp.Equal(store.bets, test.expectedBets, opts.MoneyComparer) {
+				t.Fatal(cmp.Diff(store.bets, test.expectedBets, opts.MoneyComparer))
 			}
 		})
 	}
 }
+
+func TestBetStorage_CarryImprisoned_Success(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenBets       map[uuid.UUID]storage.Bet
+		expectedBets    map[uuid.UUID]storage.Bet
+		expectedByTable map[uuid.UUID][]uuid.UUID
+	}{
+		{
+			name: "given an imprisoned and a settled bet, expect only the imprisoned bet to be carried",
+			givenBets: map[uuid.UUID]storage.Bet{
+				uuid.MustParse("22ee17b5-fae7-4c13-80cc-4354820df3d4"): {
+					ID:      uuid.MustParse("22ee17b5-fae7-4c13-80cc-4354820df3d4"),
+					Status:  "imprisoned",
+					Stake:   money.New(100, "GBP"),
+					Table:   uuid.MustParse("1a31c7a1-6577-44c6-b3be-829674bf5175"),
+					Version: 2,
+				},
+				uuid.MustParse("0438312a-cd6c-44b2-9c98-966b975e11d2"): {
+					ID:      uuid.MustParse("0438312a-cd6c-44b2-9c98-966b975e11d2"),
+					Status:  "settled",
+					Stake:   money.New(100, "GBP"),
+					Table:   uuid.MustParse("1a31c7a1-6577-44c6-b3be-829674bf5175"),
+					Version: 2,
+				},
+			},
+			expectedBets: map[uuid.UUID]storage.Bet{
+				uuid.MustParse("22ee17b5-fae7-4c13-80cc-4354820df3d4"): {
+					ID:      uuid.MustParse("22ee17b5-fae7-4c13-80cc-4354820df3d4"),
+					Status:  "imprisoned",
+					Stake:   money.New(100, "GBP"),
+					Table:   uuid.MustParse("78e7a130-761e-4188-a204-715e3ab747a3"),
+					Version: 3,
+				},
+				uuid.MustParse("0438312a-cd6c-44b2-9c98-966b975e11d2"): {
+					ID:      uuid.MustParse("0438312a-cd6c-44b2-9c98-966b975e11d2"),
+					Status:  "settled",
+					Stake:   money.New(100, "GBP"),
+					Table:   uuid.MustParse("1a31c7a1-6577-44c6-b3be-829674bf5175"),
+					Version: 2,
+				},
+			},
+			expectedByTable: map[uuid.UUID][]uuid.UUID{
+				uuid.MustParse("1a31c7a1-6577-44c6-b3be-829674bf5175"): {uuid.MustParse("0438312a-cd6c-44b2-9c98-966b975e11d2")},
+				uuid.MustParse("78e7a130-761e-4188-a204-715e3ab747a3"): {uuid.MustParse("22ee17b5-fae7-4c13-80cc-4354820df3d4")},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			store := BetStorage{
+				events:  NewEventStore(clock.NewFake(stampedAt)),
+				bets:    test.givenBets,
+				byTable: indexByTable(test.givenBets),
+				tables: &TableStorage{tables: map[uuid.UUID]storage.Table{
+					uuid.MustParse("78e7a130-761e-4188-a204-715e3ab747a3"): {ID: uuid.MustParse("78e7a130-761e-4188-a204-715e3ab747a3")},
+				}},
+				RWMutex: sync.RWMutex{},
+			}
+
+			err := store.CarryImprisoned(
+				context.Background(),
+				uuid.MustParse("1a31c7a1-6577-44c6-b3be-829674bf5175"),
+				uuid.MustParse("78e7a130-761e-4188-a204-715e3ab747a3"),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(store.bets, test.expectedBets, opts.MoneyComparer) {
+				t.Fatal(cmp.Diff(store.bets, test.expectedBets, opts.MoneyComparer))
+			}
+
+			if !cmp.Equal(store.byTable, test.expectedByTable) {
+				t.Fatal(cmp.Diff(store.byTable, test.expectedByTable))
+			}
+		})
+	}
+}
+
+func TestBetStorage_CarryImprisoned_Fail(t *testing.T) {
+	tests := []struct {
+		name          string
+		givenTables   map[uuid.UUID]storage.Table
+		expectedError error
+	}{
+		{
+			name: "given the table carried to is closed, expect storage.ErrTableClosed",
+			givenTables: map[uuid.UUID]storage.Table{
+				uuid.MustParse("78e7a130-761e-4188-a204-715e3ab747a3"): {
+					ID:       uuid.MustParse("78e7a130-761e-4188-a204-715e3ab747a3"),
+					IsClosed: true,
+				},
+			},
+			expectedError: storage.ErrTableClosed,
+		},
+		{
+			name:          "given the table carried to does not exist, expect ErrNoTables",
+			givenTables:   map[uuid.UUID]storage.Table{},
+			expectedError: ErrNoTables,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bets := map[uuid.UUID]storage.Bet{
+				uuid.MustParse("22ee17b5-fae7-4c13-80cc-4354820df3d4"): {
+					ID:     uuid.MustParse("22ee17b5-fae7-4c13-80cc-4354820df3d4"),
+					Status: "imprisoned",
+					Table:  uuid.MustParse("1a31c7a1-6577-44c6-b3be-829674bf5175"),
+				},
+			}
+
+			store := BetStorage{
+				bets:    bets,
+				byTable: indexByTable(bets),
+				tables:  &TableStorage{tables: test.givenTables},
+				RWMutex: sync.RWMutex{},
+			}
+
+			err := store.CarryImprisoned(
+				context.Background(),
+				uuid.MustParse("1a31c7a1-6577-44c6-b3be-829674bf5175"),
+				uuid.MustParse("78e7a130-761e-4188-a204-715e3ab747a3"),
+			)
+
+			if !cmp.Equal(err, test.expectedError, cmpopts.EquateErrors()) {
+				t.Fatal(cmp.Diff(err, test.expectedError, cmpopts.EquateErrors()))
+			}
+
+			bet := store.bets[uuid.MustParse("22ee17b5-fae7-4c13-80cc-4354820df3d4")]
+			if bet.Table != uuid.MustParse("1a31c7a1-6577-44c6-b3be-829674bf5175") {
+				t.Fatalf("expected the bet to stay on its table, got %v", bet.Table)
+			}
+		})
+	}
+}
+
+func TestBetStorage_ListByTables(t *testing.T) {
+	tests := []struct {
+		name          string
+		givenBets     map[uuid.UUID]storage.Bet
+		givenTableIDs []uuid.UUID
+		expectedBets  map[uuid.UUID][]storage.Bet
+	}{
+		{
+			name: "given table IDs, expect associated bets returned keyed by table",
+			givenBets: map[uuid.UUID]storage.Bet{
+				uuid.MustParse("22ee17b5-fae7-4c13-80cc-4354820df3d4"): {
+					ID:    uuid.MustParse("22ee17b5-fae7-4c13-80cc-4354820df3d4"),
+					Stake: money.New(100, "GBP"),
+					Table: uuid.MustParse("1a31c7a1-6577-44c6-b3be-829674bf5175"),
+				},
+				uuid.MustParse("31b128ac-37de-4b24-99ca-1f3646798f41"): {
+					ID:    uuid.MustParse("31b128ac-37de-4b24-99ca-1f3646798f41"),
+					Stake: money.New(200, "GBP"),
+					Table: uuid.MustParse("78e7a130-761e-4188-a204-715e3ab747a3"),
+				},
+				uuid.MustParse("0438312a-cd6c-44b2-9c98-966b975e11d2"): {
+					ID:    uuid.MustParse("0438312a-cd6c-44b2-9c98-966b975e11d2"),
+					Stake: money.New(300, "GBP"),
+					Table: uuid.MustParse("9fa1f9c5-8f4e-4a27-a8a5-6f4a3c1f2b10"),
+				},
+			},
+			givenTableIDs: []uuid.UUID{
+				uuid.MustParse("1a31c7a1-6577-44c6-b3be-829674bf5175"),
+				uuid.MustParse("78e7a130-761e-4188-a204-715e3ab747a3"),
+				uuid.MustParse("c4b39dc0-2ff4-4405-b3cb-c4f87a9c82fb"),
+			},
+			expectedBets: map[uuid.UUID][]storage.Bet{
+				uuid.MustParse("1a31c7a1-6577-44c6-b3be-829674bf5175"): {
+					{
+						ID:    uuid.MustParse("22ee17b5-fae7-4c13-80cc-4354820df3d4"),
+						Stake: money.New(100, "GBP"),
+						Table: uuid.MustParse("1a31c7a1-6577-44c6-b3be-829674bf5175"),
+					},
+				},
+				uuid.MustParse("78e7a130-761e-4188-a204-715e3ab747a3"): {
+					{
+						ID:    uuid.MustParse("31b128ac-37de-4b24-99ca-1f3646798f41"),
+						Stake: money.New(200, "GBP"),
+						Table: uuid.MustParse("78e7a130-761e-4188-a204-715e3ab747a3"),
+					},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			store := BetStorage{
+				bets:    test.givenBets,
+				byTable: indexByTable(test.givenBets),
+				RWMutex: sync.RWMutex{},
+			}
+
+			actual, err := store.ListByTables(context.Background(), test.givenTableIDs)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(actual, test.expectedBets, opts.MoneyComparer) {
+				t.Fatal(cmp.Diff(actual, test.expectedBets, opts.MoneyComparer))
+			}
+		})
+	}
+}
+
+func BenchmarkBetStorage_List(b *testing.B) {
+	for _, size := range []struct{ tables, betsPerTable int }{{100, 20}, {1000, 20}} {
+		store, ids := newBenchmarkBetStorage(b, size.tables, size.betsPerTable)
+
+		b.Run(fmt.Sprintf("scan/tables=%v/bets=%v", size.tables, size.tables*size.betsPerTable), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for j := range ids {
+					_ = scanByTable(store, ids[j])
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("index/tables=%v/bets=%v", size.tables, size.tables*size.betsPerTable), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for j := range ids {
+					_, err := store.List(context.Background(), ids[j])
+					if err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkBetStorage_ListByTables(b *testing.B) {
+	for _, size := range []struct{ tables, betsPerTable int }{{100, 20}, {1000, 20}} {
+		store, ids := newBenchmarkBetStorage(b, size.tables, size.betsPerTable)
+
+		b.Run(fmt.Sprintf("tables=%v/bets=%v", size.tables, size.tables*size.betsPerTable), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, err := store.ListByTables(context.Background(), ids)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// scanByTable lists the Bets of a Table by scanning every Bet, as List did before the table index, the baseline the
+// index is benchmarked against.
+func scanByTable(store *BetStorage, id uuid.UUID) []storage.Bet {
+	store.RLock()
+	defer store.RUnlock()
+
+	var bets []storage.Bet
+
+	for i := range store.bets {
+		if store.bets[i].Table != id {
+			continue
+		}
+
+		bets = append(bets, store.bets[i])
+	}
+
+	return bets
+}
+
+// indexByTable builds the table index for Bets given directly to a BetStorage.
+func indexByTable(bets map[uuid.UUID]storage.Bet) map[uuid.UUID][]uuid.UUID {
+	index := make(map[uuid.UUID][]uuid.UUID)
+
+	for id := range bets {
+		index[bets[id].Table] = append(index[bets[id].Table], id)
+	}
+
+	return index
+}
+
+func newBenchmarkBetStorage(b *testing.B, tables, betsPerTable int) (*BetStorage, []uuid.UUID) {
+	b.Helper()
+
+	events := NewEventStore(clock.NewFake(stampedAt))
+	tableStore := NewTableStorage(events)
+	store := NewBetStorage(tableStore, events, clock.NewFake(stampedAt))
+	ids := make([]uuid.UUID, tables)
+
+	for i := range ids {
+		ids[i] = uuid.New()
+
+		err := tableStore.Insert(context.Background(), storage.Table{ID: ids[i]})
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		for j := 0; j < betsPerTable; j++ {
+			err := store.Insert(context.Background(), storage.Bet{
+				ID:             uuid.New(),
+				Stake:          money.New(100, "GBP"),
+				SelectedSpaces: []int{j % 37},
+				Table:          ids[i],
+			})
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+
+	return store, ids
+}
@@ -0,0 +1,108 @@
+package memory
+
+import (
+	"betting/internal/pkg/clock"
+	"betting/internal/pkg/tracing"
+	"betting/storage"
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// ErrNoEvents is returned when no Events have been recorded on a stream.
+var ErrNoEvents = errors.New("could not locate events")
+
+// EventStore holds every Event in the order it was appended, indexed by stream. Events appended within a unit of work
+// are removed again should the unit fail, units of work being run one at a time no other Event can have followed them.
+type EventStore struct {
+	events  []storage.Event
+	streams map[uuid.UUID][]int
+	clock   clock.Clock
+	sync.RWMutex
+}
+
+// NewEventStore instantiates EventStore, stamping the time each Event is recorded from the given Clock.
+func NewEventStore(clock clock.Clock) *EventStore {
+	return &EventStore{
+		streams: make(map[uuid.UUID][]int),
+		clock:   clock,
+	}
+}
+
+// Append records the given Events in order, each numbered after the last Event of its stream.
+func (e *EventStore) Append(ctx context.Context, events ...storage.Event) error {
+	_, span := tracing.Start(ctx, "memory.EventStore.Append", tracing.KeyEventCount.Int(len(events)))
+	defer span.End()
+
+	e.Lock()
+	defer e.Unlock()
+
+	length := len(e.events)
+	recordedAt := e.clock.Now()
+
+	for i := range events {
+		event := events[i]
+
+		event.Sequence = int64(len(e.streams[event.Stream]) + 1)
+		event.RecordedAt = recordedAt
+
+		e.streams[event.Stream] = append(e.streams[event.Stream], len(e.events))
+		e.events = append(e.events, event)
+	}
+
+	record(ctx, e, func() {
+		for i := len(e.events) - 1; i >= length; i-- {
+			stream := e.events[i].Stream
+
+			e.streams[stream] = e.streams[stream][:len(e.streams[stream])-1]
+			if len(e.streams[stream]) == 0 {
+				delete(e.streams, stream)
+			}
+		}
+
+		e.events = e.events[:length]
+	})
+
+	return nil
+}
+
+// List returns the Events of the given stream in Sequence.
+func (e *EventStore) List(ctx context.Context, stream uuid.UUID) ([]storage.Event, error) {
+	_, span := tracing.Start(ctx, "memory.EventStore.List", tracing.KeyTableID.String(stream.String()))
+	defer span.End()
+
+	e.RLock()
+	defer e.RUnlock()
+
+	indices := e.streams[stream]
+	if len(indices) == 0 {
+		return nil, tracing.Fail(span, ErrNoEvents)
+	}
+
+	events := make([]storage.Event, len(indices))
+
+	for i := range indices {
+		events[i] = e.events[indices[i]]
+	}
+
+	return events, nil
+}
+
+// All returns every Event of every stream in the order they were appended.
+func (e *EventStore) All(ctx context.Context) ([]storage.Event, error) {
+	_, span := tracing.Start(ctx, "memory.EventStore.All")
+	defer span.End()
+
+	e.RLock()
+	defer e.RUnlock()
+
+	events := make([]storage.Event, len(e.events))
+
+	copy(events, e.events)
+
+	span.SetAttributes(tracing.KeyEventCount.Int(len(events)))
+
+	return events, nil
+}
@@ -0,0 +1,228 @@
+package memory
+
+import (
+	"betting/internal/domain"
+	"betting/internal/pkg/clock"
+	"betting/storage"
+	"betting/testing/opts"
+	"context"
+	"testing"
+
+	"github.com/Rhymond/go-money"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+)
+
+var (
+	eventTable = uuid.MustParse("3f2c1b0a-9d8e-4f7a-8b6c-5d4e3f2a1b0c")
+	eventNext  = uuid.MustParse("7a6b5c4d-3e2f-4a1b-9c8d-7e6f5a4b3c2d")
+	eventBet   = uuid.MustParse("c1d2e3f4-a5b6-4c7d-8e9f-0a1b2c3d4e5f")
+	eventLost  = uuid.MustParse("0f1e2d3c-4b5a-4968-8776-655443322110")
+)
+
+func TestEventStore_Append_Success(t *testing.T) {
+	store := NewEventStore(clock.NewFake(stampedAt))
+
+	err := store.Append(context.Background(),
+		storage.Event{Stream: eventTable, Type: domain.TableOpened.String(), Data: []byte(`{}`)},
+		storage.Event{Stream: eventNext, Type: domain.TableOpened.String(), Data: []byte(`{}`)},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = store.Append(context.Background(), storage.Event{Stream: eventTable, Type: domain.TableClosed.String(), Data: []byte(`{}`)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual, err := store.List(context.Background(), eventTable)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []storage.Event{
+		{Stream: eventTable, Sequence: 1, Type: domain.TableOpened.String(), RecordedAt: stampedAt, Data: []byte(`{}`)},
+		{Stream: eventTable, Sequence: 2, Type: domain.TableClosed.String(), RecordedAt: stampedAt, Data: []byte(`{}`)},
+	}
+
+	if !cmp.Equal(actual, expected) {
+		t.Fatal(cmp.Diff(actual, expected))
+	}
+
+	all, err := store.All(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	streams := make([]uuid.UUID, len(all))
+
+	for i := range all {
+		streams[i] = all[i].Stream
+	}
+
+	if !cmp.Equal(streams, []uuid.UUID{eventTable, eventNext, eventTable}) {
+		t.Fatalf("expected every event in the order appended, got %v", streams)
+	}
+}
+
+func TestEventStore_Append_Rollback(t *testing.T) {
+	store := NewEventStore(clock.NewFake(stampedAt))
+
+	err := store.Append(context.Background(), storage.Event{Stream: eventTable, Type: domain.TableOpened.String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = NewUnitOfWork().Transact(context.Background(), func(ctx context.Context) error {
+		err := store.Append(ctx,
+			storage.Event{Stream: eventTable, Type: domain.TableClosed.String()},
+			storage.Event{Stream: eventNext, Type: domain.TableOpened.String()},
+		)
+		if err != nil {
+			return err
+		}
+
+		return errTransaction
+	})
+	if !cmp.Equal(err, errTransaction, cmpopts.EquateErrors()) {
+		t.Fatal(cmp.Diff(err, errTransaction, cmpopts.EquateErrors()))
+	}
+
+	events, err := store.List(context.Background(), eventTable)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(events) != 1 {
+		t.Fatalf("expected the events of the failed unit of work to be removed, got %v", len(events))
+	}
+
+	_, err = store.List(context.Background(), eventNext)
+	if !cmp.Equal(err, ErrNoEvents, cmpopts.EquateErrors()) {
+		t.Fatal(cmp.Diff(err, ErrNoEvents, cmpopts.EquateErrors()))
+	}
+
+	err = store.Append(context.Background(), storage.Event{Stream: eventTable, Type: domain.TableClosed.String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	events, err = store.List(context.Background(), eventTable)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if events[1].Sequence != 2 {
+		t.Fatalf("expected the next event to follow the last kept, got sequence %v", events[1].Sequence)
+	}
+}
+
+func TestEventStore_List_Fail(t *testing.T) {
+	_, err := NewEventStore(clock.NewFake(stampedAt)).List(context.Background(), eventTable)
+	if !cmp.Equal(err, ErrNoEvents, cmpopts.EquateErrors()) {
+		t.Fatal(cmp.Diff(err, ErrNoEvents, cmpopts.EquateErrors()))
+	}
+}
+
+func TestRebuild(t *testing.T) {
+	ctx := context.Background()
+	events := NewEventStore(clock.NewFake(stampedAt))
+	tables := NewTableStorage(events)
+	bets := NewBetStorage(tables, events, clock.NewFake(stampedAt))
+
+	steps := []func() error{
+		func() error {
+			return tables.Insert(ctx, storage.Table{ID: eventTable, ZeroRule: "en_prison", CreatedAt: stampedAt})
+		},
+		func() error {
+			return bets.InsertSlip(ctx, []storage.Bet{
+				{ID: eventBet, SelectedSpaces: []int{1}, Stake: money.New(100, "GBP"), Table: eventTable, PlacedAt: stampedAt},
+				{ID: eventLost, SelectedSpaces: []int{2}, Stake: money.New(50, "GBP"), Table: eventTable, PlacedAt: stampedAt},
+			})
+		},
+		func() error { return tables.Close(ctx, eventTable, 1, stampedAt, "croupier") },
+		func() error { return bets.UpdateStateByTableID(ctx, eventTable, domain.Live) },
+		func() error {
+			return tables.SetOutcomes(ctx, eventTable, 2, []storage.Outcome{{Colour: "green", Value: 0}}, nil, stampedAt, "croupier")
+		},
+		func() error { return tables.Settle(ctx, eventTable, 3, stampedAt, "croupier") },
+		func() error { return bets.UpdateStateByTableID(ctx, eventTable, domain.Settled) },
+		func() error {
+			settled, err := bets.List(ctx, eventTable)
+			if err != nil {
+				return err
+			}
+
+			settled[0].Status = domain.Imprisoned.String()
+			settled[0].ImprisonedOn = eventTable
+
+			return bets.SetWinners(ctx, settled)
+		},
+		func() error {
+			return tables.Insert(ctx, storage.Table{ID: eventNext, Previous: eventTable, CreatedAt: stampedAt})
+		},
+		func() error { return bets.CarryImprisoned(ctx, eventTable, eventNext) },
+	}
+
+	for i := range steps {
+		err := steps[i]()
+		if err != nil {
+			t.Fatalf("step %v: %v", i, err)
+		}
+	}
+
+	stream, err := events.List(ctx, eventTable)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	kinds := make([]string, len(stream))
+
+	for i := range stream {
+		kinds[i] = stream[i].Type
+	}
+
+	expectedKinds := []string{"TableOpened", "BetPlaced", "BetPlaced", "TableClosed", "BetsMarked", "OutcomeDrawn",
+		"TableSettled", "BetsMarked", "BetsSettled"}
+
+	if !cmp.Equal(kinds, expectedKinds) {
+		t.Fatal(cmp.Diff(kinds, expectedKinds))
+	}
+
+	rebuiltTables := NewTableStorage(events)
+	rebuiltBets := NewBetStorage(rebuiltTables, events, clock.NewFake(stampedAt))
+
+	err = rebuiltTables.Rebuild(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = rebuiltBets.Rebuild(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !cmp.Equal(rebuiltTables.tables, tables.tables) {
+		t.Fatal(cmp.Diff(rebuiltTables.tables, tables.tables))
+	}
+
+	if !cmp.Equal(rebuiltTables.byCreation, tables.byCreation) {
+		t.Fatal(cmp.Diff(rebuiltTables.byCreation, tables.byCreation))
+	}
+
+	if !cmp.Equal(rebuiltBets.bets, bets.bets, opts.MoneyComparer) {
+		t.Fatal(cmp.Diff(rebuiltBets.bets, bets.bets, opts.MoneyComparer))
+	}
+
+	if !cmp.Equal(rebuiltBets.byTable, bets.byTable, cmpopts.EquateEmpty()) {
+		t.Fatal(cmp.Diff(rebuiltBets.byTable, bets.byTable, cmpopts.EquateEmpty()))
+	}
+
+	carried := rebuiltBets.bets[eventBet]
+
+	if carried.Table != eventNext || carried.Version != 5 {
+		t.Fatalf("expected the imprisoned bet to be carried onto the next table at version 5, got %+v", carried)
+	}
+}
@@ -0,0 +1,116 @@
+package memory
+
+import (
+	"betting/internal/pkg/tracing"
+	"betting/storage"
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/Rhymond/go-money"
+)
+
+// ErrPoolExhausted is returned when the Jackpot is asked to pay out more of a currency than its Pool holds.
+var ErrPoolExhausted = errors.New("jackpot pool holds less than the awards")
+
+// JackpotStorage holds the Pool of the Jackpot, in minor units keyed by currency code, and the Awards paid from it.
+type JackpotStorage struct {
+	pool   map[string]int64
+	awards []storage.JackpotAward
+	sync.RWMutex
+}
+
+// NewJackpotStorage instantiates JackpotStorage with an empty Pool.
+func NewJackpotStorage() *JackpotStorage {
+	return &JackpotStorage{
+		pool: make(map[string]int64),
+	}
+}
+
+// Get returns the Jackpot with its Pool in order of currency code.
+func (j *JackpotStorage) Get(ctx context.Context) (storage.Jackpot, error) {
+	_, span := tracing.Start(ctx, "memory.JackpotStorage.Get")
+	defer span.End()
+
+	j.RLock()
+	defer j.RUnlock()
+
+	pool := make([]*money.Money, 0, len(j.pool))
+
+	for code, amount := range j.pool {
+		pool = append(pool, money.New(amount, code))
+	}
+
+	sort.Slice(pool, func(a, b int) bool {
+		return pool[a].Currency().Code < pool[b].Currency().Code
+	})
+
+	var awards []storage.JackpotAward
+	if len(j.awards) > 0 {
+		awards = append(awards, j.awards...)
+	}
+
+	return storage.Jackpot{
+		Pool:   pool,
+		Awards: awards,
+	}, nil
+}
+
+// Contribute adds the given amount to the Pool of its currency.
+func (j *JackpotStorage) Contribute(ctx context.Context, amount *money.Money) error {
+	_, span := tracing.Start(ctx, "memory.JackpotStorage.Contribute")
+	defer span.End()
+
+	j.Lock()
+	defer j.Unlock()
+
+	code := amount.Currency().Code
+
+	j.pool[code] += amount.Amount()
+
+	record(ctx, j, func() {
+		j.pool[code] -= amount.Amount()
+	})
+
+	return nil
+}
+
+// Award pays the given Awards out of the Pool, either all of them are paid or, should a currency not hold enough, none
+// are and ErrPoolExhausted is returned.
+func (j *JackpotStorage) Award(ctx context.Context, awards []storage.JackpotAward) error {
+	_, span := tracing.Start(ctx, "memory.JackpotStorage.Award", tracing.KeyBetCount.Int(len(awards)))
+	defer span.End()
+
+	j.Lock()
+	defer j.Unlock()
+
+	owed := make(map[string]int64)
+
+	for i := range awards {
+		owed[awards[i].Amount.Currency().Code] += awards[i].Amount.Amount()
+	}
+
+	for code, amount := range owed {
+		if j.pool[code] < amount {
+			return tracing.Fail(span, ErrPoolExhausted)
+		}
+	}
+
+	for code, amount := range owed {
+		j.pool[code] -= amount
+	}
+
+	paid := len(j.awards)
+	j.awards = append(j.awards, awards...)
+
+	record(ctx, j, func() {
+		for code, amount := range owed {
+			j.pool[code] += amount
+		}
+
+		j.awards = j.awards[:paid]
+	})
+
+	return nil
+}
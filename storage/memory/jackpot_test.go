@@ -0,0 +1,168 @@
+package memory
+
+import (
+	"betting/storage"
+	"betting/testing/opts"
+	"context"
+	"testing"
+
+	"github.com/Rhymond/go-money"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+)
+
+func TestJackpotStorage_Award_Success(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenPool       []*money.Money
+		givenAwards     []storage.JackpotAward
+		expectedJackpot storage.Jackpot
+	}{
+		{
+			name:      "given no awards, expect the pool in order of currency",
+			givenPool: []*money.Money{money.New(500, "GBP"), money.New(100, "EUR"), money.New(250, "GBP")},
+			expectedJackpot: storage.Jackpot{
+				Pool: []*money.Money{money.New(100, "EUR"), money.New(750, "GBP")},
+			},
+		},
+		{
+			name:      "given awards, expect them to be paid out of the pool of their currency",
+			givenPool: []*money.Money{money.New(1000, "GBP"), money.New(100, "EUR")},
+			givenAwards: []storage.JackpotAward{
+				{
+					Bet:    uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+					Table:  uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+					Amount: money.New(999, "GBP"),
+				},
+			},
+			expectedJackpot: storage.Jackpot{
+				Pool: []*money.Money{money.New(100, "EUR"), money.New(1, "GBP")},
+				Awards: []storage.JackpotAward{
+					{
+						Bet:    uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"),
+						Table:  uuid.MustParse("160998da-2d89-4f06-a690-fd189213958d"),
+						Amount: money.New(999, "GBP"),
+					},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			jackpot := NewJackpotStorage()
+
+			for _, amount := range test.givenPool {
+				err := jackpot.Contribute(context.Background(), amount)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if test.givenAwards != nil {
+				err := jackpot.Award(context.Background(), test.givenAwards)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			actual, err := jackpot.Get(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(actual, test.expectedJackpot, opts.MoneyComparer) {
+				t.Fatal(cmp.Diff(actual, test.expectedJackpot, opts.MoneyComparer))
+			}
+		})
+	}
+}
+
+func TestJackpotStorage_Award_Fail(t *testing.T) {
+	tests := []struct {
+		name          string
+		givenAwards   []storage.JackpotAward
+		expectedError error
+	}{
+		{
+			name: "given awards exceeding the pool, expect ErrPoolExhausted and nothing paid",
+			givenAwards: []storage.JackpotAward{
+				{Bet: uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"), Amount: money.New(600, "GBP")},
+				{Bet: uuid.MustParse("49cffe67-9798-4327-9760-c4b81562f928"), Amount: money.New(600, "GBP")},
+			},
+			expectedError: ErrPoolExhausted,
+		},
+		{
+			name: "given an award in a currency the pool does not hold, expect ErrPoolExhausted",
+			givenAwards: []storage.JackpotAward{
+				{Bet: uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"), Amount: money.New(1, "EUR")},
+			},
+			expectedError: ErrPoolExhausted,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			jackpot := NewJackpotStorage()
+
+			err := jackpot.Contribute(context.Background(), money.New(1000, "GBP"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = jackpot.Award(context.Background(), test.givenAwards)
+			if !cmp.Equal(err, test.expectedError, cmpopts.EquateErrors()) {
+				t.Fatal(cmp.Diff(err, test.expectedError, cmpopts.EquateErrors()))
+			}
+
+			actual, err := jackpot.Get(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expected := storage.Jackpot{Pool: []*money.Money{money.New(1000, "GBP")}}
+
+			if !cmp.Equal(actual, expected, opts.MoneyComparer) {
+				t.Fatal(cmp.Diff(actual, expected, opts.MoneyComparer))
+			}
+		})
+	}
+}
+
+func TestJackpotStorage_Transact_Rollback(t *testing.T) {
+	jackpot := NewJackpotStorage()
+
+	err := jackpot.Contribute(context.Background(), money.New(1000, "GBP"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = NewUnitOfWork().Transact(context.Background(), func(ctx context.Context) error {
+		err := jackpot.Contribute(ctx, money.New(10, "GBP"))
+		if err != nil {
+			return err
+		}
+
+		err = jackpot.Award(ctx, []storage.JackpotAward{
+			{Bet: uuid.MustParse("e49779f6-3507-4063-bed8-18d50174868d"), Amount: money.New(1010, "GBP")},
+		})
+		if err != nil {
+			return err
+		}
+
+		return errTransaction
+	})
+	if !cmp.Equal(err, errTransaction, cmpopts.EquateErrors()) {
+		t.Fatal(cmp.Diff(err, errTransaction, cmpopts.EquateErrors()))
+	}
+
+	actual, err := jackpot.Get(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := storage.Jackpot{Pool: []*money.Money{money.New(1000, "GBP")}}
+
+	if !cmp.Equal(actual, expected, opts.MoneyComparer) {
+		t.Fatal(cmp.Diff(actual, expected, opts.MoneyComparer))
+	}
+}
@@ -0,0 +1,64 @@
+package memory
+
+import (
+	"betting/internal/pkg/tracing"
+	"betting/storage"
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// StatisticsStorage holds the Statistics of each series of Tables, and of every Table under uuid.Nil.
+type StatisticsStorage struct {
+	statistics map[uuid.UUID]storage.Statistics
+	sync.RWMutex
+}
+
+// NewStatisticsStorage instantiates StatisticsStorage.
+func NewStatisticsStorage() *StatisticsStorage {
+	return &StatisticsStorage{
+		statistics: make(map[uuid.UUID]storage.Statistics),
+	}
+}
+
+// Get returns the Statistics of the given series, empty when nothing has been recorded for it.
+func (s *StatisticsStorage) Get(ctx context.Context, series uuid.UUID) (storage.Statistics, error) {
+	_, span := tracing.Start(ctx, "memory.StatisticsStorage.Get")
+	defer span.End()
+
+	s.RLock()
+	defer s.RUnlock()
+
+	statistics, ok := s.statistics[series]
+	if !ok {
+		return storage.Statistics{Series: series}, nil
+	}
+
+	return statistics, nil
+}
+
+// Set replaces the Statistics of their series.
+func (s *StatisticsStorage) Set(ctx context.Context, statistics storage.Statistics) error {
+	_, span := tracing.Start(ctx, "memory.StatisticsStorage.Set")
+	defer span.End()
+
+	s.Lock()
+	defer s.Unlock()
+
+	series := statistics.Series
+	previous, ok := s.statistics[series]
+
+	s.statistics[series] = statistics
+
+	record(ctx, s, func() {
+		if !ok {
+			delete(s.statistics, series)
+			return
+		}
+
+		s.statistics[series] = previous
+	})
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package memory
+
+import (
+	"betting/storage"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+)
+
+var statisticsSeries = uuid.MustParse("3a1f6f53-8d1e-4be2-9b4e-4a9a3c2ad8f1")
+
+func TestStatisticsStorage_Get_Success(t *testing.T) {
+	tests := []struct {
+		name               string
+		givenStatistics    []storage.Statistics
+		givenSeries        uuid.UUID
+		expectedStatistics storage.Statistics
+	}{
+		{
+			name:               "given nothing recorded, expect empty statistics for the series",
+			givenSeries:        statisticsSeries,
+			expectedStatistics: storage.Statistics{Series: statisticsSeries},
+		},
+		{
+			name: "given statistics set twice, expect the latest",
+			givenStatistics: []storage.Statistics{
+				{Series: statisticsSeries, Spins: 1, Recent: []storage.Result{{Value: 4, Colour: "black"}}},
+				{Series: statisticsSeries, Spins: 2, Recent: []storage.Result{{Value: 9, Colour: "red"}, {Value: 4, Colour: "black"}}},
+				{Series: uuid.Nil, Spins: 7},
+			},
+			givenSeries: statisticsSeries,
+			expectedStatistics: storage.Statistics{
+				Series: statisticsSeries,
+				Spins:  2,
+				Recent: []storage.Result{{Value: 9, Colour: "red"}, {Value: 4, Colour: "black"}},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			statistics := NewStatisticsStorage()
+
+			for i := range test.givenStatistics {
+				err := statistics.Set(context.Background(), test.givenStatistics[i])
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			actual, err := statistics.Get(context.Background(), test.givenSeries)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(actual, test.expectedStatistics) {
+				t.Fatal(cmp.Diff(actual, test.expectedStatistics))
+			}
+		})
+	}
+}
+
+func TestStatisticsStorage_Transact_Rollback(t *testing.T) {
+	statistics := NewStatisticsStorage()
+
+	err := statistics.Set(context.Background(), storage.Statistics{Series: statisticsSeries, Spins: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = NewUnitOfWork().Transact(context.Background(), func(ctx context.Context) error {
+		err := statistics.Set(ctx, storage.Statistics{Series: statisticsSeries, Spins: 2})
+		if err != nil {
+			return err
+		}
+
+		err = statistics.Set(ctx, storage.Statistics{Series: uuid.Nil, Spins: 2})
+		if err != nil {
+			return err
+		}
+
+		return errTransaction
+	})
+	if !cmp.Equal(err, errTransaction, cmpopts.EquateErrors()) {
+		t.Fatal(cmp.Diff(err, errTransaction, cmpopts.EquateErrors()))
+	}
+
+	for series, expected := range map[uuid.UUID]storage.Statistics{
+		statisticsSeries: {Series: statisticsSeries, Spins: 1},
+		uuid.Nil:         {Series: uuid.Nil},
+	} {
+		actual, err := statistics.Get(context.Background(), series)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !cmp.Equal(actual, expected) {
+			t.Fatal(cmp.Diff(actual, expected))
+		}
+	}
+}
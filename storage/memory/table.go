@@ -1,10 +1,15 @@
 package memory
 
 import (
+	"betting/internal/domain"
+	"betting/internal/pkg/tracing"
 	"betting/storage"
+	"bytes"
 	"context"
 	"errors"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -15,91 +20,472 @@ var (
 	ErrDuplicateTable = errors.New("duplicate key for table")
 )
 
-// TableStorage holds the record of all created Tables.
+// TableStorage holds the record of all created Tables, projected from the Events of each Table, indexed in order of
+// creation time and then ID and by the Table each continues. Every write appends an Event to the EventStore and applies
+// it, so the Tables held are always those Rebuild would project.
 type TableStorage struct {
-	tables map[uuid.UUID]storage.Table
+	tables     map[uuid.UUID]storage.Table
+	byCreation []storage.TableCursor
+	byPrevious map[uuid.UUID]uuid.UUID
+	events     storage.EventStore
 	sync.RWMutex
 }
 
-// NewTableStorage instantiates TableStorage.
-func NewTableStorage() *TableStorage {
+// NewTableStorage instantiates TableStorage, recording the Events of each Table in the given EventStore.
+func NewTableStorage(events storage.EventStore) *TableStorage {
 	return &TableStorage{
-		tables: make(map[uuid.UUID]storage.Table),
+		tables:     make(map[uuid.UUID]storage.Table),
+		byPrevious: make(map[uuid.UUID]uuid.UUID),
+		events:     events,
 	}
 }
 
-// Close sets the table to closed so no more bets can be added to it.
-func (t *TableStorage) Close(_ context.Context, id uuid.UUID) error {
+// Rebuild replaces the Tables held with those projected from every Event in the EventStore, in the order they were
+// recorded. Events of Bets are passed over.
+func (t *TableStorage) Rebuild(ctx context.Context) error {
+	ctx, span := tracing.Start(ctx, "memory.TableStorage.Rebuild")
+	defer span.End()
+
+	events, err := t.events.All(ctx)
+	if err != nil {
+		return tracing.Fail(span, err)
+	}
+
+	tables := make(map[uuid.UUID]storage.Table)
+
+	for i := range events {
+		err = applyTable(tables, events[i])
+		if err != nil {
+			return tracing.Fail(span, err)
+		}
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	t.tables = tables
+	t.byCreation = indexTables(tables)
+	t.byPrevious = make(map[uuid.UUID]uuid.UUID)
+
+	for id, table := range tables {
+		if table.Previous != uuid.Nil {
+			t.byPrevious[table.Previous] = id
+		}
+	}
+
+	span.SetAttributes(tracing.KeyTableCount.Int(len(tables)))
+
+	return nil
+}
+
+// Close sets the table to closed so no more bets can be added to it, provided the table is still at the given version
+// and open, otherwise storage.ErrTableClosed is returned. The time it was closed at and by whom are recorded.
+func (t *TableStorage) Close(ctx context.Context, id uuid.UUID, version int64, closedAt time.Time, closedBy string) error {
+	ctx, span := tracing.Start(ctx, "memory.TableStorage.Close", tracing.KeyTableID.String(id.String()))
+	defer span.End()
+
 	t.Lock()
 	defer t.Unlock()
 
 	table, ok := t.tables[id]
 	if !ok {
-		return ErrNoTables
+		return tracing.Fail(span, ErrNoTables)
 	}
 
-	table.IsClosed = true
+	if table.Version != version {
+		return tracing.Fail(span, storage.ErrVersionConflict)
+	}
+
+	if table.IsClosed {
+		return tracing.Fail(span, storage.ErrTableClosed)
+	}
+
+	err := t.append(ctx, id, domain.TableClosed, storage.TableClosed{ClosedAt: closedAt, ClosedBy: closedBy})
+	if err != nil {
+		return tracing.Fail(span, err)
+	}
 
-	t.tables[id] = table
+	record(ctx, t, func() {
+		t.tables[id] = table
+	})
+
+	return nil
+}
+
+// checkOpen returns storage.ErrTableClosed unless the Table with the given ID is open.
+func (t *TableStorage) checkOpen(id uuid.UUID) error {
+	t.RLock()
+	defer t.RUnlock()
+
+	table, ok := t.tables[id]
+	if !ok {
+		return ErrNoTables
+	}
+
+	if table.IsClosed {
+		return storage.ErrTableClosed
+	}
 
 	return nil
 }
 
 // Get returns a Table for a given ID.
-func (t *TableStorage) Get(_ context.Context, id uuid.UUID) (storage.Table, error) {
+func (t *TableStorage) Get(ctx context.Context, id uuid.UUID) (storage.Table, error) {
+	_, span := tracing.Start(ctx, "memory.TableStorage.Get", tracing.KeyTableID.String(id.String()))
+	defer span.End()
+
 	t.RLock()
 	defer t.RUnlock()
 
 	table, ok := t.tables[id]
 	if !ok {
-		return storage.Table{}, ErrNoTables
+		return storage.Table{}, tracing.Fail(span, ErrNoTables)
 	}
 
 	return table, nil
 }
 
-// Insert creates a new Table in memory.
-func (t *TableStorage) Insert(_ context.Context, table storage.Table) error {
+// Insert opens a new Table in memory at the first version. A Table continues the round of its Previous Table, which is
+// only continued once, continuing it again returns storage.ErrTableContinued.
+func (t *TableStorage) Insert(ctx context.Context, table storage.Table) error {
+	ctx, span := tracing.Start(ctx, "memory.TableStorage.Insert", tracing.KeyTableID.String(table.ID.String()))
+	defer span.End()
+
 	t.Lock()
 	defer t.Unlock()
 
 	_, ok := t.tables[table.ID]
 	if ok {
-		return ErrDuplicateTable
+		return tracing.Fail(span, ErrDuplicateTable)
+	}
+
+	if _, ok = t.byPrevious[table.Previous]; ok && table.Previous != uuid.Nil {
+		return tracing.Fail(span, storage.ErrTableContinued)
 	}
 
-	t.tables[table.ID] = table
+	err := t.append(ctx, table.ID, domain.TableOpened, table)
+	if err != nil {
+		return tracing.Fail(span, err)
+	}
+
+	key := storage.TableCursor{CreatedAt: table.CreatedAt, ID: table.ID}
+	i := searchAfter(t.byCreation, key)
+
+	t.byCreation = append(t.byCreation, storage.TableCursor{})
+	copy(t.byCreation[i+1:], t.byCreation[i:])
+	t.byCreation[i] = key
+
+	if table.Previous != uuid.Nil {
+		if t.byPrevious == nil {
+			t.byPrevious = make(map[uuid.UUID]uuid.UUID)
+		}
+
+		t.byPrevious[table.Previous] = table.ID
+	}
+
+	record(ctx, t, func() {
+		delete(t.tables, table.ID)
+		delete(t.byPrevious, table.Previous)
+
+		i := searchFrom(t.byCreation, key)
+		t.byCreation = append(t.byCreation[:i], t.byCreation[i+1:]...)
+	})
 
 	return nil
 }
 
-// List returns all Tables in memory.
-func (t *TableStorage) List(_ context.Context) ([]storage.Table, error) {
+// List returns a page of the Tables in memory matching the query, ordered by creation time and then ID. The index is
+// sought to the cursor and the range of creation times, so only the Tables of the page and those filtered out on the
+// way to it are read.
+func (t *TableStorage) List(ctx context.Context, query storage.TableQuery) (storage.TablePage, error) {
+	_, span := tracing.Start(ctx, "memory.TableStorage.List")
+	defer span.End()
+
 	t.RLock()
 	defer t.RUnlock()
 
-	var list []storage.Table
+	lower, upper := t.bounds(query)
+
+	i, step := lower, 1
+	if query.Descending {
+		i, step = upper-1, -1
+	}
+
+	list := make([]storage.Table, 0)
+
+	for ; i >= lower && i < upper; i += step {
+		table := t.tables[t.byCreation[i].ID]
+
+		if !matchesTableQuery(table, query) {
+			continue
+		}
 
-	for _, table := range t.tables {
 		list = append(list, table)
+
+		if query.Limit > 0 && len(list) > query.Limit {
+			break
+		}
+	}
+
+	var page storage.TablePage
+
+	if query.Limit > 0 && len(list) > query.Limit {
+		list = list[:query.Limit]
+
+		last := list[len(list)-1]
+
+		page.Next = &storage.TableCursor{
+			CreatedAt: last.CreatedAt,
+			ID:        last.ID,
+		}
+	}
+
+	page.Tables = list
+
+	return page, nil
+}
+
+// bounds returns the range of the index holding the Tables created within the times of the query and beyond its
+// cursor, in the order asked for. The lock must already be held.
+func (t *TableStorage) bounds(query storage.TableQuery) (int, int) {
+	lower, upper := 0, len(t.byCreation)
+
+	if query.CreatedFrom != nil {
+		lower = searchFrom(t.byCreation, storage.TableCursor{CreatedAt: *query.CreatedFrom})
+	}
+
+	if query.CreatedTo != nil {
+		upper = searchFrom(t.byCreation, storage.TableCursor{CreatedAt: *query.CreatedTo})
+	}
+
+	switch {
+	case query.After == nil:
+	case query.Descending:
+		if i := searchFrom(t.byCreation, *query.After); i < upper {
+			upper = i
+		}
+	default:
+		if i := searchAfter(t.byCreation, *query.After); i > lower {
+			lower = i
+		}
 	}
 
-	return list, nil
+	return lower, upper
 }
 
-// SetOutcome updates the table with the result.
-func (t *TableStorage) SetOutcome(_ context.Context, id uuid.UUID, outcome storage.Outcome) error {
+// SetOutcomes updates the table with the result of each ball and the multipliers struck for the spin, along with when it
+// was spun and by whom, provided the table is still at the given version. A table is only spun once, spinning it again
+// returns storage.ErrTableSpun.
+func (t *TableStorage) SetOutcomes(
+	ctx context.Context,
+	id uuid.UUID,
+	version int64,
+	outcomes []storage.Outcome,
+	multipliers []storage.Multiplier,
+	spunAt time.Time,
+	spunBy string,
+) error {
+	ctx, span := tracing.Start(ctx, "memory.TableStorage.SetOutcomes", tracing.KeyTableID.String(id.String()))
+	defer span.End()
+
 	t.Lock()
 	defer t.Unlock()
 
 	table, ok := t.tables[id]
 	if !ok {
-		return ErrNoTables
+		return tracing.Fail(span, ErrNoTables)
 	}
 
-	table.Outcome = &outcome
+	if table.Version != version {
+		return tracing.Fail(span, storage.ErrVersionConflict)
+	}
 
-	t.tables[id] = table
+	if table.SpunAt != nil {
+		return tracing.Fail(span, storage.ErrTableSpun)
+	}
+
+	err := t.append(ctx, id, domain.OutcomeDrawn, storage.OutcomeDrawn{
+		Outcomes:    outcomes,
+		Multipliers: multipliers,
+		SpunAt:      spunAt,
+		SpunBy:      spunBy,
+	})
+	if err != nil {
+		return tracing.Fail(span, err)
+	}
+
+	record(ctx, t, func() {
+		t.tables[id] = table
+	})
 
 	return nil
 }
+
+// Settle records when the table was settled and by whom, provided the table is still at the given version. The table
+// must have been spun, otherwise storage.ErrTableNotSpun is returned, and is only settled once, settling it again
+// returns storage.ErrTableSettled.
+func (t *TableStorage) Settle(ctx context.Context, id uuid.UUID, version int64, settledAt time.Time, settledBy string) error {
+	ctx, span := tracing.Start(ctx, "memory.TableStorage.Settle", tracing.KeyTableID.String(id.String()))
+	defer span.End()
+
+	t.Lock()
+	defer t.Unlock()
+
+	table, ok := t.tables[id]
+	if !ok {
+		return tracing.Fail(span, ErrNoTables)
+	}
+
+	if table.Version != version {
+		return tracing.Fail(span, storage.ErrVersionConflict)
+	}
+
+	switch {
+	case table.SpunAt == nil:
+		return tracing.Fail(span, storage.ErrTableNotSpun)
+	case table.SettledAt != nil:
+		return tracing.Fail(span, storage.ErrTableSettled)
+	}
+
+	err := t.append(ctx, id, domain.TableSettled, storage.TableSettled{SettledAt: settledAt, SettledBy: settledBy})
+	if err != nil {
+		return tracing.Fail(span, err)
+	}
+
+	record(ctx, t, func() {
+		t.tables[id] = table
+	})
+
+	return nil
+}
+
+// append records an Event of the given kind on the stream of a Table and applies it, the lock must already be held.
+func (t *TableStorage) append(ctx context.Context, id uuid.UUID, kind domain.EventType, data interface{}) error {
+	event, err := storage.NewEvent(id, kind, data)
+	if err != nil {
+		return err
+	}
+
+	err = t.events.Append(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	return applyTable(t.tables, event)
+}
+
+// applyTable projects the Event onto the Table of its stream, each Event after the Table is opened moving it on to its
+// next version.
+func applyTable(tables map[uuid.UUID]storage.Table, event storage.Event) error {
+	table := tables[event.Stream]
+
+	switch domain.EventType(event.Type) {
+	case domain.TableOpened:
+		err := event.Decode(&table)
+		if err != nil {
+			return err
+		}
+
+		table.Version = 0
+	case domain.TableClosed:
+		var closed storage.TableClosed
+
+		err := event.Decode(&closed)
+		if err != nil {
+			return err
+		}
+
+		table.IsClosed = true
+		table.ClosedAt = &closed.ClosedAt
+		table.ClosedBy = closed.ClosedBy
+	case domain.OutcomeDrawn:
+		var drawn storage.OutcomeDrawn
+
+		err := event.Decode(&drawn)
+		if err != nil {
+			return err
+		}
+
+		table.Outcomes = drawn.Outcomes
+		table.Multipliers = drawn.Multipliers
+		table.SpunAt = &drawn.SpunAt
+		table.SpunBy = drawn.SpunBy
+	case domain.TableSettled:
+		var settled storage.TableSettled
+
+		err := event.Decode(&settled)
+		if err != nil {
+			return err
+		}
+
+		table.SettledAt = &settled.SettledAt
+		table.SettledBy = settled.SettledBy
+	default:
+		return nil
+	}
+
+	table.Version++
+
+	tables[event.Stream] = table
+
+	return nil
+}
+
+// matchesTableQuery reports whether the Table passes the state and colour filters of the query, the range of creation
+// times and the cursor are left to the bounds of the index.
+func matchesTableQuery(table storage.Table, query storage.TableQuery) bool {
+	if query.IsClosed != nil && table.IsClosed != *query.IsClosed {
+		return false
+	}
+
+	return query.Colour == "" || landedOn(table.Outcomes, query.Colour)
+}
+
+// indexTables returns the key of every Table ordered by creation time and then ID.
+func indexTables(tables map[uuid.UUID]storage.Table) []storage.TableCursor {
+	index := make([]storage.TableCursor, 0, len(tables))
+
+	for id, table := range tables {
+		index = append(index, storage.TableCursor{CreatedAt: table.CreatedAt, ID: id})
+	}
+
+	sort.Slice(index, func(i, j int) bool {
+		return isBefore(index[i].CreatedAt, index[i].ID, index[j].CreatedAt, index[j].ID)
+	})
+
+	return index
+}
+
+// searchFrom returns the position of the first key of the index which does not sort before the given key.
+func searchFrom(index []storage.TableCursor, key storage.TableCursor) int {
+	return sort.Search(len(index), func(i int) bool {
+		return !isBefore(index[i].CreatedAt, index[i].ID, key.CreatedAt, key.ID)
+	})
+}
+
+// searchAfter returns the position of the first key of the index which sorts after the given key.
+func searchAfter(index []storage.TableCursor, key storage.TableCursor) int {
+	return sort.Search(len(index), func(i int) bool {
+		return isBefore(key.CreatedAt, key.ID, index[i].CreatedAt, index[i].ID)
+	})
+}
+
+// isBefore reports whether the first Table sorts before the second, ties on creation time are broken by ID.
+func isBefore(aCreatedAt time.Time, aID uuid.UUID, bCreatedAt time.Time, bID uuid.UUID) bool {
+	if !aCreatedAt.Equal(bCreatedAt) {
+		return aCreatedAt.Before(bCreatedAt)
+	}
+
+	return bytes.Compare(aID[:], bID[:]) < 0
+}
+
+// landedOn reports whether any of the Outcomes is of the given colour.
+func landedOn(outcomes []storage.Outcome, colour string) bool {
+	for i := range outcomes {
+		if outcomes[i].Colour == colour {
+			return true
+		}
+	}
+
+	return false
+}
@@ -1,36 +1,46 @@
 package memory
 
 import (
+	"betting/internal/pkg/clock"
 	"betting/storage"
 	"context"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/google/uuid"
 )
 
+var stampedAt = time.Date(2021, 10, 11, 12, 0, 0, 0, time.UTC)
+
 func TestTableStorage_Close_Success(t *testing.T) {
 	tests := []struct {
 		name           string
 		givenID        uuid.UUID
+		givenVersion   int64
 		givenTables    map[uuid.UUID]storage.Table
 		expectedTables map[uuid.UUID]storage.Table
 	}{
 		{
-			name:    "given a valid ID, expect it to close",
-			givenID: uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"),
+			name:         "given a valid ID at the given version, expect it to close, by whom, and move to the next version",
+			givenID:      uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"),
+			givenVersion: 1,
 			givenTables: map[uuid.UUID]storage.Table{
 				uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"): {
 					ID:       uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"),
 					IsClosed: false,
+					Version:  1,
 				},
 			},
 			expectedTables: map[uuid.UUID]storage.Table{
 				uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"): {
 					ID:       uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"),
 					IsClosed: true,
+					ClosedAt: &stampedAt,
+					ClosedBy: "croupier",
+					Version:  2,
 				},
 			},
 		},
@@ -38,11 +48,12 @@ func TestTableStorage_Close_Success(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			store := TableStorage{
+				events:  NewEventStore(clock.NewFake(stampedAt)),
 				tables:  test.givenTables,
 				RWMutex: sync.RWMutex{},
 			}
 
-			err := store.Close(context.Background(), test.givenID)
+			err := store.Close(context.Background(), test.givenID, test.givenVersion, stampedAt, "croupier")
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -58,12 +69,14 @@ func TestTableStorage_Close_Fail(t *testing.T) {
 	tests := []struct {
 		name          string
 		givenID       uuid.UUID
+		givenVersion  int64
 		givenTables   map[uuid.UUID]storage.Table
 		expectedError error
 	}{
 		{
-			name:    "given an invalid ID, expect it to error",
-			givenID: uuid.MustParse("99510953-65f4-4b28-a8ec-398a605e5210"),
+			name:         "given an invalid ID, expect it to error",
+			givenID:      uuid.MustParse("99510953-65f4-4b28-a8ec-398a605e5210"),
+			givenVersion: 1,
 			givenTables: map[uuid.UUID]storage.Table{
 				uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"): {
 					ID:       uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"),
@@ -72,6 +85,32 @@ func TestTableStorage_Close_Fail(t *testing.T) {
 			},
 			expectedError: ErrNoTables,
 		},
+		{
+			name:         "given a version which is no longer stored, expect a conflict",
+			givenID:      uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"),
+			givenVersion: 1,
+			givenTables: map[uuid.UUID]storage.Table{
+				uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"): {
+					ID:       uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"),
+					IsClosed: true,
+					Version:  2,
+				},
+			},
+			expectedError: storage.ErrVersionConflict,
+		},
+		{
+			name:         "given a table which is already closed, expect ErrTableClosed",
+			givenID:      uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"),
+			givenVersion: 2,
+			givenTables: map[uuid.UUID]storage.Table{
+				uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"): {
+					ID:       uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"),
+					IsClosed: true,
+					Version:  2,
+				},
+			},
+			expectedError: storage.ErrTableClosed,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -80,7 +119,7 @@ func TestTableStorage_Close_Fail(t *testing.T) {
 				RWMutex: sync.RWMutex{},
 			}
 
-			err := store.Close(context.Background(), test.givenID)
+			err := store.Close(context.Background(), test.givenID, test.givenVersion, stampedAt, "croupier")
 			if err == nil {
 				t.Fatalf("expected %v, got nil", test.expectedError)
 			}
@@ -179,11 +218,10 @@ func TestTableStorage_Insert_Success(t *testing.T) {
 		expectedTables map[uuid.UUID]storage.Table
 	}{
 		{
-			name: "given a valid ID, expect a Table to be returned",
+			name: "given a valid ID, expect a Table to be stored at the first version",
 			givenTable: storage.Table{
 				ID:       uuid.MustParse("1e722843-ff0d-4598-8a61-255120b1f3af"),
 				IsClosed: false,
-				Outcome:  nil,
 			},
 			givenTables: map[uuid.UUID]storage.Table{
 				uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"): {
@@ -199,7 +237,7 @@ func TestTableStorage_Insert_Success(t *testing.T) {
 				uuid.MustParse("1e722843-ff0d-4598-8a61-255120b1f3af"): {
 					ID:       uuid.MustParse("1e722843-ff0d-4598-8a61-255120b1f3af"),
 					IsClosed: false,
-					Outcome:  nil,
+					Version:  1,
 				},
 			},
 		},
@@ -207,6 +245,7 @@ func TestTableStorage_Insert_Success(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			store := TableStorage{
+				events:  NewEventStore(clock.NewFake(stampedAt)),
 				tables:  test.givenTables,
 				RWMutex: sync.RWMutex{},
 			}
@@ -235,7 +274,6 @@ func TestTableStorage_Insert_Fail(t *testing.T) {
 			givenTable: storage.Table{
 				ID:       uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"),
 				IsClosed: false,
-				Outcome:  nil,
 			},
 			givenTables: map[uuid.UUID]storage.Table{
 				uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"): {
@@ -265,76 +303,312 @@ func TestTableStorage_Insert_Fail(t *testing.T) {
 	}
 }
 
+func TestTableStorage_Insert_Continued(t *testing.T) {
+	previous := storage.Table{ID: uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210")}
+	rolledBack := storage.Table{ID: uuid.MustParse("06510953-65f4-4b28-a8ec-398a605e5210"), Previous: previous.ID}
+	next := storage.Table{ID: uuid.MustParse("16510953-65f4-4b28-a8ec-398a605e5210"), Previous: previous.ID}
+	fork := storage.Table{ID: uuid.MustParse("26510953-65f4-4b28-a8ec-398a605e5210"), Previous: previous.ID}
+
+	events := NewEventStore(clock.NewFake(stampedAt))
+	store := NewTableStorage(events)
+
+	err := store.Insert(context.Background(), previous)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = NewUnitOfWork().Transact(context.Background(), func(ctx context.Context) error {
+		err := store.Insert(ctx, rolledBack)
+		if err != nil {
+			return err
+		}
+
+		return errTransaction
+	})
+	if !cmp.Equal(err, errTransaction, cmpopts.EquateErrors()) {
+		t.Fatal(cmp.Diff(err, errTransaction, cmpopts.EquateErrors()))
+	}
+
+	err = store.Insert(context.Background(), next)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rebuilt := NewTableStorage(events)
+
+	err = rebuilt.Rebuild(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, s := range []*TableStorage{store, rebuilt} {
+		err = s.Insert(context.Background(), fork)
+		if !cmp.Equal(err, storage.ErrTableContinued, cmpopts.EquateErrors()) {
+			t.Fatal(cmp.Diff(err, storage.ErrTableContinued, cmpopts.EquateErrors()))
+		}
+	}
+}
+
 func TestTableStorage_List(t *testing.T) {
+	first := storage.Table{
+		ID:        uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"),
+		IsClosed:  true,
+		Outcomes:  []storage.Outcome{{Colour: "red", Value: 16}},
+		CreatedAt: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC),
+	}
+	second := storage.Table{
+		ID:        uuid.MustParse("06510953-65f4-4b28-a8ec-398a605e5210"),
+		IsClosed:  false,
+		CreatedAt: time.Date(2021, 6, 2, 0, 0, 0, 0, time.UTC),
+	}
+	third := storage.Table{
+		ID:        uuid.MustParse("16510953-65f4-4b28-a8ec-398a605e5210"),
+		IsClosed:  false,
+		CreatedAt: time.Date(2021, 6, 2, 0, 0, 0, 0, time.UTC),
+	}
+	tables := map[uuid.UUID]storage.Table{
+		first.ID:  first,
+		second.ID: second,
+		third.ID:  third,
+	}
+	isClosed := false
+	createdFrom := time.Date(2021, 6, 2, 0, 0, 0, 0, time.UTC)
+
 	tests := []struct {
-		name           string
-		givenTables    map[uuid.UUID]storage.Table
-		expectedTables []storage.Table
+		name         string
+		givenTables  map[uuid.UUID]storage.Table
+		givenQuery   storage.TableQuery
+		expectedPage storage.TablePage
 	}{
 		{
-			name: "expect slice of Tables to be returned",
-			givenTables: map[uuid.UUID]storage.Table{
-				uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"): {
-					ID:       uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"),
-					IsClosed: false,
-				},
+			name:        "given no query, expect all Tables ordered by creation time and then ID",
+			givenTables: tables,
+			expectedPage: storage.TablePage{
+				Tables: []storage.Table{first, second, third},
 			},
-			expectedTables: []storage.Table{
-				{
-					ID:       uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"),
-					IsClosed: false,
-				},
+		},
+		{
+			name:        "given descending order, expect Tables in reverse",
+			givenTables: tables,
+			givenQuery:  storage.TableQuery{Descending: true},
+			expectedPage: storage.TablePage{
+				Tables: []storage.Table{third, second, first},
+			},
+		},
+		{
+			name:        "given a limit, expect a page and the cursor of its last Table",
+			givenTables: tables,
+			givenQuery:  storage.TableQuery{Limit: 2},
+			expectedPage: storage.TablePage{
+				Tables: []storage.Table{first, second},
+				Next:   &storage.TableCursor{CreatedAt: second.CreatedAt, ID: second.ID},
+			},
+		},
+		{
+			name:        "given a cursor, expect the Tables after it",
+			givenTables: tables,
+			givenQuery: storage.TableQuery{
+				After: &storage.TableCursor{CreatedAt: second.CreatedAt, ID: second.ID},
+				Limit: 2,
+			},
+			expectedPage: storage.TablePage{
+				Tables: []storage.Table{third},
+			},
+		},
+		{
+			name:        "given a cursor in descending order, expect the Tables before it",
+			givenTables: tables,
+			givenQuery: storage.TableQuery{
+				Descending: true,
+				After:      &storage.TableCursor{CreatedAt: second.CreatedAt, ID: second.ID},
+			},
+			expectedPage: storage.TablePage{
+				Tables: []storage.Table{first},
+			},
+		},
+		{
+			name:        "given a state filter, expect only matching Tables",
+			givenTables: tables,
+			givenQuery:  storage.TableQuery{IsClosed: &isClosed},
+			expectedPage: storage.TablePage{
+				Tables: []storage.Table{second, third},
+			},
+		},
+		{
+			name:        "given a created from filter, expect only Tables created since",
+			givenTables: tables,
+			givenQuery:  storage.TableQuery{CreatedFrom: &createdFrom},
+			expectedPage: storage.TablePage{
+				Tables: []storage.Table{second, third},
+			},
+		},
+		{
+			name:        "given a created to filter, expect only Tables created before",
+			givenTables: tables,
+			givenQuery:  storage.TableQuery{CreatedTo: &createdFrom},
+			expectedPage: storage.TablePage{
+				Tables: []storage.Table{first},
+			},
+		},
+		{
+			name:        "given a colour filter, expect only Tables with that outcome",
+			givenTables: tables,
+			givenQuery:  storage.TableQuery{Colour: "red"},
+			expectedPage: storage.TablePage{
+				Tables: []storage.Table{first},
 			},
 		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			store := TableStorage{
-				tables:  test.givenTables,
-				RWMutex: sync.RWMutex{},
+				tables:     test.givenTables,
+				byCreation: indexTables(test.givenTables),
+				RWMutex:    sync.RWMutex{},
 			}
 
-			actual, err := store.List(context.Background())
+			actual, err := store.List(context.Background(), test.givenQuery)
 			if err != nil {
 				t.Fatal(err)
 			}
 
-			if !cmp.Equal(actual, test.expectedTables) {
-				t.Fatal(cmp.Diff(actual, test.expectedTables))
+			if !cmp.Equal(actual, test.expectedPage) {
+				t.Fatal(cmp.Diff(actual, test.expectedPage))
 			}
 		})
 	}
 }
 
-func TestTableStorage_SetOutcome_Success(t *testing.T) {
+func TestTableStorage_List_Inserted(t *testing.T) {
+	first := storage.Table{
+		ID:        uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"),
+		CreatedAt: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC),
+	}
+	second := storage.Table{
+		ID:        uuid.MustParse("06510953-65f4-4b28-a8ec-398a605e5210"),
+		CreatedAt: time.Date(2021, 6, 2, 0, 0, 0, 0, time.UTC),
+	}
+	third := storage.Table{
+		ID:        uuid.MustParse("16510953-65f4-4b28-a8ec-398a605e5210"),
+		CreatedAt: time.Date(2021, 6, 2, 0, 0, 0, 0, time.UTC),
+	}
+	rolledBack := storage.Table{
+		ID:        uuid.MustParse("26510953-65f4-4b28-a8ec-398a605e5210"),
+		CreatedAt: time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	store := NewTableStorage(NewEventStore(clock.NewFake(stampedAt)))
+
+	for _, table := range []storage.Table{third, first, second} {
+		err := store.Insert(context.Background(), table)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err := NewUnitOfWork().Transact(context.Background(), func(ctx context.Context) error {
+		err := store.Insert(ctx, rolledBack)
+		if err != nil {
+			return err
+		}
+
+		return errTransaction
+	})
+	if !cmp.Equal(err, errTransaction, cmpopts.EquateErrors()) {
+		t.Fatal(cmp.Diff(err, errTransaction, cmpopts.EquateErrors()))
+	}
+
+	first.Version, second.Version, third.Version = 1, 1, 1
+
+	var actual []storage.Table
+
+	query := storage.TableQuery{Descending: true, Limit: 2}
+
+	for {
+		page, err := store.List(context.Background(), query)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		actual = append(actual, page.Tables...)
+
+		if page.Next == nil {
+			break
+		}
+
+		query.After = page.Next
+	}
+
+	expected := []storage.Table{third, second, first}
+
+	if !cmp.Equal(actual, expected) {
+		t.Fatal(cmp.Diff(actual, expected))
+	}
+}
+
+func TestTableStorage_SetOutcomes_Success(t *testing.T) {
 	tests := []struct {
-		name           string
-		givenID        uuid.UUID
-		givenOutcome   storage.Outcome
-		givenTables    map[uuid.UUID]storage.Table
-		expectedTables map[uuid.UUID]storage.Table
+		name             string
+		givenID          uuid.UUID
+		givenVersion     int64
+		givenOutcomes    []storage.Outcome
+		givenMultipliers []storage.Multiplier
+		givenTables      map[uuid.UUID]storage.Table
+		expectedTables   map[uuid.UUID]storage.Table
 	}{
 		{
-			name:    "given a valid ID, expect it the outcome to be set",
-			givenID: uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"),
-			givenOutcome: storage.Outcome{
+			name:         "given a valid ID at the given version, expect the outcomes to be set",
+			givenID:      uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"),
+			givenVersion: 2,
+			givenOutcomes: []storage.Outcome{{
 				Colour: "red",
 				Value:  16,
-			},
+			}},
 			givenTables: map[uuid.UUID]storage.Table{
 				uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"): {
 					ID:       uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"),
 					IsClosed: false,
+					Version:  2,
 				},
 			},
 			expectedTables: map[uuid.UUID]storage.Table{
 				uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"): {
 					ID:       uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"),
 					IsClosed: false,
-					Outcome: &storage.Outcome{
+					Outcomes: []storage.Outcome{{
 						Colour: "red",
 						Value:  16,
-					},
+					}},
+					SpunAt:  &stampedAt,
+					SpunBy:  "croupier",
+					Version: 3,
+				},
+			},
+		},
+		{
+			name:             "given multipliers struck for the spin, expect them to be set with the outcomes",
+			givenID:          uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"),
+			givenVersion:     2,
+			givenOutcomes:    []storage.Outcome{{Colour: "red", Value: 16}},
+			givenMultipliers: []storage.Multiplier{{Position: 16, Factor: 200}, {Position: 3, Factor: 50}},
+			givenTables: map[uuid.UUID]storage.Table{
+				uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"): {
+					ID:       uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"),
+					ZeroRule: "none",
+					Variant:  "lightning",
+					Version:  2,
+				},
+			},
+			expectedTables: map[uuid.UUID]storage.Table{
+				uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"): {
+					ID:          uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"),
+					Outcomes:    []storage.Outcome{{Colour: "red", Value: 16}},
+					Multipliers: []storage.Multiplier{{Position: 16, Factor: 200}, {Position: 3, Factor: 50}},
+					ZeroRule:    "none",
+					Variant:     "lightning",
+					SpunAt:      &stampedAt,
+					SpunBy:      "croupier",
+					Version:     3,
 				},
 			},
 		},
@@ -342,11 +616,20 @@ func TestTableStorage_SetOutcome_Success(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			store := TableStorage{
+				events:  NewEventStore(clock.NewFake(stampedAt)),
 				tables:  test.givenTables,
 				RWMutex: sync.RWMutex{},
 			}
 
-			err := store.SetOutcome(context.Background(), test.givenID, test.givenOutcome)
+			err := store.SetOutcomes(
+				context.Background(),
+				test.givenID,
+				test.givenVersion,
+				test.givenOutcomes,
+				test.givenMultipliers,
+				stampedAt,
+				"croupier",
+			)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -358,21 +641,23 @@ func TestTableStorage_SetOutcome_Success(t *testing.T) {
 	}
 }
 
-func TestTableStorage_SetOutcome_Fail(t *testing.T) {
+func TestTableStorage_SetOutcomes_Fail(t *testing.T) {
 	tests := []struct {
 		name          string
 		givenID       uuid.UUID
-		givenOutcome  storage.Outcome
+		givenVersion  int64
+		givenOutcomes []storage.Outcome
 		givenTables   map[uuid.UUID]storage.Table
 		expectedError error
 	}{
 		{
-			name:    "given an invalid ID, expect it to error",
-			givenID: uuid.MustParse("99510953-65f4-4b28-a8ec-398a605e5210"),
-			givenOutcome: storage.Outcome{
+			name:         "given an invalid ID, expect it to error",
+			givenID:      uuid.MustParse("99510953-65f4-4b28-a8ec-398a605e5210"),
+			givenVersion: 1,
+			givenOutcomes: []storage.Outcome{{
 				Colour: "red",
 				Value:  16,
-			},
+			}},
 			givenTables: map[uuid.UUID]storage.Table{
 				uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"): {
 					ID:       uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"),
@@ -381,6 +666,42 @@ func TestTableStorage_SetOutcome_Fail(t *testing.T) {
 			},
 			expectedError: ErrNoTables,
 		},
+		{
+			name:         "given a version which is no longer stored, expect a conflict",
+			givenID:      uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"),
+			givenVersion: 1,
+			givenOutcomes: []storage.Outcome{{
+				Colour: "red",
+				Value:  16,
+			}},
+			givenTables: map[uuid.UUID]storage.Table{
+				uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"): {
+					ID:       uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"),
+					IsClosed: true,
+					Version:  2,
+				},
+			},
+			expectedError: storage.ErrVersionConflict,
+		},
+		{
+			name:         "given a table which has already been spun, expect ErrTableSpun",
+			givenID:      uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"),
+			givenVersion: 3,
+			givenOutcomes: []storage.Outcome{{
+				Colour: "red",
+				Value:  7,
+			}},
+			givenTables: map[uuid.UUID]storage.Table{
+				uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"): {
+					ID:       uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"),
+					IsClosed: true,
+					Outcomes: []storage.Outcome{{Colour: "red", Value: 14}},
+					SpunAt:   &stampedAt,
+					Version:  3,
+				},
+			},
+			expectedError: storage.ErrTableSpun,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -389,7 +710,111 @@ func TestTableStorage_SetOutcome_Fail(t *testing.T) {
 				RWMutex: sync.RWMutex{},
 			}
 
-			err := store.SetOutcome(context.Background(), test.givenID, test.givenOutcome)
+			err := store.SetOutcomes(context.Background(), test.givenID, test.givenVersion, test.givenOutcomes, nil, stampedAt, "croupier")
+			if err == nil {
+				t.Fatalf("expected %v, got nil", test.expectedError)
+			}
+
+			if !cmp.Equal(err, test.expectedError, cmpopts.EquateErrors()) {
+				t.Fatal(cmp.Diff(err, test.expectedError, cmpopts.EquateErrors()))
+			}
+		})
+	}
+}
+
+func TestTableStorage_Settle_Success(t *testing.T) {
+	id := uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210")
+
+	store := TableStorage{
+		events: NewEventStore(clock.NewFake(stampedAt)),
+		tables: map[uuid.UUID]storage.Table{
+			id: {ID: id, IsClosed: true, Outcomes: []storage.Outcome{{Colour: "red", Value: 16}}, SpunAt: &stampedAt, Version: 3},
+		},
+	}
+
+	err := store.Settle(context.Background(), id, 3, stampedAt, "croupier")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[uuid.UUID]storage.Table{
+		id: {
+			ID:        id,
+			IsClosed:  true,
+			Outcomes:  []storage.Outcome{{Colour: "red", Value: 16}},
+			SpunAt:    &stampedAt,
+			SettledAt: &stampedAt,
+			SettledBy: "croupier",
+			Version:   4,
+		},
+	}
+
+	if !cmp.Equal(store.tables, expected) {
+		t.Fatal(cmp.Diff(store.tables, expected))
+	}
+}
+
+func TestTableStorage_Settle_Fail(t *testing.T) {
+	spun := storage.Table{
+		ID:       uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"),
+		IsClosed: true,
+		Outcomes: []storage.Outcome{{Colour: "red", Value: 14}},
+		SpunAt:   &stampedAt,
+		Version:  3,
+	}
+
+	settled := spun
+	settled.SettledAt = &stampedAt
+
+	tests := []struct {
+		name          string
+		givenID       uuid.UUID
+		givenVersion  int64
+		givenTable    storage.Table
+		expectedError error
+	}{
+		{
+			name:          "given an invalid ID, expect it to error",
+			givenID:       uuid.MustParse("99510953-65f4-4b28-a8ec-398a605e5210"),
+			givenVersion:  3,
+			givenTable:    spun,
+			expectedError: ErrNoTables,
+		},
+		{
+			name:          "given a version which is no longer stored, expect a conflict",
+			givenID:       uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"),
+			givenVersion:  2,
+			givenTable:    spun,
+			expectedError: storage.ErrVersionConflict,
+		},
+		{
+			name:         "given a table which has not been spun, expect ErrTableNotSpun",
+			givenID:      uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"),
+			givenVersion: 3,
+			givenTable: storage.Table{
+				ID:       uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"),
+				IsClosed: true,
+				Version:  3,
+			},
+			expectedError: storage.ErrTableNotSpun,
+		},
+		{
+			name:          "given a table which has already been settled, expect ErrTableSettled",
+			givenID:       uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"),
+			givenVersion:  3,
+			givenTable:    settled,
+			expectedError: storage.ErrTableSettled,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			store := TableStorage{
+				tables: map[uuid.UUID]storage.Table{
+					uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210"): test.givenTable,
+				},
+			}
+
+			err := store.Settle(context.Background(), test.givenID, test.givenVersion, stampedAt, "croupier")
 			if err == nil {
 				t.Fatalf("expected %v, got nil", test.expectedError)
 			}
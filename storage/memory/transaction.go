@@ -0,0 +1,83 @@
+package memory
+
+import (
+	"betting/internal/pkg/tracing"
+	"context"
+	"sync"
+)
+
+// UnitOfWork runs writes against TableStorage, BetStorage, EventStore, JackpotStorage, StatisticsStorage and
+// AuditStorage as a single unit, should any of them fail the writes already made are undone. Units of work are run
+// one at a time.
+type UnitOfWork struct {
+	sync.Mutex
+}
+
+// NewUnitOfWork instantiates a UnitOfWork.
+func NewUnitOfWork() *UnitOfWork {
+	return &UnitOfWork{}
+}
+
+// Transact calls fn with a context through which every write to storage is recorded. When fn returns an error the
+// recorded writes are rolled back, in reverse order, and the error is returned as is. A call made within a unit of
+// work joins it rather than starting another.
+func (u *UnitOfWork) Transact(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(transactionKey{}).(*transaction); ok {
+		return fn(ctx)
+	}
+
+	ctx, span := tracing.Start(ctx, "memory.UnitOfWork.Transact")
+	defer span.End()
+
+	u.Lock()
+	defer u.Unlock()
+
+	tx := &transaction{}
+
+	err := fn(context.WithValue(ctx, transactionKey{}, tx))
+	if err != nil {
+		tx.rollback()
+
+		return tracing.Fail(span, err)
+	}
+
+	return nil
+}
+
+type transactionKey struct{}
+
+// transaction holds the functions which undo each write made within a unit of work.
+type transaction struct {
+	undo []func()
+	sync.Mutex
+}
+
+func (t *transaction) rollback() {
+	t.Lock()
+	defer t.Unlock()
+
+	for i := len(t.undo) - 1; i >= 0; i-- {
+		t.undo[i]()
+	}
+
+	t.undo = nil
+}
+
+// record registers undo against the unit of work carried by ctx, if any. The storage lock must not be taken by undo
+// as it is held by the caller, it is taken again when undo is run.
+func record(ctx context.Context, lock sync.Locker, undo func()) {
+	tx, ok := ctx.Value(transactionKey{}).(*transaction)
+	if !ok {
+		return
+	}
+
+	tx.Lock()
+	defer tx.Unlock()
+
+	tx.undo = append(tx.undo, func() {
+		lock.Lock()
+		defer lock.Unlock()
+
+		undo()
+	})
+}
@@ -0,0 +1,240 @@
+package memory
+
+import (
+	"betting/internal/domain"
+	"betting/internal/pkg/clock"
+	"betting/storage"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+)
+
+var (
+	transactionTableID = uuid.MustParse("86510953-65f4-4b28-a8ec-398a605e5210")
+	transactionBetID   = uuid.MustParse("0173b64f-e07e-4fa0-bcb3-231856390dce")
+	errTransaction     = errors.New("failed to write")
+)
+
+func TestUnitOfWork_Transact_Success(t *testing.T) {
+	tests := []struct {
+		name           string
+		givenFn        func(ctx context.Context, tables *TableStorage, bets *BetStorage) error
+		expectedTables map[uuid.UUID]storage.Table
+		expectedBets   map[uuid.UUID]storage.Bet
+	}{
+		{
+			name: "given writes which succeed, expect them to be kept",
+			givenFn: func(ctx context.Context, tables *TableStorage, bets *BetStorage) error {
+				err := tables.Close(ctx, transactionTableID, 1, stampedAt, "croupier")
+				if err != nil {
+					return err
+				}
+
+				err = bets.UpdateStateByTableID(ctx, transactionTableID, domain.Live)
+				if err != nil {
+					return err
+				}
+
+				return tables.SetOutcomes(ctx, transactionTableID, 2, []storage.Outcome{{Colour: "red", Value: 14}}, nil, stampedAt, "croupier")
+			},
+			expectedTables: map[uuid.UUID]storage.Table{
+				transactionTableID: {
+					ID:       transactionTableID,
+					IsClosed: true,
+					Outcomes: []storage.Outcome{{Colour: "red", Value: 14}},
+					ClosedAt: &stampedAt,
+					ClosedBy: "croupier",
+					SpunAt:   &stampedAt,
+					SpunBy:   "croupier",
+					Version:  3,
+				},
+			},
+			expectedBets: map[uuid.UUID]storage.Bet{
+				transactionBetID: {
+					ID:      transactionBetID,
+					Status:  domain.Live.String(),
+					Table:   transactionTableID,
+					Version: 2,
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tables, bets := newTransactionStorage(t)
+
+			err := NewUnitOfWork().Transact(context.Background(), func(ctx context.Context) error {
+				return test.givenFn(ctx, tables, bets)
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(tables.tables, test.expectedTables) {
+				t.Fatal(cmp.Diff(tables.tables, test.expectedTables))
+			}
+
+			if !cmp.Equal(bets.bets, test.expectedBets) {
+				t.Fatal(cmp.Diff(bets.bets, test.expectedBets))
+			}
+		})
+	}
+}
+
+func TestUnitOfWork_Transact_Fail(t *testing.T) {
+	tests := []struct {
+		name          string
+		givenFn       func(ctx context.Context, tables *TableStorage, bets *BetStorage) error
+		expectedError error
+	}{
+		{
+			name: "given a failure after closing the table and marking bets live, expect both to be undone",
+			givenFn: func(ctx context.Context, tables *TableStorage, bets *BetStorage) error {
+				err := tables.Close(ctx, transactionTableID, 1, stampedAt, "croupier")
+				if err != nil {
+					return err
+				}
+
+				err = bets.UpdateStateByTableID(ctx, transactionTableID, domain.Live)
+				if err != nil {
+					return err
+				}
+
+				return errTransaction
+			},
+			expectedError: errTransaction,
+		},
+		{
+			name: "given a failure after setting the outcome and winners, expect both to be undone",
+			givenFn: func(ctx context.Context, tables *TableStorage, bets *BetStorage) error {
+				err := tables.SetOutcomes(ctx, transactionTableID, 1, []storage.Outcome{{Colour: "red", Value: 14}}, nil, stampedAt, "croupier")
+				if err != nil {
+					return err
+				}
+
+				err = bets.SetWinners(ctx, []storage.Bet{
+					{ID: transactionBetID, Status: domain.Settled.String(), Win: true, Table: transactionTableID, Version: 1},
+					{ID: uuid.New(), Status: domain.Settled.String(), Table: transactionTableID},
+				})
+				if err != nil {
+					return err
+				}
+
+				return errTransaction
+			},
+			expectedError: errTransaction,
+		},
+		{
+			name: "given a failure after inserting a table and a bet, expect both to be removed",
+			givenFn: func(ctx context.Context, tables *TableStorage, bets *BetStorage) error {
+				table := storage.Table{ID: uuid.New()}
+
+				err := tables.Insert(ctx, table)
+				if err != nil {
+					return err
+				}
+
+				err = bets.Insert(ctx, storage.Bet{ID: uuid.New(), Table: transactionTableID})
+				if err != nil {
+					return err
+				}
+
+				return errTransaction
+			},
+			expectedError: errTransaction,
+		},
+		{
+			name: "given a failing storage call, expect its error and earlier writes to be undone",
+			givenFn: func(ctx context.Context, tables *TableStorage, _ *BetStorage) error {
+				err := tables.Close(ctx, transactionTableID, 1, stampedAt, "croupier")
+				if err != nil {
+					return err
+				}
+
+				return tables.SetOutcomes(ctx, uuid.New(), 2, []storage.Outcome{{}}, nil, stampedAt, "croupier")
+			},
+			expectedError: ErrNoTables,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tables, bets := newTransactionStorage(t)
+			expectedTables, expectedBets, expectedByTable := tables.tables, bets.bets, bets.byTable
+
+			tables, bets = newTransactionStorage(t)
+
+			err := NewUnitOfWork().Transact(context.Background(), func(ctx context.Context) error {
+				return test.givenFn(ctx, tables, bets)
+			})
+
+			if !cmp.Equal(err, test.expectedError, cmpopts.EquateErrors()) {
+				t.Fatal(cmp.Diff(err, test.expectedError, cmpopts.EquateErrors()))
+			}
+
+			if !cmp.Equal(tables.tables, expectedTables) {
+				t.Fatal(cmp.Diff(tables.tables, expectedTables))
+			}
+
+			if !cmp.Equal(bets.bets, expectedBets) {
+				t.Fatal(cmp.Diff(bets.bets, expectedBets))
+			}
+
+			if !cmp.Equal(bets.byTable, expectedByTable) {
+				t.Fatal(cmp.Diff(bets.byTable, expectedByTable))
+			}
+		})
+	}
+}
+
+func TestUnitOfWork_Transact_Nested(t *testing.T) {
+	tables, _ := newTransactionStorage(t)
+	unitOfWork := NewUnitOfWork()
+
+	err := unitOfWork.Transact(context.Background(), func(ctx context.Context) error {
+		err := unitOfWork.Transact(ctx, func(ctx context.Context) error {
+			return tables.Close(ctx, transactionTableID, 1, stampedAt, "croupier")
+		})
+		if err != nil {
+			return err
+		}
+
+		return errTransaction
+	})
+
+	if !cmp.Equal(err, errTransaction, cmpopts.EquateErrors()) {
+		t.Fatal(cmp.Diff(err, errTransaction, cmpopts.EquateErrors()))
+	}
+
+	if tables.tables[transactionTableID].IsClosed {
+		t.Fatal("expected the write of the joined unit of work to be undone")
+	}
+}
+
+// newTransactionStorage returns storage holding an open table with a single unsettled bet.
+func newTransactionStorage(t *testing.T) (*TableStorage, *BetStorage) {
+	t.Helper()
+
+	events := NewEventStore(clock.NewFake(stampedAt))
+	tables := NewTableStorage(events)
+	bets := NewBetStorage(tables, events, clock.NewFake(stampedAt))
+
+	err := tables.Insert(context.Background(), storage.Table{ID: transactionTableID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = bets.Insert(context.Background(), storage.Bet{
+		ID:     transactionBetID,
+		Status: domain.Unsettled.String(),
+		Table:  transactionTableID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return tables, bets
+}
@@ -0,0 +1,99 @@
+package memory
+
+import (
+	"betting/internal/pkg/tracing"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/Rhymond/go-money"
+)
+
+// ErrInsufficientFunds is returned when a player's wallet holds less than the stakes taken from it.
+var ErrInsufficientFunds = errors.New("wallet balance is less than the stakes")
+
+// WalletStorage holds the balance of each player, in minor units keyed by currency code. A player is given the opening
+// balances the first time their wallet is used, currencies without an opening balance hold nothing.
+type WalletStorage struct {
+	opening  map[string]int64
+	balances map[string]map[string]int64
+	sync.RWMutex
+}
+
+// NewWalletStorage instantiates WalletStorage, opening every wallet with the given balances.
+func NewWalletStorage(opening map[string]int64) *WalletStorage {
+	return &WalletStorage{
+		opening:  opening,
+		balances: make(map[string]map[string]int64),
+	}
+}
+
+// Balance returns what the player's wallet holds of the given currency.
+func (w *WalletStorage) Balance(ctx context.Context, player, currency string) (*money.Money, error) {
+	_, span := tracing.Start(ctx, "memory.WalletStorage.Balance")
+	defer span.End()
+
+	w.RLock()
+	defer w.RUnlock()
+
+	balances, ok := w.balances[player]
+	if !ok {
+		return money.New(w.opening[currency], currency), nil
+	}
+
+	return money.New(balances[currency], currency), nil
+}
+
+// Debit takes the given stakes from the player's wallet, either all of them are taken or, should a currency not hold
+// enough, none are and ErrInsufficientFunds is returned.
+func (w *WalletStorage) Debit(ctx context.Context, player string, stakes []*money.Money) error {
+	_, span := tracing.Start(ctx, "memory.WalletStorage.Debit")
+	defer span.End()
+
+	w.Lock()
+	defer w.Unlock()
+
+	owed := make(map[string]int64)
+
+	for i := range stakes {
+		owed[stakes[i].Currency().Code] += stakes[i].Amount()
+	}
+
+	balances, opened := w.balances[player]
+	if !opened {
+		balances = make(map[string]int64, len(w.opening))
+
+		for code, amount := range w.opening {
+			balances[code] = amount
+		}
+	}
+
+	for code, amount := range owed {
+		if balances[code] < amount {
+			held := money.New(balances[code], code)
+
+			return tracing.Fail(span, fmt.Errorf("holds %v of %v: %w", held.Display(), money.New(amount, code).Display(),
+				ErrInsufficientFunds))
+		}
+	}
+
+	for code, amount := range owed {
+		balances[code] -= amount
+	}
+
+	w.balances[player] = balances
+
+	record(ctx, w, func() {
+		if !opened {
+			delete(w.balances, player)
+			return
+		}
+
+		for code, amount := range owed {
+			w.balances[player][code] += amount
+		}
+	})
+
+	return nil
+}
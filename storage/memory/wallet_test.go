@@ -0,0 +1,140 @@
+package memory
+
+import (
+	"betting/testing/opts"
+	"context"
+	"testing"
+
+	"github.com/Rhymond/go-money"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestWalletStorage_Debit_Success(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenOpening    map[string]int64
+		givenStakes     [][]*money.Money
+		expectedBalance []*money.Money
+	}{
+		{
+			name:            "given no stakes, expect the opening balances",
+			givenOpening:    map[string]int64{"GBP": 1000},
+			expectedBalance: []*money.Money{money.New(1000, "GBP"), money.New(0, "EUR")},
+		},
+		{
+			name:         "given stakes in several currencies, expect each to be taken from its own balance",
+			givenOpening: map[string]int64{"GBP": 1000, "EUR": 500},
+			givenStakes: [][]*money.Money{
+				{money.New(300, "GBP"), money.New(200, "EUR")},
+				{money.New(700, "GBP")},
+			},
+			expectedBalance: []*money.Money{money.New(0, "GBP"), money.New(300, "EUR")},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			wallet := NewWalletStorage(test.givenOpening)
+
+			for _, stakes := range test.givenStakes {
+				err := wallet.Debit(context.Background(), "player", stakes)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			actual := make([]*money.Money, 0, len(test.expectedBalance))
+
+			for _, expected := range test.expectedBalance {
+				balance, err := wallet.Balance(context.Background(), "player", expected.Currency().Code)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				actual = append(actual, balance)
+			}
+
+			if !cmp.Equal(actual, test.expectedBalance, opts.MoneyComparer) {
+				t.Fatal(cmp.Diff(actual, test.expectedBalance, opts.MoneyComparer))
+			}
+		})
+	}
+}
+
+func TestWalletStorage_Debit_Fail(t *testing.T) {
+	tests := []struct {
+		name          string
+		givenStakes   []*money.Money
+		expectedError error
+	}{
+		{
+			name:          "given stakes exceeding the balance together, expect none of them to be taken",
+			givenStakes:   []*money.Money{money.New(600, "GBP"), money.New(600, "GBP")},
+			expectedError: ErrInsufficientFunds,
+		},
+		{
+			name:          "given a stake in a currency without a balance, expect none of them to be taken",
+			givenStakes:   []*money.Money{money.New(100, "GBP"), money.New(100, "EUR")},
+			expectedError: ErrInsufficientFunds,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			wallet := NewWalletStorage(map[string]int64{"GBP": 1000})
+
+			err := wallet.Debit(context.Background(), "player", test.givenStakes)
+			if !cmp.Equal(err, test.expectedError, cmpopts.EquateErrors()) {
+				t.Fatal(cmp.Diff(err, test.expectedError, cmpopts.EquateErrors()))
+			}
+
+			actual, err := wallet.Balance(context.Background(), "player", "GBP")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(actual, money.New(1000, "GBP"), opts.MoneyComparer) {
+				t.Fatal(cmp.Diff(actual, money.New(1000, "GBP"), opts.MoneyComparer))
+			}
+		})
+	}
+}
+
+func TestWalletStorage_Debit_Rollback(t *testing.T) {
+	wallet := NewWalletStorage(map[string]int64{"GBP": 1000})
+
+	err := wallet.Debit(context.Background(), "player", []*money.Money{money.New(400, "GBP")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = NewUnitOfWork().Transact(context.Background(), func(ctx context.Context) error {
+		err := wallet.Debit(ctx, "player", []*money.Money{money.New(500, "GBP")})
+		if err != nil {
+			return err
+		}
+
+		err = wallet.Debit(ctx, "another player", []*money.Money{money.New(500, "GBP")})
+		if err != nil {
+			return err
+		}
+
+		return errTransaction
+	})
+	if !cmp.Equal(err, errTransaction, cmpopts.EquateErrors()) {
+		t.Fatal(cmp.Diff(err, errTransaction, cmpopts.EquateErrors()))
+	}
+
+	for player, expected := range map[string]*money.Money{
+		"player":         money.New(600, "GBP"),
+		"another player": money.New(1000, "GBP"),
+	} {
+		actual, err := wallet.Balance(context.Background(), player, "GBP")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !cmp.Equal(actual, expected, opts.MoneyComparer) {
+			t.Fatal(cmp.Diff(actual, expected, opts.MoneyComparer))
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package storage
+
+import (
+	"betting/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TableCursor is the storage representation of domain.TableCursor.
+type TableCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+// TableQuery is the storage representation of domain.TableQuery. A nil IsClosed matches both open and closed Tables,
+// a Limit of zero returns every matching Table.
+type TableQuery struct {
+	IsClosed    *bool
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	Colour      string
+	Descending  bool
+	After       *TableCursor
+	Limit       int
+}
+
+// TablePage is the storage representation of domain.TablePage.
+type TablePage struct {
+	Tables []Table
+	Next   *TableCursor
+}
+
+// AdaptTableQueryFromDomain adapts a domain.TableQuery to a TableQuery.
+func AdaptTableQueryFromDomain(query domain.TableQuery) TableQuery {
+	q := TableQuery{
+		CreatedFrom: query.CreatedFrom,
+		CreatedTo:   query.CreatedTo,
+		Colour:      query.Colour.String(),
+		Descending:  query.Order == domain.Descending,
+		Limit:       query.Limit,
+	}
+
+	switch query.State {
+	case domain.Open:
+		isClosed := false
+		q.IsClosed = &isClosed
+	case domain.Closed:
+		isClosed := true
+		q.IsClosed = &isClosed
+	}
+
+	if query.After != nil {
+		q.After = &TableCursor{
+			CreatedAt: query.After.CreatedAt,
+			ID:        query.After.ID,
+		}
+	}
+
+	return q
+}
+
+// AdaptTablePageToDomain adapts a TablePage to a domain.TablePage.
+func AdaptTablePageToDomain(page TablePage) domain.TablePage {
+	tables := make([]domain.Table, len(page.Tables))
+
+	for i := range page.Tables {
+		tables[i] = AdaptTableToDomain(page.Tables[i])
+	}
+
+	p := domain.TablePage{
+		Tables: tables,
+	}
+
+	if page.Next != nil {
+		p.Next = &domain.TableCursor{
+			CreatedAt: page.Next.CreatedAt,
+			ID:        page.Next.ID,
+		}
+	}
+
+	return p
+}
@@ -0,0 +1,118 @@
+package storage
+
+import (
+	"betting/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Statistics is the storage representation of domain.Statistics.
+type Statistics struct {
+	Series  uuid.UUID
+	Spins   int
+	Recent  []Result
+	Hits    []int
+	LastHit []int
+	Streaks []Streak
+}
+
+// Result is the storage representation of domain.Result.
+type Result struct {
+	Table  uuid.UUID
+	Value  int
+	Colour string
+	SpunAt time.Time
+}
+
+// Streak is the storage representation of domain.Streak.
+type Streak struct {
+	Kind   string
+	Value  string
+	Length int
+}
+
+// AdaptStatisticsToDomain returns a domain.Statistics for a given Statistics.
+func AdaptStatisticsToDomain(statistics Statistics) domain.Statistics {
+	s := domain.Statistics{
+		Series:  statistics.Series,
+		Spins:   statistics.Spins,
+		Hits:    copyInts(statistics.Hits),
+		LastHit: copyInts(statistics.LastHit),
+	}
+
+	if statistics.Recent != nil {
+		s.Recent = make([]domain.Result, len(statistics.Recent))
+	}
+
+	for i := range statistics.Recent {
+		s.Recent[i] = domain.Result{
+			Table:  statistics.Recent[i].Table,
+			Value:  statistics.Recent[i].Value,
+			Colour: domain.Colour(statistics.Recent[i].Colour),
+			SpunAt: statistics.Recent[i].SpunAt,
+		}
+	}
+
+	if statistics.Streaks != nil {
+		s.Streaks = make([]domain.Streak, len(statistics.Streaks))
+	}
+
+	for i := range statistics.Streaks {
+		s.Streaks[i] = domain.Streak{
+			Kind:   domain.StreakKind(statistics.Streaks[i].Kind),
+			Value:  statistics.Streaks[i].Value,
+			Length: statistics.Streaks[i].Length,
+		}
+	}
+
+	return s
+}
+
+// AdaptStatisticsFromDomain adapts a domain.Statistics to Statistics.
+func AdaptStatisticsFromDomain(statistics domain.Statistics) Statistics {
+	s := Statistics{
+		Series:  statistics.Series,
+		Spins:   statistics.Spins,
+		Hits:    copyInts(statistics.Hits),
+		LastHit: copyInts(statistics.LastHit),
+	}
+
+	if statistics.Recent != nil {
+		s.Recent = make([]Result, len(statistics.Recent))
+	}
+
+	for i := range statistics.Recent {
+		s.Recent[i] = Result{
+			Table:  statistics.Recent[i].Table,
+			Value:  statistics.Recent[i].Value,
+			Colour: statistics.Recent[i].Colour.String(),
+			SpunAt: statistics.Recent[i].SpunAt,
+		}
+	}
+
+	if statistics.Streaks != nil {
+		s.Streaks = make([]Streak, len(statistics.Streaks))
+	}
+
+	for i := range statistics.Streaks {
+		s.Streaks[i] = Streak{
+			Kind:   statistics.Streaks[i].Kind.String(),
+			Value:  statistics.Streaks[i].Value,
+			Length: statistics.Streaks[i].Length,
+		}
+	}
+
+	return s
+}
+
+func copyInts(ints []int) []int {
+	if ints == nil {
+		return nil
+	}
+
+	c := make([]int, len(ints))
+	copy(c, ints)
+
+	return c
+}
@@ -2,61 +2,178 @@ package storage
 
 import (
 	"betting/internal/domain"
+	"errors"
+	"time"
 
 	"github.com/google/uuid"
 )
 
-// Table is the storage representation of domain.Table.
+// Errors returned by writes to storage.
+var (
+	ErrVersionConflict = errors.New("stored version does not match the expected version")
+	ErrTableClosed     = errors.New("table was closed before the write")
+	ErrTableSpun       = errors.New("table was spun before the write")
+	ErrTableNotSpun    = errors.New("table was not spun before the write")
+	ErrTableSettled    = errors.New("table was settled before the write")
+	ErrTableContinued  = errors.New("table was continued by another round before the write")
+	ErrChainBroken     = errors.New("audit entry does not follow the last one stored")
+)
+
+// Table is the storage representation of domain.Table. Version starts at one and is incremented by every write.
 type Table struct {
-	ID       uuid.UUID
-	IsClosed bool
-	Outcome  *Outcome
+	ID          uuid.UUID
+	IsClosed    bool
+	Outcomes    []Outcome
+	Multipliers []Multiplier
+	ZeroRule    string
+	Variant     string
+	Wheels      int
+	Previous    uuid.UUID
+	Series      uuid.UUID
+	CreatedAt   time.Time
+	CreatedBy   string
+	ClosedAt    *time.Time
+	ClosedBy    string
+	SpunAt      *time.Time
+	SpunBy      string
+	SettledAt   *time.Time
+	SettledBy   string
+	Version     int64
 }
 
 // Outcome is the storage representation of domain.Outcome.
 type Outcome struct {
 	Colour string
 	Value  int
+	Seed   int64
+}
+
+// Multiplier is the storage representation of domain.Multiplier.
+type Multiplier struct {
+	Position int
+	Factor   int
 }
 
 // AdaptTableToDomain returns a domain.Table for a given storage.Table.
 func AdaptTableToDomain(table Table) domain.Table {
 	return domain.Table{
-		ID:       table.ID,
-		IsClosed: table.IsClosed,
-		Outcome:  AdaptOutcomeToDomain(table.Outcome),
+		ID:          table.ID,
+		IsClosed:    table.IsClosed,
+		Outcomes:    AdaptOutcomesToDomain(table.Outcomes),
+		Multipliers: AdaptMultipliersToDomain(table.Multipliers),
+		Rules: domain.Rules{
+			Zero:    domain.ZeroRule(table.ZeroRule),
+			Variant: domain.Variant(table.Variant),
+			Wheels:  table.Wheels,
+		},
+		Previous:  table.Previous,
+		Series:    table.Series,
+		CreatedAt: table.CreatedAt,
+		CreatedBy: table.CreatedBy,
+		ClosedAt:  table.ClosedAt,
+		ClosedBy:  table.ClosedBy,
+		SpunAt:    table.SpunAt,
+		SpunBy:    table.SpunBy,
+		SettledAt: table.SettledAt,
+		SettledBy: table.SettledBy,
+		Version:   table.Version,
+	}
+}
+
+// AdaptOutcomesToDomain adapts storage Outcomes to domain.Outcomes.
+func AdaptOutcomesToDomain(outcomes []Outcome) []domain.Outcome {
+	if outcomes == nil {
+		return nil
+	}
+
+	o := make([]domain.Outcome, len(outcomes))
+
+	for i := range outcomes {
+		o[i] = domain.Outcome{
+			Value:  outcomes[i].Value,
+			Colour: domain.Colour(outcomes[i].Colour),
+			Seed:   outcomes[i].Seed,
+		}
+	}
+
+	return o
+}
+
+// AdaptOutcomesFromDomain adapts domain.Outcomes to Outcomes.
+func AdaptOutcomesFromDomain(outcomes []domain.Outcome) []Outcome {
+	if outcomes == nil {
+		return nil
+	}
+
+	o := make([]Outcome, len(outcomes))
+
+	for i := range outcomes {
+		o[i] = Outcome{
+			Value:  outcomes[i].Value,
+			Colour: outcomes[i].Colour.String(),
+			Seed:   outcomes[i].Seed,
+		}
 	}
+
+	return o
 }
 
-// AdaptOutcomeToDomain adapts a storage Outcome to a domain.Outcome.
-func AdaptOutcomeToDomain(outcome *Outcome) *domain.Outcome {
-	if outcome == nil {
+// AdaptMultipliersToDomain adapts storage Multipliers to domain.Multipliers.
+func AdaptMultipliersToDomain(multipliers []Multiplier) []domain.Multiplier {
+	if multipliers == nil {
 		return nil
 	}
 
-	return &domain.Outcome{
-		Value:  outcome.Value,
-		Colour: domain.Colour(outcome.Colour),
+	m := make([]domain.Multiplier, len(multipliers))
+
+	for i := range multipliers {
+		m[i] = domain.Multiplier{
+			Position: multipliers[i].Position,
+			Factor:   multipliers[i].Factor,
+		}
 	}
+
+	return m
 }
 
-// AdaptOutcomeFromDomain adapts a domain.Outcome to a Outcome.
-func AdaptOutcomeFromDomain(outcome *domain.Outcome) *Outcome {
-	if outcome == nil {
+// AdaptMultipliersFromDomain adapts domain.Multipliers to Multipliers.
+func AdaptMultipliersFromDomain(multipliers []domain.Multiplier) []Multiplier {
+	if multipliers == nil {
 		return nil
 	}
 
-	return &Outcome{
-		Value:  outcome.Value,
-		Colour: outcome.Colour.String(),
+	m := make([]Multiplier, len(multipliers))
+
+	for i := range multipliers {
+		m[i] = Multiplier{
+			Position: multipliers[i].Position,
+			Factor:   multipliers[i].Factor,
+		}
 	}
+
+	return m
 }
 
 // AdaptTableFromDomain adapts a domain.Table to a Table.
 func AdaptTableFromDomain(table domain.Table) Table {
 	return Table{
-		ID:       table.ID,
-		IsClosed: table.IsClosed,
-		Outcome:  AdaptOutcomeFromDomain(table.Outcome),
+		ID:          table.ID,
+		IsClosed:    table.IsClosed,
+		Outcomes:    AdaptOutcomesFromDomain(table.Outcomes),
+		Multipliers: AdaptMultipliersFromDomain(table.Multipliers),
+		ZeroRule:    table.Rules.Zero.String(),
+		Variant:     table.Rules.Variant.String(),
+		Wheels:      table.Rules.Wheels,
+		Previous:    table.Previous,
+		Series:      table.Series,
+		CreatedAt:   table.CreatedAt,
+		CreatedBy:   table.CreatedBy,
+		ClosedAt:    table.ClosedAt,
+		ClosedBy:    table.ClosedBy,
+		SpunAt:      table.SpunAt,
+		SpunBy:      table.SpunBy,
+		SettledAt:   table.SettledAt,
+		SettledBy:   table.SettledBy,
+		Version:     table.Version,
 	}
 }